@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"dcbot/internal/config"
+	"dcbot/internal/database"
+
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	// Load environment variables
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found, using system environment variables")
+	}
+
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(1)
+	}
+
+	cfg := config.Load()
+
+	switch os.Args[1] {
+	case "backup":
+		runBackup(cfg, os.Args[2:])
+	case "restore":
+		runRestore(cfg, os.Args[2:])
+	default:
+		printUsage()
+		os.Exit(1)
+	}
+}
+
+// printUsage prints the command usage
+func printUsage() {
+	fmt.Println("Usage:")
+	fmt.Println("  backuprestore backup --output backup.json [--db ./bridge.db]")
+	fmt.Println("  backuprestore restore --input backup.json [--db ./bridge.db] [--dry-run]")
+}
+
+// runBackup exports the database to a JSON file
+func runBackup(cfg *config.Config, args []string) {
+	flags := flag.NewFlagSet("backup", flag.ExitOnError)
+	output := flags.String("output", "backup.json", "Backup file to write")
+	dbPath := flags.String("db", cfg.DatabasePath, "Path to the bridge database")
+	flags.Parse(args)
+
+	db, err := database.NewDatabase(*dbPath)
+	if err != nil {
+		log.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	data, err := db.ExportAll()
+	if err != nil {
+		log.Fatalf("Failed to export database: %v", err)
+	}
+
+	file, err := os.Create(*output)
+	if err != nil {
+		log.Fatalf("Failed to create backup file: %v", err)
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(data); err != nil {
+		log.Fatalf("Failed to write backup: %v", err)
+	}
+
+	fmt.Printf("✅ Backup written to %s\n", *output)
+	printCounts(data)
+}
+
+// runRestore imports a JSON backup into the database
+func runRestore(cfg *config.Config, args []string) {
+	flags := flag.NewFlagSet("restore", flag.ExitOnError)
+	input := flags.String("input", "backup.json", "Backup file to read")
+	dbPath := flags.String("db", cfg.DatabasePath, "Path to the bridge database")
+	dryRun := flags.Bool("dry-run", false, "Validate the backup without writing to the database")
+	flags.Parse(args)
+
+	file, err := os.Open(*input)
+	if err != nil {
+		log.Fatalf("Failed to open backup file: %v", err)
+	}
+	defer file.Close()
+
+	var data database.BackupData
+	if err := json.NewDecoder(file).Decode(&data); err != nil {
+		log.Fatalf("Failed to parse backup file: %v", err)
+	}
+
+	if *dryRun {
+		if err := database.ValidateBackup(&data); err != nil {
+			log.Fatalf("❌ Backup is invalid: %v", err)
+		}
+		fmt.Printf("✅ Backup %s is valid (dry run, nothing written)\n", *input)
+		printCounts(&data)
+		return
+	}
+
+	db, err := database.NewDatabase(*dbPath)
+	if err != nil {
+		log.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.ImportAll(&data); err != nil {
+		log.Fatalf("Failed to restore backup: %v", err)
+	}
+
+	fmt.Printf("✅ Backup %s restored\n", *input)
+	printCounts(&data)
+}
+
+// printCounts prints the number of records per table in a backup
+func printCounts(data *database.BackupData) {
+	fmt.Printf("  Users:            %d\n", len(data.Users))
+	fmt.Printf("  User mappings:    %d\n", len(data.UserMappings))
+	fmt.Printf("  Rooms:            %d\n", len(data.Rooms))
+	fmt.Printf("  Room mappings:    %d\n", len(data.RoomMappings))
+	fmt.Printf("  Bridge configs:   %d\n", len(data.BridgeConfigs))
+	fmt.Printf("  Messages:         %d\n", len(data.Messages))
+	fmt.Printf("  Message mappings: %d\n", len(data.MessageMappings))
+}
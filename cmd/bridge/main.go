@@ -1,21 +1,39 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	"dcbot/internal/api"
 	"dcbot/internal/config"
 	"dcbot/internal/database"
+	"dcbot/internal/health"
+	"dcbot/internal/logger"
+	"dcbot/internal/metrics"
+	"dcbot/internal/notifications"
 	"dcbot/internal/platforms/telegram"
 	"dcbot/internal/platforms/discord"
+	"dcbot/internal/platforms/incomingwebhook"
 	"dcbot/internal/bridge"
+	"dcbot/internal/telemetry"
+	"dcbot/internal/transformers"
+	"dcbot/internal/types"
+	"dcbot/internal/watchdog"
 
 	"github.com/joho/godotenv"
 )
 
+// tracingServiceName identifies the bot's spans in the trace collector
+const tracingServiceName = "dcbot"
+
+// eventSubscriberBuffer is how far the metrics and admin notification subscriber may fall behind the event bus
+const eventSubscriberBuffer = 256
+
 func main() {
 	// Load environment variables
 	if err := godotenv.Load(); err != nil {
@@ -27,6 +45,17 @@ func main() {
 
 	// Load configuration
 	cfg := config.Load()
+	logger.SetDefaultLevel(cfg.LogLevel)
+
+	// Export message delivery traces to an OTLP collector such as Jaeger
+	if cfg.OTLPEnabled {
+		shutdownTracer, err := telemetry.InitTracer(tracingServiceName, cfg.OTLPEndpoint)
+		if err != nil {
+			log.Printf("⚠️ Tracing disabled: %v", err)
+		} else {
+			defer shutdownTracer()
+		}
+	}
 	
 	// Initialize database
 	fmt.Println("🗄️ Initializing database...")
@@ -39,9 +68,23 @@ func main() {
 	// Initialize bridge core
 	fmt.Println("🌉 Initializing bridge core...")
 	bridgeCore := bridge.NewBridgeCore(db)
+	bridgeCore.SetPingInterval(time.Duration(cfg.PingIntervalSeconds) * time.Second)
+	bridgeCore.SetDeliveryTimeout(time.Duration(cfg.MessageDeliveryTimeoutSeconds) * time.Second)
+	bridgeCore.SetAutoCreateDiscordThreads(cfg.AutoCreateDiscordThreads)
+	bridgeCore.SetDeduplicationCacheSize(cfg.DeduplicationCacheSize)
+	bridgeCore.SetRetryPolicy(cfg.MaxRetries, time.Duration(cfg.RetryBackoffMs)*time.Millisecond, time.Duration(cfg.MaxRetryDelaySeconds)*time.Second)
+	bridgeCore.SetTimingLogger(logger.NewPlatformLogger("bridge", cfg.PlatformLogLevels), cfg.EnableDetailedTimingLogs)
+	bridgeCore.SetDowntimeNotifications(time.Duration(cfg.DowntimeNotifyDelaySeconds)*time.Second, cfg.DowntimeMessage, cfg.DowntimeRestoredMessage)
+	defer bridgeCore.Stop()
+
+	// Register message transformers
+	if cfg.URLShortenerEnable {
+		bridgeCore.AddTransformer(transformers.NewURLShortenerTransformer(cfg.URLShortenerAPI, cfg.URLShortenMinLength))
+	}
 
 	// Initialize platform clients based on configuration
 	var telegramClient *telegram.Client
+	var telegramAdapter *bridge.TelegramAdapter
 	var telegramHandler *telegram.MessageHandler
 	var discordClient *discord.Client
 	var discordHandler *discord.MessageHandler
@@ -55,6 +98,15 @@ func main() {
 			telegramConfig := telegram.Config{
 				BotToken: cfg.TelegramBotToken,
 				ChatID:   cfg.TelegramChatID,
+				Logger:   logger.NewPlatformLogger("telegram", cfg.PlatformLogLevels),
+
+				MaxMediaSizeBytes:   cfg.MaxMediaSizeBytes,
+				StreamMediaTransfer: cfg.StreamMediaTransfer,
+				AdminUserIDs:        cfg.TelegramAdminUserIDs,
+				DiscordAdminIDs:     cfg.DiscordAdminUserIDs,
+
+				// Sends the bridge timed out on must not reach Telegram after their retry
+				RequestTimeout: time.Duration(cfg.MessageDeliveryTimeoutSeconds) * time.Second,
 			}
 			
 			telegramClient, err = telegram.NewClient(telegramConfig)
@@ -70,9 +122,20 @@ func main() {
 					return bridgeCore.ProcessMessageLegacy(platform, chatID, userID, messageType, content)
 				})
 				
+				// Route event and media messages directly to the bridge core
+				telegramClient.SetBridgeMessageHandler(func(message *types.BridgeMessage) error {
+					bridgeCore.SetUserMapping(message.SourcePlatform, message.SourceUserID, message.Username)
+					return bridgeCore.ProcessMessage(message)
+				})
+				
 				// Register Telegram platform with bridge core
-				telegramAdapter := bridge.NewTelegramAdapter(telegramClient)
+				telegramAdapter = bridge.NewTelegramAdapter(telegramClient)
 				bridgeCore.RegisterPlatform(telegramAdapter)
+				telegramClient.SetBridgeCore(bridgeCore)
+				telegramClient.SetSentMessageStore(db)
+				telegramClient.SetUserMappingStore(db)
+				telegramClient.SetBridgeInviteStore(db)
+				telegramClient.SetAccountLinkStore(db)
 				
 				// Start Telegram client
 				if err := telegramClient.Start(telegramHandler.HandleMessage); err != nil {
@@ -90,7 +153,7 @@ func main() {
 			log.Println("⚠️ Discord is enabled but bot token is missing, skipping Discord initialization")
 		} else {
 			fmt.Println("🎮 Initializing Discord bot...")
-			discordClient, err = discord.NewClient(cfg.DiscordBotToken, cfg.DiscordGuildID)
+			discordClient, err = discord.NewClient(cfg.DiscordBotToken, cfg.DiscordGatewayIntents, logger.NewPlatformLogger("discord", cfg.PlatformLogLevels))
 			if err != nil {
 				log.Printf("❌ Failed to create Discord client: %v", err)
 			} else {
@@ -103,13 +166,24 @@ func main() {
 				discordAdapter := bridge.NewDiscordAdapter(discordClient)
 				bridgeCore.RegisterPlatform(discordAdapter)
 				
+				// Persist webhooks so restarts reuse them instead of creating new ones
+				discordClient.SetWebhookStore(db)
+				discordClient.SetMaxWebhooksPerChannel(cfg.MaxWebhooksPerChannel)
+				discordClient.SetSentMessageStore(db)
+
 				// Set bridge core reference in Discord handler
 				discordHandler.SetBridgeCore(bridgeCore)
+				discordHandler.SetMaxMediaSizeBytes(cfg.MaxMediaSizeBytes)
 				
-				// Set admin users (add your User ID here)
-				discordHandler.SetAdminUsers([]string{
-					"1359619658214412298", // Your Discord User ID
-				})
+				// Set admin users (DISCORD_ADMIN_USER_IDS)
+				discordHandler.SetAdminUsers(cfg.DiscordAdminUserIDs)
+
+				// Templates for /bridge create template:<name>
+				if templates, err := config.LoadTemplates(cfg.BridgeTemplatesPath); err != nil {
+					log.Printf("❌ Failed to load bridge templates: %v", err)
+				} else {
+					discordHandler.SetTemplates(templates)
+				}
 				
 				// Setup Discord handlers
 				discordHandler.SetupHandlers()
@@ -124,9 +198,147 @@ func main() {
 		fmt.Println("⏭️ Discord is disabled in configuration")
 	}
 
+	// Deliver into a Discord server through an incoming webhook, without the bot
+	if cfg.DiscordIncomingWebhookURL != "" {
+		webhookClient, err := incomingwebhook.NewClient(incomingwebhook.Config{WebhookURL: cfg.DiscordIncomingWebhookURL})
+		if err != nil {
+			log.Printf("❌ Failed to create Discord incoming webhook client: %v", err)
+		} else {
+			bridgeCore.RegisterPlatform(bridge.NewWebhookAdapter(webhookClient))
+			fmt.Println("🪝 Discord incoming webhook enabled")
+		}
+	}
+
+	// Remove orphaned Discord webhooks at startup and daily
+	webhookCleanupCtx, stopWebhookCleanup := context.WithCancel(context.Background())
+	defer stopWebhookCleanup()
+	if discordClient != nil {
+		discordClient.StartWebhookCleanup(webhookCleanupCtx)
+	}
+
+	// Show the bridge health as the Discord bot's status
+	statusCtx, stopStatusUpdates := context.WithCancel(context.Background())
+	defer stopStatusUpdates()
+	if discordHandler != nil {
+		discordHandler.StartStatusUpdates(statusCtx)
+	}
+
+	// Let Telegram messages preview linked Discord messages and commands show channel names
+	if telegramAdapter != nil && discordClient != nil {
+		telegramAdapter.SetDiscordClient(discordClient)
+		telegramClient.SetDiscordResolver(func(channelID string) (string, error) {
+			channel, err := discordClient.GetChannel(channelID)
+			if err != nil {
+				return "", err
+			}
+			return channel.Name, nil
+		})
+	}
+
 	// Show active platforms
 	showActivePlatforms(cfg)
 
+	// Check that every bridge can reach its target channel
+	selfTestResults := bridgeCore.SelfTest(context.Background())
+	showSelfTestResults(selfTestResults)
+	if cfg.FailOnSelfTestError {
+		for _, result := range selfTestResults {
+			if !result.OK {
+				log.Fatalf("❌ Bridge self-test failed for %s: %s", result.BridgeID, result.Error)
+			}
+		}
+	}
+
+	// Probe bridges daily and on /bridge test
+	testerCtx, stopTester := context.WithCancel(context.Background())
+	defer stopTester()
+	bridgeTester, err := health.NewBridgeTester(bridgeCore, cfg.BridgeTestTime, func(message string) {
+		if discordHandler != nil {
+			discordHandler.NotifyAdmins(message)
+		}
+	})
+	if err != nil {
+		log.Printf("❌ Failed to create bridge tester: %v", err)
+	} else {
+		bridgeTester.Start(testerCtx)
+		if discordHandler != nil {
+			discordHandler.SetBridgeTester(bridgeTester)
+		}
+	}
+
+	// DM Discord admins about critical errors
+	var adminNotifier *notifications.AdminNotifier
+	if discordClient != nil {
+		adminNotifier = notifications.NewAdminNotifier(discordClient.Session(), cfg.DiscordAdminUserIDs)
+		bridgeCore.SetErrorHandler(adminNotifier.HandleError)
+	}
+
+	// Count bridge events and tell admins about platforms going down
+	bridgeEvents, unsubscribeEvents := bridgeCore.Subscribe(eventSubscriberBuffer)
+	defer unsubscribeEvents()
+	go func() {
+		for event := range bridgeEvents {
+			metrics.IncBridgeEvents(event.Type)
+			if adminNotifier != nil {
+				adminNotifier.HandleEvent(event)
+			}
+		}
+	}()
+
+	// Record the Discord server of channels bridged before cross-server support
+	bridgeCore.ResolveMissingGuildIDs()
+
+	// Retry deliveries that failed while a platform was unavailable
+	bridgeCore.StartRetryQueue()
+
+	// Sync Telegram group descriptions to Discord channel topics, Telegram sends no update for them
+	bridgeCore.StartTopicSync()
+
+	// Sync Telegram group admins to the Discord admin role of bridges that opted in
+	bridgeCore.StartAdminRoleSync()
+
+	// Keep the message tables from growing without bound
+	cleanupCtx, stopCleanup := context.WithCancel(context.Background())
+	defer stopCleanup()
+	dbCleaner := database.NewCleaner(db, cfg.MaxMessagesPerBridge, cfg.MaxMessageMappingsPerBridge, cfg.WALCheckpointThreshold)
+	dbCleaner.Start(cleanupCtx)
+	if discordHandler != nil {
+		discordHandler.SetDatabasePruner(dbCleaner)
+	}
+
+	// Restart platform clients that stop responding
+	watchdogCtx, stopWatchdog := context.WithCancel(context.Background())
+	defer stopWatchdog()
+	clientWatchdog := watchdog.New()
+	if telegramClient != nil {
+		clientWatchdog.Register(types.PlatformTelegram, func() bool {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			return telegramClient.IsRunning() && telegramClient.Ping(ctx) == nil
+		}, telegramClient.Restart)
+	}
+	if discordClient != nil {
+		clientWatchdog.Register(types.PlatformDiscord, func() bool {
+			return discordClient.IsConnected() && discordClient.Ping() == nil
+		}, discordClient.Reconnect)
+	}
+	clientWatchdog.Start(watchdogCtx, time.Duration(cfg.WatchdogIntervalSeconds)*time.Second)
+
+	// Start the REST API, which also exposes Prometheus metrics
+	var apiServer *api.Server
+	if cfg.APIEnable {
+		apiServer = api.NewServer(cfg.APIPort, bridgeCore, logger.NewPlatformLogger("api", cfg.PlatformLogLevels))
+		apiServer.SetAuthToken(cfg.APIToken)
+		apiServer.SetSnapshotStore(db)
+		apiServer.SetBackupStore(db)
+		apiServer.SetRateLimit(cfg.APIRateLimitRPM, cfg.APIRateLimitBurst)
+		go func() {
+			if err := apiServer.Start(); err != nil {
+				log.Printf("❌ %v", err)
+			}
+		}()
+	}
+
 	// Graceful shutdown
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
@@ -135,6 +347,18 @@ func main() {
 	<-stop
 
 	fmt.Println("🛑 Shutting down bridge bot...")
+
+	// Stop the watchdog first so it does not restart clients being shut down
+	stopWatchdog()
+
+	// Stop API server if running
+	if apiServer != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := apiServer.Shutdown(ctx); err != nil {
+			log.Printf("⚠️ API server shutdown error: %v", err)
+		}
+		cancel()
+	}
 	
 	// Stop Telegram client if running
 	if telegramClient != nil {
@@ -164,3 +388,30 @@ func showActivePlatforms(cfg *config.Config) {
 	}
 	fmt.Println()
 }
+
+// showSelfTestResults prints the startup bridge self-test as a table
+func showSelfTestResults(results []bridge.SelfTestResult) {
+	fmt.Println("🩺 Bridge Self-Test:")
+	if len(results) == 0 {
+		fmt.Println("  (no active bridges)")
+		fmt.Println()
+		return
+	}
+
+	width := len("BRIDGE")
+	for _, result := range results {
+		if len(result.BridgeID) > width {
+			width = len(result.BridgeID)
+		}
+	}
+
+	fmt.Printf("  %-*s  %s\n", width, "BRIDGE", "STATUS")
+	for _, result := range results {
+		status := "✅ OK"
+		if !result.OK {
+			status = "❌ " + result.Error
+		}
+		fmt.Printf("  %-*s  %s\n", width, result.BridgeID, status)
+	}
+	fmt.Println()
+}
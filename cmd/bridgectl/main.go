@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	"dcbot/internal/api/client"
+
+	"github.com/spf13/cobra"
+)
+
+// Output formats of list commands
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// options holds the global flags shared by all commands
+type options struct {
+	server string
+	token  string
+}
+
+func main() {
+	if err := newRootCommand().ExecuteContext(context.Background()); err != nil {
+		os.Exit(1)
+	}
+}
+
+// newRootCommand builds the bridgectl command tree
+func newRootCommand() *cobra.Command {
+	opts := &options{}
+
+	root := &cobra.Command{
+		Use:          "bridgectl",
+		Short:        "Manage bridges of a running bridge through its REST API",
+		SilenceUsage: true,
+	}
+	root.PersistentFlags().StringVar(&opts.server, "server", getEnv("BRIDGECTL_SERVER", "http://localhost:8080"), "Base URL of the bridge API server")
+	root.PersistentFlags().StringVar(&opts.token, "token", os.Getenv("API_TOKEN"), "API_TOKEN of the bridge API server")
+
+	bridge := &cobra.Command{
+		Use:   "bridge",
+		Short: "List, create and remove bridges",
+	}
+	bridge.AddCommand(newBridgeListCommand(opts), newBridgeCreateCommand(opts), newBridgeRemoveCommand(opts))
+
+	db := &cobra.Command{
+		Use:   "db",
+		Short: "Database maintenance",
+	}
+	db.AddCommand(newDBBackupCommand(opts))
+
+	root.AddCommand(bridge, newStatsCommand(opts), db)
+	return root
+}
+
+// client creates an API client from the global flags
+func (o *options) client() *client.BridgectlClient {
+	return client.NewBridgectlClient(o.server, o.token)
+}
+
+// newBridgeListCommand builds "bridgectl bridge list"
+func newBridgeListCommand(opts *options) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List all bridge connections",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutput(output); err != nil {
+				return err
+			}
+
+			bridges, err := opts.client().ListBridges(cmd.Context())
+			if err != nil {
+				return err
+			}
+			sort.Slice(bridges, func(i, j int) bool { return bridges[i].ID < bridges[j].ID })
+
+			if output == outputJSON {
+				return printJSON(bridges)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tNAME\tSOURCE\tTARGET\tACTIVE\tCREATED")
+			for _, b := range bridges {
+				fmt.Fprintf(w, "%s\t%s\t%s #%s\t%s #%s\t%t\t%s\n", b.ID, b.Name, b.SourcePlatform, b.SourceChannelID,
+					b.TargetPlatform, b.TargetChannelID, b.IsActive, b.CreatedAt.Format("2006-01-02 15:04"))
+			}
+			return w.Flush()
+		},
+	}
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+// newBridgeCreateCommand builds "bridgectl bridge create"
+func newBridgeCreateCommand(opts *options) *cobra.Command {
+	req := &client.CreateBridgeRequest{}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a bidirectional bridge between two channels",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.client().CreateBridge(cmd.Context(), req); err != nil {
+				return err
+			}
+			fmt.Printf("✅ Bridge created: %s #%s ↔ %s #%s\n", req.SourcePlatform, req.SourceChannelID, req.TargetPlatform, req.TargetChannelID)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&req.SourcePlatform, "source-platform", "", "Platform of the source channel (discord, telegram)")
+	cmd.Flags().StringVar(&req.SourceChannelID, "source-channel", "", "ID of the source channel")
+	cmd.Flags().StringVar(&req.TargetPlatform, "target-platform", "", "Platform of the target channel (discord, telegram)")
+	cmd.Flags().StringVar(&req.TargetChannelID, "target-channel", "", "ID of the target channel")
+	for _, name := range []string{"source-platform", "source-channel", "target-platform", "target-channel"} {
+		cmd.MarkFlagRequired(name)
+	}
+	return cmd
+}
+
+// newBridgeRemoveCommand builds "bridgectl bridge remove"
+func newBridgeRemoveCommand(opts *options) *cobra.Command {
+	var bridgeID string
+
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Remove a bridge together with its reverse direction",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.client().RemoveBridge(cmd.Context(), bridgeID); err != nil {
+				return err
+			}
+			fmt.Printf("🗑️ Bridge removed: %s\n", bridgeID)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&bridgeID, "id", "", "ID of the bridge connection, as shown by bridge list")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+// newStatsCommand builds "bridgectl stats"
+func newStatsCommand(opts *options) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show bridge and platform counts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutput(output); err != nil {
+				return err
+			}
+
+			stats, err := opts.client().Stats(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			if output == outputJSON {
+				return printJSON(stats)
+			}
+
+			names := make([]string, 0, len(stats))
+			for name := range stats {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "STAT\tVALUE")
+			for _, name := range names {
+				fmt.Fprintf(w, "%s\t%s\n", name, strconv.Itoa(stats[name]))
+			}
+			return w.Flush()
+		},
+	}
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+// newDBBackupCommand builds "bridgectl db backup"
+func newDBBackupCommand(opts *options) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Export the database to a JSON file that backuprestore can restore",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := os.Create(output)
+			if err != nil {
+				return fmt.Errorf("failed to create backup file: %v", err)
+			}
+
+			err = opts.client().Backup(cmd.Context(), file)
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				os.Remove(output)
+				return err
+			}
+
+			fmt.Printf("✅ Backup written to %s\n", output)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&output, "output", "backup.json", "Backup file to write")
+	return cmd
+}
+
+// addOutputFlag adds the --output format flag of list commands
+func addOutputFlag(cmd *cobra.Command, output *string) {
+	cmd.Flags().StringVarP(output, "output", "o", outputTable, "Output format: table or json")
+}
+
+// validateOutput checks the value of an --output format flag
+func validateOutput(output string) error {
+	if output != outputTable && output != outputJSON {
+		return fmt.Errorf("invalid output format %q, use table or json", output)
+	}
+	return nil
+}
+
+// printJSON writes a value to stdout as indented JSON
+func printJSON(v interface{}) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// getEnv returns the value of an environment variable or a default
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
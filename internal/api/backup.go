@@ -0,0 +1,39 @@
+package api
+
+import (
+	"log/slog"
+	"net/http"
+
+	"dcbot/internal/database"
+	"dcbot/internal/logger"
+)
+
+// BackupStore exports the whole database
+type BackupStore interface {
+	ExportAll() (*database.BackupData, error)
+}
+
+// SetBackupStore sets the store serving /api/v1/db/backup
+func (s *Server) SetBackupStore(store BackupStore) {
+	s.backups = store
+}
+
+// handleBackup returns a JSON export of the database in the format of the backuprestore tool
+func (s *Server) handleBackup(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if s.backups == nil {
+		writeError(w, http.StatusServiceUnavailable, "database backups are not available")
+		return
+	}
+
+	data, err := s.backups.ExportAll()
+	if err != nil {
+		logger.FromContext(r.Context(), s.logger).Error("❌ Failed to export database", slog.String("error", err.Error()))
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, data)
+}
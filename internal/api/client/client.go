@@ -0,0 +1,147 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"dcbot/internal/types"
+)
+
+// defaultTimeout bounds each request to the API server
+const defaultTimeout = 30 * time.Second
+
+// BridgectlClient talks to the REST API of a running bridge
+type BridgectlClient struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// CreateBridgeRequest describes a bridge to create
+type CreateBridgeRequest struct {
+	SourcePlatform  string `json:"source_platform"`
+	SourceChannelID string `json:"source_channel_id"`
+	TargetPlatform  string `json:"target_platform"`
+	TargetChannelID string `json:"target_channel_id"`
+}
+
+// NewBridgectlClient creates a client for the API server at baseURL, authenticated with the API_TOKEN bearer token
+func NewBridgectlClient(baseURL, token string) *BridgectlClient {
+	return &BridgectlClient{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		token:      token,
+		httpClient: &http.Client{Timeout: defaultTimeout},
+	}
+}
+
+// ListBridges returns every bridge connection, one per direction
+func (c *BridgectlClient) ListBridges(ctx context.Context) ([]*types.BridgeConnection, error) {
+	var bridges []*types.BridgeConnection
+	if err := c.do(ctx, http.MethodGet, "/api/v1/bridges", nil, &bridges); err != nil {
+		return nil, fmt.Errorf("failed to list bridges: %v", err)
+	}
+	return bridges, nil
+}
+
+// CreateBridge creates a bidirectional bridge between two channels
+func (c *BridgectlClient) CreateBridge(ctx context.Context, req *CreateBridgeRequest) error {
+	if err := c.do(ctx, http.MethodPost, "/api/v1/bridges", req, nil); err != nil {
+		return fmt.Errorf("failed to create bridge: %v", err)
+	}
+	return nil
+}
+
+// RemoveBridge removes a bridge connection together with its reverse connection
+func (c *BridgectlClient) RemoveBridge(ctx context.Context, bridgeID string) error {
+	if err := c.do(ctx, http.MethodDelete, "/api/v1/bridges/"+url.PathEscape(bridgeID), nil, nil); err != nil {
+		return fmt.Errorf("failed to remove bridge: %v", err)
+	}
+	return nil
+}
+
+// Stats returns the bridge and platform counts of the bridge
+func (c *BridgectlClient) Stats(ctx context.Context) (map[string]int, error) {
+	var stats map[string]int
+	if err := c.do(ctx, http.MethodGet, "/api/v1/stats", nil, &stats); err != nil {
+		return nil, fmt.Errorf("failed to get stats: %v", err)
+	}
+	return stats, nil
+}
+
+// Backup writes a JSON export of the database to w, in the format read by backuprestore
+func (c *BridgectlClient) Backup(ctx context.Context, w io.Writer) error {
+	var data json.RawMessage
+	if err := c.do(ctx, http.MethodGet, "/api/v1/db/backup", nil, &data); err != nil {
+		return fmt.Errorf("failed to back up database: %v", err)
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, data, "", "  "); err != nil {
+		return fmt.Errorf("failed to format backup: %v", err)
+	}
+	indented.WriteByte('\n')
+
+	if _, err := indented.WriteTo(w); err != nil {
+		return fmt.Errorf("failed to write backup: %v", err)
+	}
+	return nil
+}
+
+// do sends a request with an optional JSON body and decodes the JSON response into out, if given
+func (c *BridgectlClient) do(ctx context.Context, method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %v", err)
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return responseError(resp)
+	}
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %v", err)
+	}
+	return nil
+}
+
+// responseError turns an error response of the API into an error, using its error message when present
+func responseError(resp *http.Response) error {
+	var body struct {
+		Error string `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err == nil && body.Error != "" {
+		return fmt.Errorf("%s: %s", resp.Status, body.Error)
+	}
+	return fmt.Errorf("%s", resp.Status)
+}
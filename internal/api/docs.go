@@ -0,0 +1,50 @@
+package api
+
+import (
+	"embed"
+	"net/http"
+)
+
+// openAPISpec is the hand-written OpenAPI document of the REST API
+//
+//go:embed openapi.yaml
+var openAPISpec []byte
+
+// swaggerPage is the Swagger UI page rendering openAPISpec
+//
+//go:embed swagger.html
+var swaggerPage []byte
+
+// swaggerUI holds the vendored swagger-ui-dist assets loaded by swaggerPage, so the docs work without a CDN
+//
+//go:embed swagger-ui/swagger-ui.css swagger-ui/swagger-ui-bundle.js
+var swaggerUI embed.FS
+
+// handleOpenAPI serves the OpenAPI document
+func (s *Server) handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	w.Write(openAPISpec)
+}
+
+// handleDocs serves the Swagger UI page
+func (s *Server) handleDocs(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(swaggerPage)
+}
+
+// handleDocsAsset serves a Swagger UI stylesheet or script from swaggerUI
+func (s *Server) handleDocsAsset(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	http.ServeFileFS(w, r, swaggerUI, "swagger-ui/"+r.PathValue("file"))
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"dcbot/internal/types"
+)
+
+// eventStreamKeepalive is how often an idle event stream gets a comment line to keep proxies from closing it
+const eventStreamKeepalive = 15 * time.Second
+
+// eventStreamBuffer is how many events a slow client may fall behind before events are dropped
+const eventStreamBuffer = 64
+
+// requireBearerToken rejects requests without the configured API token
+func (s *Server) requireBearerToken(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.token == "" {
+			writeError(w, http.StatusServiceUnavailable, "API token is not configured")
+			return
+		}
+
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, "invalid or missing bearer token")
+			return
+		}
+		next(w, r)
+	}
+}
+
+// handleEventStream streams bridge events as server-sent events until the client disconnects
+func (s *Server) handleEventStream(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "streaming is not supported")
+		return
+	}
+
+	// Subscribe before replaying so no event falls between the two
+	events, unsubscribe := s.bridges.Subscribe(eventStreamBuffer)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	// Reconnecting clients send the ID of the last event they received
+	var lastID uint64
+	if header := r.Header.Get("Last-Event-ID"); header != "" {
+		lastID, _ = strconv.ParseUint(header, 10, 64)
+		for _, event := range s.bridges.EventsSince(lastID) {
+			if err := writeEvent(w, event); err != nil {
+				return
+			}
+			lastID = event.ID
+		}
+	}
+	flusher.Flush()
+
+	keepalive := time.NewTicker(eventStreamKeepalive)
+	defer keepalive.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if event.ID <= lastID {
+				continue
+			}
+			if err := writeEvent(w, event); err != nil {
+				return
+			}
+			lastID = event.ID
+		case <-keepalive.C:
+			if _, err := fmt.Fprint(w, ": keepalive\n\n"); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+// writeEvent writes one server-sent event with its ID, type and JSON data
+func writeEvent(w http.ResponseWriter, event types.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %v", err)
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
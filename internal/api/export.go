@@ -0,0 +1,65 @@
+package api
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"dcbot/internal/logger"
+	"dcbot/internal/types"
+)
+
+// bridgeExportVersionHeader carries the version of the bridge export format
+const bridgeExportVersionHeader = "X-Bridge-Export-Version"
+
+// maxImportBytes limits the size of an uploaded bridge export
+const maxImportBytes = 10 << 20
+
+// importResult reports the outcome of a bridge import
+type importResult struct {
+	Imported int `json:"imported"`
+	Skipped  int `json:"skipped"`
+	Failed   int `json:"failed"`
+}
+
+// handleBridgeExport returns every bridge and its configuration in the versioned export format
+func (s *Server) handleBridgeExport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	data, err := s.bridges.ExportConnections()
+	if err != nil {
+		logger.FromContext(r.Context(), s.logger).Error("❌ Failed to export bridges", slog.String("error", err.Error()))
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set(bridgeExportVersionHeader, strconv.Itoa(types.BridgeExportVersion))
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// handleBridgeImport creates the bridges of an uploaded export that do not exist yet
+func (s *Server) handleBridgeImport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxImportBytes))
+	if err != nil {
+		writeError(w, http.StatusRequestEntityTooLarge, "bridge export is too large")
+		return
+	}
+
+	imported, skipped, failed, err := s.bridges.ImportConnections(data)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, importResult{Imported: imported, Skipped: skipped, Failed: failed})
+}
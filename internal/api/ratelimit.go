@@ -0,0 +1,127 @@
+package api
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"dcbot/internal/metrics"
+	"golang.org/x/time/rate"
+)
+
+// Defaults of the API rate limit, used when none has been configured
+const (
+	defaultRateLimitRPM   = 60
+	defaultRateLimitBurst = 10
+)
+
+// rateLimitEvictInterval is how often limiters of inactive addresses are dropped
+const rateLimitEvictInterval = 5 * time.Minute
+
+// rateLimitExemptPaths are never rate limited, so health checks keep working under load
+var rateLimitExemptPaths = map[string]bool{
+	"/api/health": true,
+}
+
+// IPRateLimiter limits requests per client IP address with a token bucket each
+type IPRateLimiter struct {
+	limiters sync.Map   // IP address -> *rate.Limiter
+	rate     rate.Limit // Tokens added per second
+	burst    int
+}
+
+// NewIPRateLimiter creates a limiter allowing requestsPerMinute per IP address with the given burst
+func NewIPRateLimiter(requestsPerMinute, burst int) *IPRateLimiter {
+	if requestsPerMinute <= 0 {
+		requestsPerMinute = defaultRateLimitRPM
+	}
+	if burst <= 0 {
+		burst = defaultRateLimitBurst
+	}
+	return &IPRateLimiter{rate: rate.Limit(float64(requestsPerMinute) / 60), burst: burst}
+}
+
+// Allow takes a token for an IP address, returning how long to wait if none is left
+func (l *IPRateLimiter) Allow(ip string) (bool, time.Duration) {
+	return l.allowAt(ip, time.Now())
+}
+
+// allowAt is Allow for a request made at now
+func (l *IPRateLimiter) allowAt(ip string, now time.Time) (bool, time.Duration) {
+	value, _ := l.limiters.LoadOrStore(ip, rate.NewLimiter(l.rate, l.burst))
+	limiter := value.(*rate.Limiter)
+
+	reservation := limiter.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		// Rejected requests must not use up tokens of later ones
+		reservation.CancelAt(now)
+		return false, delay
+	}
+	return true, 0
+}
+
+// evictInactive drops the limiters of addresses whose bucket has refilled completely
+func (l *IPRateLimiter) evictInactive() {
+	now := time.Now()
+	l.limiters.Range(func(key, value interface{}) bool {
+		if value.(*rate.Limiter).TokensAt(now) >= float64(l.burst) {
+			l.limiters.Delete(key)
+		}
+		return true
+	})
+}
+
+// runEviction periodically drops limiters of inactive addresses until stop is closed
+func (l *IPRateLimiter) runEviction(stop <-chan struct{}) {
+	ticker := time.NewTicker(rateLimitEvictInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			l.evictInactive()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// SetRateLimit sets how many requests per minute, with what burst, each IP address may make
+func (s *Server) SetRateLimit(requestsPerMinute, burst int) {
+	s.limiter = NewIPRateLimiter(requestsPerMinute, burst)
+}
+
+// rateLimit rejects requests from IP addresses that exceeded the rate limit with 429 Too Many Requests
+func (s *Server) rateLimit(mux *http.ServeMux) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rateLimitExemptPaths[r.URL.Path] {
+			mux.ServeHTTP(w, r)
+			return
+		}
+
+		allowed, retryAfter := s.limiter.Allow(clientIP(r))
+		if !allowed {
+			// Label with the route pattern so unknown paths cannot grow the metric without bound
+			_, pattern := mux.Handler(r)
+			metrics.IncAPIRateLimited(pattern)
+
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			writeError(w, http.StatusTooManyRequests, "rate limit exceeded")
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// clientIP returns the IP address of the client, without the port
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
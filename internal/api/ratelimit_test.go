@@ -0,0 +1,69 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIPRateLimiterBurst(t *testing.T) {
+	limiter := NewIPRateLimiter(60, 3)
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		if allowed, _ := limiter.allowAt("10.0.0.1", now); !allowed {
+			t.Fatalf("request %d of the burst was rejected", i+1)
+		}
+	}
+
+	allowed, retryAfter := limiter.allowAt("10.0.0.1", now)
+	if allowed {
+		t.Fatal("request over the burst was allowed")
+	}
+	if retryAfter <= 0 || retryAfter > time.Second {
+		t.Errorf("retry after %v, want at most one token interval of 1s", retryAfter)
+	}
+
+	if allowed, _ := limiter.allowAt("10.0.0.2", now); !allowed {
+		t.Error("another address shared the exhausted bucket")
+	}
+}
+
+func TestIPRateLimiterRefill(t *testing.T) {
+	limiter := NewIPRateLimiter(60, 1)
+	now := time.Now()
+
+	limiter.allowAt("10.0.0.1", now)
+	if allowed, _ := limiter.allowAt("10.0.0.1", now.Add(500*time.Millisecond)); allowed {
+		t.Fatal("request allowed before a token was refilled")
+	}
+	if allowed, _ := limiter.allowAt("10.0.0.1", now.Add(time.Second)); !allowed {
+		t.Error("request rejected after a token was refilled")
+	}
+}
+
+func TestRateLimitSetsRetryAfter(t *testing.T) {
+	s := NewServer(0, nil, nil)
+	s.SetRateLimit(30, 1)
+
+	request := func() *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/api/openapi.yaml", nil)
+		r.RemoteAddr = "10.0.0.1:1234"
+		s.server.Handler.ServeHTTP(recorder, r)
+		return recorder
+	}
+
+	if recorder := request(); recorder.Code != http.StatusOK {
+		t.Fatalf("first request = %d, want 200", recorder.Code)
+	}
+
+	recorder := request()
+	if recorder.Code != http.StatusTooManyRequests {
+		t.Fatalf("second request = %d, want 429", recorder.Code)
+	}
+	if got := recorder.Header().Get("Retry-After"); got != "2" {
+		t.Errorf("Retry-After = %q, want %q", got, "2")
+	}
+}
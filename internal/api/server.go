@@ -0,0 +1,283 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"dcbot/internal/logger"
+	"dcbot/internal/metrics"
+	"dcbot/internal/types"
+)
+
+// requestIDHeader carries the request ID between clients and the server
+const requestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength limits client-supplied request IDs
+const maxRequestIDLength = 64
+
+// BridgeService is the part of the bridge core exposed over the API
+type BridgeService interface {
+	GetAllBridges() map[string][]*types.BridgeConnection
+	GetPlatformStatus() map[string]bool
+	AddBridgeContext(ctx context.Context, sourcePlatform, sourceChannelID, targetPlatform, targetChannelID string) error
+	RemoveBridgeByID(connectionID string) error
+	GetBridgeStats() map[string]int
+	ExportConnections() ([]byte, error)
+	ImportConnections(data []byte) (imported, skipped, failed int, err error)
+	Subscribe(bufSize int) (<-chan types.Event, func())
+	EventsSince(lastID uint64) []types.Event
+	GetUserMappings(platform string) map[string]string
+	GetUserMapping(platform, userID string) (string, bool)
+	DeleteUserMapping(platform, userID string) error
+}
+
+// Server is the REST API server
+type Server struct {
+	port    int
+	bridges BridgeService
+	logger  *slog.Logger
+	server  *http.Server
+	token   string // Bearer token required by protected endpoints
+	limiter *IPRateLimiter
+	stop    chan struct{} // Closed on shutdown to stop background work
+
+	snapshots SnapshotStore // Optional, serves /api/v1/stats/history
+	backups   BackupStore   // Optional, serves /api/v1/db/backup
+}
+
+// NewServer creates a new API server
+func NewServer(port int, bridges BridgeService, logger *slog.Logger) *Server {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	s := &Server{
+		port:    port,
+		bridges: bridges,
+		logger:  logger,
+		limiter: NewIPRateLimiter(defaultRateLimitRPM, defaultRateLimitBurst),
+		stop:    make(chan struct{}),
+	}
+
+	mux := http.NewServeMux()
+	for _, route := range s.routes() {
+		mux.Handle(route.pattern, route.handler)
+	}
+
+	s.server = &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           s.requestIDMiddleware(s.requestLogger(s.rateLimit(mux))),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return s
+}
+
+// route is an endpoint registered on the API's ServeMux
+type route struct {
+	pattern string
+	handler http.Handler
+}
+
+// routes lists every endpoint of the API; each pattern must be a path in openapi.yaml
+func (s *Server) routes() []route {
+	return []route{
+		{"/metrics", metrics.Handler()},
+		{"/api/health", http.HandlerFunc(s.handleHealth)},
+		{"/api/openapi.yaml", http.HandlerFunc(s.handleOpenAPI)},
+		{"/api/docs", http.HandlerFunc(s.handleDocs)},
+		{"/api/docs/swagger-ui/{file}", http.HandlerFunc(s.handleDocsAsset)},
+		{"/api/v1/events/stream", s.requireBearerToken(s.handleEventStream)},
+		{"/api/v1/users", s.requireBearerToken(s.handleUsers)},
+		{"/api/v1/users/{platform}/{userID}", s.requireBearerToken(s.handleUser)},
+		{"/api/v1/bridges", s.requireBearerToken(s.handleBridges)},
+		{"/api/v1/bridges/{id}", s.requireBearerToken(s.handleBridge)},
+		{"/api/v1/bridges/export", s.requireBearerToken(s.handleBridgeExport)},
+		{"/api/v1/bridges/import", s.requireBearerToken(s.handleBridgeImport)},
+		{"/api/v1/stats", s.requireBearerToken(s.handleStats)},
+		{"/api/v1/stats/history", s.requireBearerToken(s.handleStatsHistory)},
+		{"/api/v1/db/backup", s.requireBearerToken(s.handleBackup)},
+	}
+}
+
+// SetAuthToken sets the bearer token required by protected endpoints; they are unavailable without one
+func (s *Server) SetAuthToken(token string) {
+	s.token = token
+}
+
+// Start serves the API until Shutdown is called
+func (s *Server) Start() error {
+	log.Printf("🌐 API server listening on :%d", s.port)
+	go s.limiter.runEviction(s.stop)
+	if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("API server stopped: %v", err)
+	}
+	return nil
+}
+
+// Shutdown stops the API server, waiting for in-flight requests
+func (s *Server) Shutdown(ctx context.Context) error {
+	close(s.stop)
+	return s.server.Shutdown(ctx)
+}
+
+// requestIDMiddleware assigns every request an ID, reusing a sanitized client-supplied one if present
+func (s *Server) requestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := sanitizeRequestID(r.Header.Get(requestIDHeader))
+		if id == "" {
+			id = newRequestID()
+		}
+
+		w.Header().Set(requestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(logger.WithRequestID(r.Context(), id)))
+	})
+}
+
+// requestLogger logs every request with its status, duration and request ID
+func (s *Server) requestLogger(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		s.logger.Info("🌐 API request",
+			slog.String("request_id", logger.RequestID(r.Context())),
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.Int("status", recorder.status),
+			slog.Duration("duration", time.Since(start)))
+	})
+}
+
+// statusRecorder remembers the status code written by a handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader records the status code before writing it
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Flush passes flushes through so streaming handlers work behind the recorder
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// handleHealth reports the connection status of every platform
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"status":    "ok",
+		"platforms": s.bridges.GetPlatformStatus(),
+	})
+}
+
+// createBridgeRequest is the body of POST /api/v1/bridges
+type createBridgeRequest struct {
+	SourcePlatform  string `json:"source_platform"`
+	SourceChannelID string `json:"source_channel_id"`
+	TargetPlatform  string `json:"target_platform"`
+	TargetChannelID string `json:"target_channel_id"`
+}
+
+// handleBridges lists bridges (GET) or creates one (POST)
+func (s *Server) handleBridges(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		var bridges []*types.BridgeConnection
+		for _, connections := range s.bridges.GetAllBridges() {
+			bridges = append(bridges, connections...)
+		}
+		writeJSON(w, http.StatusOK, bridges)
+
+	case http.MethodPost:
+		var req createBridgeRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+			return
+		}
+		if req.SourcePlatform == "" || req.SourceChannelID == "" || req.TargetPlatform == "" || req.TargetChannelID == "" {
+			writeError(w, http.StatusBadRequest, "source_platform, source_channel_id, target_platform and target_channel_id are required")
+			return
+		}
+
+		err := s.bridges.AddBridgeContext(r.Context(), req.SourcePlatform, req.SourceChannelID, req.TargetPlatform, req.TargetChannelID)
+		if err != nil {
+			logger.FromContext(r.Context(), s.logger).Error("❌ Failed to create bridge", slog.String("error", err.Error()))
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeJSON(w, http.StatusCreated, req)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// handleBridge removes a bridge connection together with its reverse connection
+func (s *Server) handleBridge(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	if err := s.bridges.RemoveBridgeByID(r.PathValue("id")); err != nil {
+		writeError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeJSON writes a JSON response
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("❌ Failed to write API response: %v", err)
+	}
+}
+
+// writeError writes a JSON error response
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
+
+// sanitizeRequestID keeps only letters, digits and hyphens of a client-supplied request ID
+func sanitizeRequestID(id string) string {
+	id = strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' {
+			return r
+		}
+		return -1
+	}, id)
+	if len(id) > maxRequestIDLength {
+		id = id[:maxRequestIDLength]
+	}
+	return id
+}
+
+// newRequestID generates a random version 4 UUID
+func newRequestID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
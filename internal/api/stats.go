@@ -0,0 +1,68 @@
+package api
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/logger"
+)
+
+// Bounds of the days parameter of GET /api/v1/stats/history
+const (
+	defaultHistoryDays = 30
+	maxHistoryDays     = 365
+)
+
+// SnapshotStore provides the daily database size snapshots
+type SnapshotStore interface {
+	GetSnapshotHistory(days int) ([]*models.DBSnapshot, error)
+}
+
+// SetSnapshotStore sets the store serving /api/v1/stats/history
+func (s *Server) SetSnapshotStore(store SnapshotStore) {
+	s.snapshots = store
+}
+
+// handleStats returns the bridge and platform counts of the bridge core
+func (s *Server) handleStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.bridges.GetBridgeStats())
+}
+
+// handleStatsHistory returns the database size snapshots of the last days, oldest first
+func (s *Server) handleStatsHistory(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if s.snapshots == nil {
+		writeError(w, http.StatusServiceUnavailable, "database statistics are not available")
+		return
+	}
+
+	days := defaultHistoryDays
+	if value := r.URL.Query().Get("days"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxHistoryDays {
+			writeError(w, http.StatusBadRequest, "days must be a number between 1 and 365")
+			return
+		}
+		days = parsed
+	}
+
+	snapshots, err := s.snapshots.GetSnapshotHistory(days)
+	if err != nil {
+		logger.FromContext(r.Context(), s.logger).Error("❌ Failed to get database snapshots", slog.String("error", err.Error()))
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if snapshots == nil {
+		snapshots = []*models.DBSnapshot{}
+	}
+	writeJSON(w, http.StatusOK, snapshots)
+}
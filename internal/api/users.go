@@ -0,0 +1,56 @@
+package api
+
+import (
+	"log/slog"
+	"net/http"
+
+	"dcbot/internal/logger"
+)
+
+// handleUsers lists the known display names of a platform's users, by user ID
+func (s *Server) handleUsers(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	platform := r.URL.Query().Get("platform")
+	if platform == "" {
+		writeError(w, http.StatusBadRequest, "platform is required")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.bridges.GetUserMappings(platform))
+}
+
+// userMappingResponse is the body of GET /api/v1/users/{platform}/{userID}
+type userMappingResponse struct {
+	Platform    string `json:"platform"`
+	UserID      string `json:"user_id"`
+	DisplayName string `json:"display_name"`
+}
+
+// handleUser returns (GET) or deletes (DELETE) the display name of a single user
+func (s *Server) handleUser(w http.ResponseWriter, r *http.Request) {
+	platform, userID := r.PathValue("platform"), r.PathValue("userID")
+
+	switch r.Method {
+	case http.MethodGet:
+		displayName, ok := s.bridges.GetUserMapping(platform, userID)
+		if !ok {
+			writeError(w, http.StatusNotFound, "user mapping not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, userMappingResponse{Platform: platform, UserID: userID, DisplayName: displayName})
+
+	case http.MethodDelete:
+		if err := s.bridges.DeleteUserMapping(platform, userID); err != nil {
+			logger.FromContext(r.Context(), s.logger).Error("❌ Failed to delete user mapping", slog.String("error", err.Error()))
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
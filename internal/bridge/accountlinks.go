@@ -0,0 +1,33 @@
+package bridge
+
+import (
+	"fmt"
+	"log"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/types"
+)
+
+// LinkTelegramAccount links a Discord user to the Telegram account that requested an unexpired link code.
+// It returns the redeemed request, or nil if the code is unknown.
+func (bc *BridgeCore) LinkTelegramAccount(code, discordUserID, discordUsername string) (*models.PendingAccountLink, error) {
+	if bc.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	link, err := bc.db.RedeemAccountLink(code)
+	if err != nil || link == nil {
+		return nil, err
+	}
+
+	err = bc.db.LinkUserAccounts(
+		&models.UserMapping{Platform: types.PlatformDiscord, PlatformUserID: discordUserID, Username: discordUsername},
+		&models.UserMapping{Platform: types.PlatformTelegram, PlatformUserID: link.PlatformUserID, Username: link.Username},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("🔗 Linked Discord user %s to Telegram user %s", discordUserID, link.PlatformUserID)
+	return link, nil
+}
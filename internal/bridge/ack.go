@@ -0,0 +1,43 @@
+package bridge
+
+import (
+	"log"
+	"time"
+
+	"dcbot/internal/types"
+)
+
+// ackReactionDuration is how long a delivery acknowledgement stays on the original Discord message
+const ackReactionDuration = 5 * time.Second
+
+// acknowledgeDelivery reacts to a Discord message with the outcome of its delivery to Telegram, if its bridge asks for it
+func (bc *BridgeCore) acknowledgeDelivery(message *types.BridgeMessage, connection *types.BridgeConnection, delivered bool) {
+	if message.SourcePlatform != types.PlatformDiscord || connection.TargetPlatform != types.PlatformTelegram || message.SourceMessageID == "" {
+		return
+	}
+
+	adapter, ok := bc.platforms[types.PlatformDiscord].(*DiscordAdapter)
+	if !ok {
+		return
+	}
+
+	config, err := bc.getBridgeConfig(message.SourcePlatform, message.SourceChannelID)
+	if err != nil || !config.AckWithReaction {
+		return
+	}
+
+	emoji := "✅"
+	if !delivered {
+		emoji = "❌"
+	}
+
+	// Messages in threads live in the thread channel
+	channelID := message.SourceChannelID
+	if message.SourceThreadID != "" {
+		channelID = message.SourceThreadID
+	}
+
+	if err := adapter.AddTemporaryReaction(channelID, message.SourceMessageID, emoji, ackReactionDuration); err != nil {
+		log.Printf("⚠️ Failed to acknowledge Discord message %s: %v", message.SourceMessageID, err)
+	}
+}
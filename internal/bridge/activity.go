@@ -0,0 +1,99 @@
+package bridge
+
+import (
+	"sync"
+	"time"
+)
+
+// messageRateWindow is the rolling window MessagesPerMinute counts bridged messages over
+const messageRateWindow = time.Minute
+
+// messageRateSlots is how many one-second slots make up messageRateWindow
+const messageRateSlots = int(messageRateWindow / time.Second)
+
+// failingBridgeThreshold is how many deliveries in a row must fail before a bridge counts as failing
+const failingBridgeThreshold = 5
+
+// messageRate counts bridged messages in a ring of per-second slots covering messageRateWindow
+type messageRate struct {
+	mu      sync.Mutex
+	counts  [messageRateSlots]int
+	seconds [messageRateSlots]int64 // Unix second each slot counts, slots of older seconds are stale
+}
+
+// add counts a message bridged at now, reusing the slot of a second that left the window
+func (r *messageRate) add(now time.Time) {
+	second := now.Unix()
+	slot := int(second % int64(messageRateSlots))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.seconds[slot] != second {
+		r.seconds[slot] = second
+		r.counts[slot] = 0
+	}
+	r.counts[slot]++
+}
+
+// total returns how many messages were counted in the window ending at now
+func (r *messageRate) total(now time.Time) int {
+	oldest := now.Unix() - int64(messageRateSlots)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	total := 0
+	for slot, second := range r.seconds {
+		if second > oldest {
+			total += r.counts[slot]
+		}
+	}
+	return total
+}
+
+// recordMessageRate counts a bridged message for MessagesPerMinute
+func (bc *BridgeCore) recordMessageRate() {
+	bc.messageRate.add(time.Now())
+}
+
+// MessagesPerMinute returns how many messages were bridged in the last minute
+func (bc *BridgeCore) MessagesPerMinute() int {
+	return bc.messageRate.total(time.Now())
+}
+
+// recordDeliveryOutcome tracks the failed deliveries in a row of a bridge, a successful delivery clears them
+func (bc *BridgeCore) recordDeliveryOutcome(connectionID, status string) {
+	bc.deliveryFailuresMu.Lock()
+	defer bc.deliveryFailuresMu.Unlock()
+
+	switch status {
+	case DeliveryStatusDelivered:
+		delete(bc.deliveryFailures, connectionID)
+	case DeliveryStatusFailed, DeliveryStatusTimeout, DeliveryStatusDropped:
+		bc.deliveryFailures[connectionID]++
+	}
+}
+
+// clearDeliveryFailures forgets the failed deliveries of removed connections
+func (bc *BridgeCore) clearDeliveryFailures(connectionIDs ...string) {
+	bc.deliveryFailuresMu.Lock()
+	defer bc.deliveryFailuresMu.Unlock()
+
+	for _, connectionID := range connectionIDs {
+		delete(bc.deliveryFailures, connectionID)
+	}
+}
+
+// HasFailingBridges reports whether the deliveries of any bridge keep failing
+func (bc *BridgeCore) HasFailingBridges() bool {
+	bc.deliveryFailuresMu.Lock()
+	defer bc.deliveryFailuresMu.Unlock()
+
+	for _, failures := range bc.deliveryFailures {
+		if failures >= failingBridgeThreshold {
+			return true
+		}
+	}
+	return false
+}
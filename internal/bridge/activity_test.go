@@ -0,0 +1,28 @@
+package bridge
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMessageRateWindow(t *testing.T) {
+	var rate messageRate
+	start := time.Unix(1000, 0)
+
+	rate.add(start)
+	rate.add(start.Add(500 * time.Millisecond))
+	rate.add(start.Add(30 * time.Second))
+
+	if got := rate.total(start.Add(59 * time.Second)); got != 3 {
+		t.Errorf("total within the window = %d, want 3", got)
+	}
+	if got := rate.total(start.Add(time.Minute)); got != 1 {
+		t.Errorf("total after the first second left the window = %d, want 1", got)
+	}
+
+	// A minute later the first slot is reused instead of adding to its stale count
+	rate.add(start.Add(time.Minute))
+	if got := rate.total(start.Add(time.Minute)); got != 2 {
+		t.Errorf("total after reusing a slot = %d, want 2", got)
+	}
+}
@@ -0,0 +1,192 @@
+package bridge
+
+import (
+	"log"
+	"time"
+
+	"dcbot/internal/types"
+)
+
+// adminRoleSyncInterval is how often Telegram group admins are checked for changes, since Telegram sends no update for them
+const adminRoleSyncInterval = 5 * time.Minute
+
+// memberRoleSetter is implemented by platforms that can give server members a role
+type memberRoleSetter interface {
+	SetMemberRole(guildID, userID, roleID string, granted bool) error
+}
+
+// chatAdminManager is implemented by platforms that can list and change the administrators of a group
+type chatAdminManager interface {
+	ChatAdministrators(chatID string) ([]string, error)
+	SetChatAdmin(chatID, userID string, admin bool) error
+}
+
+// SyncAdminRole promotes or demotes the linked Telegram account of a Discord member in the chats of bridges whose admin role they gained or lost
+func (bc *BridgeCore) SyncAdminRole(guildID, userID, roleID string, granted bool) {
+	if bc.db == nil {
+		return
+	}
+	manager, ok := bc.platforms[types.PlatformTelegram].(chatAdminManager)
+	if !ok {
+		return
+	}
+
+	var linkedUserID string
+	for channelID, connections := range bc.allConnections() {
+		for _, connection := range connections {
+			if connection.SourcePlatform != types.PlatformDiscord || connection.TargetPlatform != types.PlatformTelegram {
+				continue
+			}
+			mapping, err := bc.db.GetRoomMappingByPlatformRoom(types.PlatformDiscord, channelID)
+			if err != nil || mapping.GuildID != guildID {
+				continue
+			}
+			config, err := bc.getBridgeConfig(types.PlatformDiscord, channelID)
+			if err != nil || !config.SyncAdminRoles || config.AdminRoleID != roleID {
+				continue
+			}
+
+			if linkedUserID == "" {
+				linked, err := bc.db.GetLinkedUserMapping(types.PlatformDiscord, userID, types.PlatformTelegram)
+				if err != nil || linked == nil {
+					log.Printf("⏭️ Not syncing admin role of Discord user %s, no linked Telegram account", userID)
+					return
+				}
+				linkedUserID = linked.PlatformUserID
+			}
+
+			chatID := connection.TargetChannelID
+			if admin, known := bc.knownChatAdmin(chatID, linkedUserID); known && admin == granted {
+				continue
+			}
+			if err := manager.SetChatAdmin(chatID, linkedUserID, granted); err != nil {
+				log.Printf("❌ Failed to sync admin status of Telegram user %s in chat %s: %v", linkedUserID, chatID, err)
+				continue
+			}
+			bc.setKnownChatAdmin(chatID, linkedUserID, granted)
+			log.Printf("👮 Synced Discord admin role of user %s to Telegram user %s in chat %s (admin: %t)", userID, linkedUserID, chatID, granted)
+		}
+	}
+}
+
+// knownChatAdmin reports whether a user was an administrator of a Telegram chat at the last check, and whether the chat was checked yet
+func (bc *BridgeCore) knownChatAdmin(chatID, userID string) (admin, known bool) {
+	bc.chatAdminsMu.Lock()
+	defer bc.chatAdminsMu.Unlock()
+
+	admins, known := bc.chatAdmins[chatID]
+	return admins[userID], known
+}
+
+// setKnownChatAdmin records an admin change made by the bridge, so the next check does not sync it back
+func (bc *BridgeCore) setKnownChatAdmin(chatID, userID string, admin bool) {
+	bc.chatAdminsMu.Lock()
+	defer bc.chatAdminsMu.Unlock()
+
+	admins, ok := bc.chatAdmins[chatID]
+	if !ok {
+		return
+	}
+	if admin {
+		admins[userID] = true
+	} else {
+		delete(admins, userID)
+	}
+}
+
+// StartAdminRoleSync checks bridged Telegram groups for admin changes until the bridge core is stopped
+func (bc *BridgeCore) StartAdminRoleSync() {
+	if bc.db == nil {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(adminRoleSyncInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				bc.checkTelegramAdmins()
+			case <-bc.stopChan:
+				return
+			}
+		}
+	}()
+}
+
+// checkTelegramAdmins gives or takes the admin role of Discord members whose linked Telegram account was promoted or demoted since the last check
+func (bc *BridgeCore) checkTelegramAdmins() {
+	telegram := bc.platforms[types.PlatformTelegram]
+	manager, ok := telegram.(chatAdminManager)
+	if !ok || !telegram.IsConnected() {
+		return
+	}
+	roles, ok := bc.platforms[types.PlatformDiscord].(memberRoleSetter)
+	if !ok {
+		return
+	}
+
+	for chatID, connections := range bc.allConnections() {
+		if len(connections) == 0 || connections[0].SourcePlatform != types.PlatformTelegram {
+			continue
+		}
+		config, err := bc.getBridgeConfig(types.PlatformTelegram, chatID)
+		if err != nil || !config.SyncAdminRoles || config.AdminRoleID == "" {
+			continue
+		}
+
+		adminIDs, err := manager.ChatAdministrators(chatID)
+		if err != nil {
+			log.Printf("⚠️ Failed to check administrators of Telegram chat %s: %v", chatID, err)
+			continue
+		}
+		current := make(map[string]bool, len(adminIDs))
+		for _, adminID := range adminIDs {
+			current[adminID] = true
+		}
+
+		bc.chatAdminsMu.Lock()
+		previous, known := bc.chatAdmins[chatID]
+		bc.chatAdmins[chatID] = current
+		bc.chatAdminsMu.Unlock()
+
+		// The first check only learns the current administrators
+		if !known {
+			continue
+		}
+
+		changes := make(map[string]bool)
+		for userID := range current {
+			if !previous[userID] {
+				changes[userID] = true
+			}
+		}
+		for userID := range previous {
+			if !current[userID] {
+				changes[userID] = false
+			}
+		}
+
+		for userID, admin := range changes {
+			linked, err := bc.db.GetLinkedUserMapping(types.PlatformTelegram, userID, types.PlatformDiscord)
+			if err != nil || linked == nil {
+				continue
+			}
+			for _, connection := range connections {
+				if connection.TargetPlatform != types.PlatformDiscord {
+					continue
+				}
+				mapping, err := bc.db.GetRoomMappingByPlatformRoom(types.PlatformDiscord, connection.TargetChannelID)
+				if err != nil || mapping.GuildID == "" {
+					continue
+				}
+				if err := roles.SetMemberRole(mapping.GuildID, linked.PlatformUserID, config.AdminRoleID, admin); err != nil {
+					log.Printf("❌ Failed to sync admin role of Discord user %s: %v", linked.PlatformUserID, err)
+					continue
+				}
+				log.Printf("👮 Synced Telegram admin status of user %s to Discord user %s (admin: %t)", userID, linked.PlatformUserID, admin)
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package bridge
+
+import (
+	"fmt"
+	"testing"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/types"
+)
+
+// fakeRolePlatform is a Discord platform recording the role changes made by admin role sync
+type fakeRolePlatform struct {
+	fakePlatform
+	roleChanges []string
+}
+
+func (p *fakeRolePlatform) SetMemberRole(guildID, userID, roleID string, granted bool) error {
+	p.roleChanges = append(p.roleChanges, fmt.Sprintf("%s/%s/%s=%t", guildID, userID, roleID, granted))
+	return nil
+}
+
+// fakeAdminPlatform is a Telegram platform with a fixed list of chat admins, recording promotions made by admin role sync
+type fakeAdminPlatform struct {
+	fakePlatform
+	admins       []string
+	adminChanges []string
+}
+
+func (p *fakeAdminPlatform) ChatAdministrators(chatID string) ([]string, error) {
+	return p.admins, nil
+}
+
+func (p *fakeAdminPlatform) SetChatAdmin(chatID, userID string, admin bool) error {
+	p.adminChanges = append(p.adminChanges, fmt.Sprintf("%s/%s=%t", chatID, userID, admin))
+	return nil
+}
+
+// newAdminRoleSyncTest bridges Discord channel 100 in server 900 to Telegram chat -200, syncing admins with role 555.
+// Discord user 300 and Telegram user 42 are linked.
+func newAdminRoleSyncTest(t *testing.T) (*BridgeCore, *fakeRolePlatform, *fakeAdminPlatform) {
+	t.Helper()
+
+	bc := newTestBridgeCore(t)
+	discord := &fakeRolePlatform{fakePlatform: fakePlatform{name: types.PlatformDiscord}}
+	telegram := &fakeAdminPlatform{fakePlatform: fakePlatform{name: types.PlatformTelegram}}
+	bc.RegisterPlatform(discord)
+	bc.RegisterPlatform(telegram)
+
+	if err := bc.AddBridge(types.PlatformDiscord, "100", types.PlatformTelegram, "-200"); err != nil {
+		t.Fatalf("AddBridge: %v", err)
+	}
+	mapping, err := bc.db.GetRoomMappingByPlatformRoom(types.PlatformDiscord, "100")
+	if err != nil {
+		t.Fatalf("GetRoomMappingByPlatformRoom: %v", err)
+	}
+	if err := bc.db.SetRoomMappingGuild(mapping.RoomID, types.PlatformDiscord, "100", "900"); err != nil {
+		t.Fatalf("SetRoomMappingGuild: %v", err)
+	}
+
+	config, err := bc.GetBridgeConfig(types.PlatformDiscord, "100")
+	if err != nil {
+		t.Fatalf("GetBridgeConfig: %v", err)
+	}
+	config.SyncAdminRoles = true
+	config.AdminRoleID = "555"
+	if err := bc.UpdateBridgeConfig(types.PlatformDiscord, "100", config); err != nil {
+		t.Fatalf("UpdateBridgeConfig: %v", err)
+	}
+
+	err = bc.db.LinkUserAccounts(
+		&models.UserMapping{Platform: types.PlatformDiscord, PlatformUserID: "300"},
+		&models.UserMapping{Platform: types.PlatformTelegram, PlatformUserID: "42"},
+	)
+	if err != nil {
+		t.Fatalf("LinkUserAccounts: %v", err)
+	}
+	return bc, discord, telegram
+}
+
+func TestCheckTelegramAdmins(t *testing.T) {
+	bc, discord, telegram := newAdminRoleSyncTest(t)
+
+	steps := []struct {
+		name   string
+		admins []string
+		want   []string
+	}{
+		{"first check only learns admins", []string{"1"}, nil},
+		{"unlinked user promoted", []string{"1", "7"}, nil},
+		{"linked user promoted", []string{"1", "7", "42"}, []string{"900/300/555=true"}},
+		{"no change", []string{"1", "7", "42"}, nil},
+		{"linked user demoted", []string{"1"}, []string{"900/300/555=false"}},
+	}
+
+	for _, step := range steps {
+		telegram.admins = step.admins
+		discord.roleChanges = nil
+		bc.checkTelegramAdmins()
+
+		if fmt.Sprint(discord.roleChanges) != fmt.Sprint(step.want) {
+			t.Errorf("%s: role changes = %v, want %v", step.name, discord.roleChanges, step.want)
+		}
+	}
+}
+
+func TestSyncAdminRole(t *testing.T) {
+	bc, _, telegram := newAdminRoleSyncTest(t)
+	telegram.admins = []string{"1"}
+	bc.checkTelegramAdmins()
+
+	tests := []struct {
+		name    string
+		guildID string
+		userID  string
+		roleID  string
+		granted bool
+		want    []string
+	}{
+		{"other server", "901", "300", "555", true, nil},
+		{"other role", "900", "300", "556", true, nil},
+		{"unlinked user", "900", "301", "555", true, nil},
+		{"role granted", "900", "300", "555", true, []string{"-200/42=true"}},
+		{"already admin", "900", "300", "555", true, nil},
+		{"role removed", "900", "300", "555", false, []string{"-200/42=false"}},
+	}
+
+	for _, tt := range tests {
+		telegram.adminChanges = nil
+		bc.SyncAdminRole(tt.guildID, tt.userID, tt.roleID, tt.granted)
+
+		if fmt.Sprint(telegram.adminChanges) != fmt.Sprint(tt.want) {
+			t.Errorf("%s: admin changes = %v, want %v", tt.name, telegram.adminChanges, tt.want)
+		}
+	}
+}
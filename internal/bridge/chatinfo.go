@@ -0,0 +1,40 @@
+package bridge
+
+import (
+	"fmt"
+	"time"
+
+	"dcbot/internal/types"
+)
+
+// chatInfoTTL is how long server and chat sizes are cached
+const chatInfoTTL = 5 * time.Minute
+
+// chatInfoCacheSize is the most servers and chats whose size is cached
+const chatInfoCacheSize = 500
+
+// chatInfoProvider is implemented by platforms that can describe a server or chat
+type chatInfoProvider interface {
+	ChatInfo(chatID string) (*types.ChatInfo, error)
+}
+
+// GetChatInfo returns the name and member count of a Discord server or Telegram chat, cached for a few minutes
+func (bc *BridgeCore) GetChatInfo(platform, chatID string) (*types.ChatInfo, error) {
+	key := platform + ":" + chatID
+	if info, ok := bc.chatInfo.Get(key); ok {
+		return info, nil
+	}
+
+	provider, ok := bc.platforms[platform].(chatInfoProvider)
+	if !ok {
+		return nil, fmt.Errorf("platform %s does not provide chat info", platform)
+	}
+
+	info, err := provider.ChatInfo(chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	bc.chatInfo.Add(key, info)
+	return info, nil
+}
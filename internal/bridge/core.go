@@ -1,21 +1,113 @@
 package bridge
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
+	"dcbot/internal/cache"
 	"dcbot/internal/database"
 	"dcbot/internal/database/models"
+	"dcbot/internal/logger"
+	"dcbot/internal/metrics"
+	"dcbot/internal/transformers"
 	"dcbot/internal/types"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
+// tracer creates the message delivery spans; it exports nothing until telemetry.InitTracer installs a provider
+var tracer = otel.Tracer("dcbot/internal/bridge")
+
+// defaultPingInterval is used when no ping interval has been configured
+const defaultPingInterval = 30 * time.Second
+
+// platformHealth tracks the result of the periodic health pings for a platform
+type platformHealth struct {
+	lastPingError error
+	lastPingAt    time.Time
+}
+
 // BridgeCore manages message bridging between platforms
 type BridgeCore struct {
 	platforms    map[string]types.Platform
 	connections  map[string][]*types.BridgeConnection // sourceChannelID -> connections
 	userMappings map[string]map[string]string         // platform -> userID -> displayName
+	filters      map[string][]compiledFilter          // sourceChannelID -> content filters
+	transformers []types.Transformer                  // Applied in order to every bridged message
 	db           *database.Database                   // Database for persistence
+
+	connectionsMu  sync.RWMutex // Guards connections and filters
+	userMappingsMu sync.RWMutex // Guards userMappings
+
+	health       map[string]*platformHealth // platform -> last health ping result
+	healthMu     sync.RWMutex
+	pingInterval time.Duration
+	stopChan     chan struct{}
+
+	deliveryTimeout time.Duration // Maximum time a single send to a target platform may take
+	retry           retryPolicy   // How failed deliveries are retried
+
+	autoCreateThreads bool       // Create Discord threads for Telegram forum topics without one
+	threadsMu         sync.Mutex // Serializes thread lookup and creation
+
+	reactionTimers map[string]*time.Timer // Discord message ID -> pending debounced Telegram edit
+	reactionMu     sync.Mutex
+
+	channelStats   map[string]*channelStats // channelID -> bridged message counts
+	channelStatsMu sync.Mutex
+
+	leaderboards   map[string]*cachedLeaderboard // canonical bridge ID -> cached top users
+	leaderboardsMu sync.Mutex
+
+	filteredCounts   map[string]int // filter type -> messages dropped since startup
+	filteredCountsMu sync.Mutex
+
+	rateLimits   map[string]*messageBucket // source channelID -> rate limit token bucket
+	rateLimitsMu sync.Mutex
+
+	lastUserMessages   map[string]map[string]userMessage // source channelID -> platform:userID -> previous message
+	lastUserMessagesMu sync.Mutex
+
+	messageRate messageRate // Messages bridged in the last minute
+
+	deliveryFailures   map[string]int // connection ID -> failed deliveries in a row
+	deliveryFailuresMu sync.Mutex
+
+	syncedTopics         map[string]string // platform:channelID -> topic set by topic sync, to skip the change it causes
+	telegramDescriptions map[string]string // Telegram chatID -> description at the last topic sync check
+	syncedTopicsMu       sync.Mutex
+
+	chatAdmins   map[string]map[string]bool // Telegram chatID -> administrator user IDs at the last admin role sync check
+	chatAdminsMu sync.Mutex
+
+	downtime   downtimeState
+	downtimeMu sync.Mutex
+
+	events eventBus // Bridge activity for API subscribers
+
+	chatInfo *cache.LRU[string, *types.ChatInfo] // platform:chatID -> server or chat name and size
+
+	deduplicator *deduplicator // Recently bridged messages, to skip ones processed twice
+
+	errorHandler func(err error, context map[string]string) // Optional, told about critical errors
+
+	timingLogger       *slog.Logger // Optional, told about each message's processing time
+	detailedTimingLogs bool         // Include the duration of each pipeline stage
+}
+
+// channelStats counts messages bridged from and into a channel since startup
+type channelStats struct {
+	sent     int
+	received int
 }
 
 // NewBridgeCore creates a new bridge core instance
@@ -24,8 +116,41 @@ func NewBridgeCore(db *database.Database) *BridgeCore {
 		platforms:    make(map[string]types.Platform),
 		connections:  make(map[string][]*types.BridgeConnection),
 		userMappings: make(map[string]map[string]string),
+		filters:      make(map[string][]compiledFilter),
 		db:           db,
+		health:       make(map[string]*platformHealth),
+		pingInterval: defaultPingInterval,
+		stopChan:     make(chan struct{}),
+
+		deliveryTimeout:   defaultDeliveryTimeout,
+		retry:             retryPolicy{maxRetries: defaultMaxRetries, initialDelay: defaultRetryDelay, maxDelay: defaultMaxRetryDelay},
+		autoCreateThreads: true,
+
+		reactionTimers: make(map[string]*time.Timer),
+		channelStats:   make(map[string]*channelStats),
+		leaderboards:   make(map[string]*cachedLeaderboard),
+		filteredCounts: make(map[string]int),
+		rateLimits:     make(map[string]*messageBucket),
+		chatInfo:       cache.NewLRU[string, *types.ChatInfo](chatInfoCacheSize, chatInfoTTL),
+
+		lastUserMessages: make(map[string]map[string]userMessage),
+		deduplicator:     newDeduplicator(defaultDeduplicationCacheSize),
+		deliveryFailures: make(map[string]int),
+
+		syncedTopics:         make(map[string]string),
+		telegramDescriptions: make(map[string]string),
+
+		chatAdmins: make(map[string]map[string]bool),
+
+		downtime: downtimeState{
+			delay:           defaultDowntimeNotifyDelay,
+			downMessage:     defaultDowntimeMessage,
+			restoredMessage: defaultRestoredMessage,
+		},
 	}
+
+	// Built-in transformers, toggled per bridge in isTransformerEnabled
+	bc.transformers = append(bc.transformers, transformers.NewEmojiNormalizerTransformer())
 	
 	// Load existing bridges from database
 	if err := bc.loadBridgesFromDB(); err != nil {
@@ -62,6 +187,17 @@ func (bc *BridgeCore) loadBridgesFromDB() error {
 			continue // Need at least 2 platforms for a bridge
 		}
 
+		config, err := bc.db.CreateOrGetBridgeConfig(roomID)
+		if err != nil {
+			log.Printf("⚠️ Failed to get bridge config for room %d: %v", roomID, err)
+		}
+
+		name := ""
+		if config != nil && config.Name != nil {
+			name = *config.Name
+		}
+		isActive := config == nil || config.IsActive
+
 		// Create bidirectional connections between all platforms in this room
 		for i, source := range mappings {
 			for j, target := range mappings {
@@ -70,23 +206,33 @@ func (bc *BridgeCore) loadBridgesFromDB() error {
 				}
 
 				connection := &types.BridgeConnection{
-					ID:              fmt.Sprintf("db_%d_%s_%s_%s_%s", roomID, source.Platform, source.PlatformRoomID, target.Platform, target.PlatformRoomID),
+					ID:              connectionID(source.Platform, source.PlatformRoomID, target.Platform, target.PlatformRoomID),
+					Name:            name,
 					SourcePlatform:  source.Platform,
 					SourceChannelID: source.PlatformRoomID,
 					TargetPlatform:  target.Platform,
 					TargetChannelID: target.PlatformRoomID,
-					IsActive:        true,
+					GuildID:         target.GuildID,
+					Priority:        target.Priority,
+					IsActive:        isActive,
 					CreatedAt:       source.CreatedAt,
 				}
 
 				// Add to connections map
+				bc.connectionsMu.Lock()
 				if bc.connections[source.PlatformRoomID] == nil {
 					bc.connections[source.PlatformRoomID] = make([]*types.BridgeConnection, 0)
 				}
 				bc.connections[source.PlatformRoomID] = append(bc.connections[source.PlatformRoomID], connection)
+				bc.connectionsMu.Unlock()
 				bridgeCount++
 			}
 		}
+
+		// Load content filters for this room
+		if err := bc.loadFiltersForRoom(roomID, mappings); err != nil {
+			log.Printf("⚠️ Failed to load content filters for room %d: %v", roomID, err)
+		}
 	}
 
 	if bridgeCount > 0 {
@@ -95,17 +241,215 @@ func (bc *BridgeCore) loadBridgesFromDB() error {
 	return nil
 }
 
+// loadFiltersForRoom compiles the room's filter rules and assigns them to each mapped channel
+func (bc *BridgeCore) loadFiltersForRoom(roomID int, mappings []*models.RoomMapping) error {
+	config, err := bc.db.CreateOrGetBridgeConfig(roomID)
+	if err != nil {
+		return fmt.Errorf("failed to get bridge config: %v", err)
+	}
+
+	rules, err := ParseFilterRules(config.FilterWords)
+	if err != nil {
+		return err
+	}
+
+	filters, err := compileFilters(rules)
+	bc.connectionsMu.Lock()
+	for _, mapping := range mappings {
+		bc.filters[mapping.PlatformRoomID] = filters
+	}
+	bc.connectionsMu.Unlock()
+	return err
+}
+
+// channelConnections returns a copy of the connections of a source channel, safe to use without holding connectionsMu
+func (bc *BridgeCore) channelConnections(channelID string) []*types.BridgeConnection {
+	bc.connectionsMu.RLock()
+	defer bc.connectionsMu.RUnlock()
+	return append([]*types.BridgeConnection(nil), bc.connections[channelID]...)
+}
+
+// allConnections returns a copy of the connections of every source channel, safe to use without holding connectionsMu
+func (bc *BridgeCore) allConnections() map[string][]*types.BridgeConnection {
+	bc.connectionsMu.RLock()
+	defer bc.connectionsMu.RUnlock()
+
+	all := make(map[string][]*types.BridgeConnection, len(bc.connections))
+	for channelID, connections := range bc.connections {
+		all[channelID] = append([]*types.BridgeConnection(nil), connections...)
+	}
+	return all
+}
+
+// channelFilters returns the compiled content filters of a source channel
+func (bc *BridgeCore) channelFilters(channelID string) []compiledFilter {
+	bc.connectionsMu.RLock()
+	defer bc.connectionsMu.RUnlock()
+	return bc.filters[channelID]
+}
+
+// bridgeEndpoint identifies one side of a bridge connection
+func bridgeEndpoint(platform, channelID string) string {
+	return fmt.Sprintf("%s_%s", platform, channelID)
+}
+
+// connectionID builds the stable directional ID of a bridge connection
+func connectionID(sourcePlatform, sourceChannelID, targetPlatform, targetChannelID string) string {
+	return bridgeEndpoint(sourcePlatform, sourceChannelID) + "_" + bridgeEndpoint(targetPlatform, targetChannelID)
+}
+
+// canonicalBridgeID returns the same ID for both directions of a bidirectional bridge
+func canonicalBridgeID(conn *types.BridgeConnection) string {
+	a := bridgeEndpoint(conn.SourcePlatform, conn.SourceChannelID)
+	b := bridgeEndpoint(conn.TargetPlatform, conn.TargetChannelID)
+	if a > b {
+		a, b = b, a
+	}
+	return a + "_" + b
+}
+
+// updateConnections replaces every connection matched by match with an updated copy and returns how many were replaced; callers hold connectionsMu.
+// Connections are never changed in place, so the ones handed out by channelConnections, allConnections and findConnection can be read without the lock.
+func (bc *BridgeCore) updateConnections(match func(*types.BridgeConnection) bool, update func(*types.BridgeConnection)) int {
+	updated := 0
+	for _, connections := range bc.connections {
+		for i, conn := range connections {
+			if !match(conn) {
+				continue
+			}
+			replacement := *conn
+			update(&replacement)
+			connections[i] = &replacement
+			updated++
+		}
+	}
+	return updated
+}
+
+// hasReverseConnection checks whether a connection has a matching connection in the opposite direction; callers hold connectionsMu
+func (bc *BridgeCore) hasReverseConnection(conn *types.BridgeConnection) bool {
+	for _, reverse := range bc.connections[conn.TargetChannelID] {
+		if reverse.TargetPlatform == conn.SourcePlatform && reverse.TargetChannelID == conn.SourceChannelID {
+			return true
+		}
+	}
+	return false
+}
+
 // RegisterPlatform registers a platform with the bridge core
 func (bc *BridgeCore) RegisterPlatform(platform types.Platform) {
 	bc.platforms[platform.GetName()] = platform
+	bc.userMappingsMu.Lock()
 	if bc.userMappings[platform.GetName()] == nil {
 		bc.userMappings[platform.GetName()] = make(map[string]string)
 	}
+	bc.userMappingsMu.Unlock()
 	log.Printf("🔌 Platform registered: %s", platform.GetName())
+
+	if telegramAdapter, ok := platform.(*TelegramAdapter); ok {
+		telegramAdapter.SetSilentModeResolver(bc.isSilent)
+		telegramAdapter.SetBridgeConfigResolver(bc.getBridgeConfig)
+	}
+	if discordAdapter, ok := platform.(*DiscordAdapter); ok {
+		discordAdapter.SetErrorReporter(bc.reportError)
+		discordAdapter.SetBridgeConfigResolver(bc.getBridgeConfig)
+	}
+	if webhookAdapter, ok := platform.(*WebhookAdapter); ok {
+		webhookAdapter.SetBridgeConfigResolver(bc.getBridgeConfig)
+	}
+
+	bc.healthMu.Lock()
+	bc.health[platform.GetName()] = &platformHealth{}
+	bc.healthMu.Unlock()
+
+	go bc.runHealthPings(platform)
+}
+
+// SetPingInterval sets how often registered platforms are health-pinged
+func (bc *BridgeCore) SetPingInterval(interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultPingInterval
+	}
+	bc.pingInterval = interval
+}
+
+// SetDeliveryTimeout sets how long a single send to a target platform may take, 0 disables the limit
+func (bc *BridgeCore) SetDeliveryTimeout(timeout time.Duration) {
+	bc.deliveryTimeout = timeout
+}
+
+// SetAutoCreateDiscordThreads sets whether Telegram forum topics without a Discord thread get one created
+func (bc *BridgeCore) SetAutoCreateDiscordThreads(enabled bool) {
+	bc.autoCreateThreads = enabled
+}
+
+// Stop stops background goroutines started by the bridge core
+func (bc *BridgeCore) Stop() {
+	close(bc.stopChan)
+}
+
+// runHealthPings periodically pings a platform until the bridge core is stopped
+func (bc *BridgeCore) runHealthPings(platform types.Platform) {
+	ticker := time.NewTicker(bc.pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			bc.pingPlatform(platform)
+		case <-bc.stopChan:
+			return
+		}
+	}
+}
+
+// pingPlatform runs a single health ping and records the result
+func (bc *BridgeCore) pingPlatform(platform types.Platform) {
+	name := platform.GetName()
+
+	ctx, cancel := context.WithTimeout(context.Background(), bc.pingInterval)
+	defer cancel()
+	err := platform.Ping(ctx)
+
+	bc.healthMu.Lock()
+	health := bc.health[name]
+	wasHealthy := health.lastPingError == nil
+	health.lastPingError = err
+	health.lastPingAt = time.Now()
+	bc.healthMu.Unlock()
+
+	metrics.SetPlatformConnected(name, err == nil)
+
+	if err != nil {
+		log.Printf("⚠️ Health ping failed for %s: %v", name, err)
+		if wasHealthy {
+			bc.publishEvent(types.EventPlatformDisconnected, map[string]string{"platform": name, "error": err.Error()})
+		}
+	} else if !wasHealthy {
+		log.Printf("✅ Health ping recovered for %s", name)
+		bc.publishEvent(types.EventPlatformConnected, map[string]string{"platform": name})
+	}
+}
+
+// SetErrorHandler sets a function told about critical errors, such as a delivery that could not be retried
+func (bc *BridgeCore) SetErrorHandler(fn func(err error, context map[string]string)) {
+	bc.errorHandler = fn
+}
+
+// reportError passes a critical error to the error handler without blocking the caller
+func (bc *BridgeCore) reportError(err error, context map[string]string) {
+	if bc.errorHandler != nil {
+		go bc.errorHandler(err, context)
+	}
 }
 
 // AddBridge creates a new bridge connection and persists it to database
 func (bc *BridgeCore) AddBridge(sourcePlatform, sourceChannelID, targetPlatform, targetChannelID string) error {
+	return bc.AddBridgeContext(context.Background(), sourcePlatform, sourceChannelID, targetPlatform, targetChannelID)
+}
+
+// AddBridgeContext adds a bridge; log lines carry the request ID of the context, if any
+func (bc *BridgeCore) AddBridgeContext(ctx context.Context, sourcePlatform, sourceChannelID, targetPlatform, targetChannelID string) error {
 	// Validate platforms
 	if _, exists := bc.platforms[sourcePlatform]; !exists {
 		return fmt.Errorf("source platform %s not registered", sourcePlatform)
@@ -114,25 +458,39 @@ func (bc *BridgeCore) AddBridge(sourcePlatform, sourceChannelID, targetPlatform,
 		return fmt.Errorf("target platform %s not registered", targetPlatform)
 	}
 
+	// Resolve the Discord servers of both channels; they may differ for cross-guild bridges
+	sourceGuildID, err := bc.channelGuildID(sourcePlatform, sourceChannelID)
+	if err != nil {
+		return err
+	}
+	targetGuildID, err := bc.channelGuildID(targetPlatform, targetChannelID)
+	if err != nil {
+		return err
+	}
+
 	// Persist to database if available
 	if bc.db != nil {
-		if err := bc.saveBridgeToDatabase(sourcePlatform, sourceChannelID, targetPlatform, targetChannelID); err != nil {
+		if err := bc.saveBridgeToDatabase(sourcePlatform, sourceChannelID, sourceGuildID, targetPlatform, targetChannelID, targetGuildID); err != nil {
+			logf(ctx, "❌ Failed to save bridge to database: %v", err)
 			return fmt.Errorf("failed to save bridge to database: %v", err)
 		}
+		logf(ctx, "💾 Bridge saved to database: %s #%s ↔ %s #%s", sourcePlatform, sourceChannelID, targetPlatform, targetChannelID)
 	}
 
 	// Create bridge connections in memory
 	connection := &types.BridgeConnection{
-		ID:              fmt.Sprintf("%s_%s_%s_%s", sourcePlatform, sourceChannelID, targetPlatform, targetChannelID),
+		ID:              connectionID(sourcePlatform, sourceChannelID, targetPlatform, targetChannelID),
 		SourcePlatform:  sourcePlatform,
 		SourceChannelID: sourceChannelID,
 		TargetPlatform:  targetPlatform,
 		TargetChannelID: targetChannelID,
+		GuildID:         targetGuildID,
 		IsActive:        true,
 		CreatedAt:       time.Now(),
 	}
 
 	// Add to connections map
+	bc.connectionsMu.Lock()
 	if bc.connections[sourceChannelID] == nil {
 		bc.connections[sourceChannelID] = make([]*types.BridgeConnection, 0)
 	}
@@ -140,11 +498,12 @@ func (bc *BridgeCore) AddBridge(sourcePlatform, sourceChannelID, targetPlatform,
 
 	// Also add reverse connection for bidirectional bridging
 	reverseConnection := &types.BridgeConnection{
-		ID:              fmt.Sprintf("%s_%s_%s_%s", targetPlatform, targetChannelID, sourcePlatform, sourceChannelID),
+		ID:              connectionID(targetPlatform, targetChannelID, sourcePlatform, sourceChannelID),
 		SourcePlatform:  targetPlatform,
 		SourceChannelID: targetChannelID,
 		TargetPlatform:  sourcePlatform,
 		TargetChannelID: sourceChannelID,
+		GuildID:         sourceGuildID,
 		IsActive:        true,
 		CreatedAt:       time.Now(),
 	}
@@ -153,13 +512,176 @@ func (bc *BridgeCore) AddBridge(sourcePlatform, sourceChannelID, targetPlatform,
 		bc.connections[targetChannelID] = make([]*types.BridgeConnection, 0)
 	}
 	bc.connections[targetChannelID] = append(bc.connections[targetChannelID], reverseConnection)
+	bc.connectionsMu.Unlock()
 
-	log.Printf("🌉 Bridge added: %s #%s ↔ %s #%s", sourcePlatform, sourceChannelID, targetPlatform, targetChannelID)
+	if sourceGuildID != "" && targetGuildID != "" && sourceGuildID != targetGuildID {
+		logf(ctx, "🌐 Cross-guild bridge: Discord server %s ↔ %s", sourceGuildID, targetGuildID)
+	}
+	logf(ctx, "🌉 Bridge added: %s #%s ↔ %s #%s", sourcePlatform, sourceChannelID, targetPlatform, targetChannelID)
+	bc.publishBridgeEvent(types.EventBridgeCreated, sourcePlatform, sourceChannelID, targetPlatform, targetChannelID)
+	return nil
+}
+
+// logf logs like log.Printf, prefixed with the request ID carried by ctx so lines can be correlated with API requests
+func logf(ctx context.Context, format string, args ...interface{}) {
+	if id := logger.RequestID(ctx); id != "" {
+		format = "[request_id=" + id + "] " + format
+	}
+	log.Printf(format, args...)
+}
+
+// channelGuildID returns the Discord server of a channel, or "" for other platforms
+func (bc *BridgeCore) channelGuildID(platform, channelID string) (string, error) {
+	adapter, ok := bc.platforms[platform].(*DiscordAdapter)
+	if !ok {
+		return "", nil
+	}
+
+	guildID, err := adapter.ChannelGuildID(channelID)
+	if err != nil {
+		return "", fmt.Errorf("cannot access Discord channel %s (is the bot in its server?): %v", channelID, err)
+	}
+	return guildID, nil
+}
+
+// ResolveMissingGuildIDs looks up the Discord server of bridged channels stored without one, such as those
+// bridged before cross-server support, so server-wide commands like /config bulkupdate include them
+func (bc *BridgeCore) ResolveMissingGuildIDs() {
+	if bc.db == nil {
+		return
+	}
+
+	bridges, err := bc.db.GetAllActiveBridges()
+	if err != nil {
+		log.Printf("⚠️ Failed to load bridges to resolve their Discord servers: %v", err)
+		return
+	}
+
+	resolved := 0
+	guildIDs := make(map[string]string) // channelID -> guildID, a channel may be mapped in several rooms
+	for _, mappings := range bridges {
+		for _, mapping := range mappings {
+			if mapping.Platform != types.PlatformDiscord || mapping.GuildID != "" {
+				continue
+			}
+
+			guildID, ok := guildIDs[mapping.PlatformRoomID]
+			if !ok {
+				guildID, err = bc.channelGuildID(mapping.Platform, mapping.PlatformRoomID)
+				if err != nil {
+					log.Printf("⚠️ Failed to resolve the Discord server of channel %s: %v", mapping.PlatformRoomID, err)
+				}
+				guildIDs[mapping.PlatformRoomID] = guildID
+			}
+			if guildID == "" {
+				continue
+			}
+
+			if err := bc.db.SetRoomMappingGuild(mapping.RoomID, mapping.Platform, mapping.PlatformRoomID, guildID); err != nil {
+				log.Printf("⚠️ Failed to save the Discord server of channel %s: %v", mapping.PlatformRoomID, err)
+				continue
+			}
+			resolved++
+		}
+	}
+
+	bc.connectionsMu.Lock()
+	bc.updateConnections(func(conn *types.BridgeConnection) bool {
+		return conn.TargetPlatform == types.PlatformDiscord && conn.GuildID == "" && guildIDs[conn.TargetChannelID] != ""
+	}, func(conn *types.BridgeConnection) {
+		conn.GuildID = guildIDs[conn.TargetChannelID]
+	})
+	bc.connectionsMu.Unlock()
+
+	if resolved > 0 {
+		log.Printf("🏠 Resolved the Discord server of %d bridged channels", resolved)
+	}
+}
+
+// SetBridgeName names the bridge a connection belongs to; both directions share the name
+func (bc *BridgeCore) SetBridgeName(connectionID, name string) error {
+	name = strings.TrimSpace(name)
+	if err := ValidateBridgeName(name); err != nil {
+		return err
+	}
+
+	connection := bc.findConnection(connectionID)
+	if connection == nil {
+		return fmt.Errorf("bridge %s not found", connectionID)
+	}
+
+	if bc.db != nil {
+		err := bc.db.SetBridgeName(connection.SourcePlatform, connection.SourceChannelID,
+			connection.TargetPlatform, connection.TargetChannelID, name)
+		if err != nil {
+			return fmt.Errorf("failed to save bridge name: %v", err)
+		}
+	}
+
+	bc.connectionsMu.Lock()
+	defer bc.connectionsMu.Unlock()
+
+	bridgeID := canonicalBridgeID(connection)
+	bc.updateConnections(func(conn *types.BridgeConnection) bool {
+		return canonicalBridgeID(conn) == bridgeID
+	}, func(conn *types.BridgeConnection) {
+		conn.Name = name
+	})
+
+	log.Printf("🏷️ Bridge %s renamed to %q", connectionID, name)
+	return nil
+}
+
+// SetBridgePriority sets the delivery priority of every bridge into the target channel of a connection
+func (bc *BridgeCore) SetBridgePriority(connectionID string, priority int) error {
+	if priority < types.MinBridgePriority || priority > types.MaxBridgePriority {
+		return fmt.Errorf("priority must be between %d and %d", types.MinBridgePriority, types.MaxBridgePriority)
+	}
+
+	connection := bc.findConnection(connectionID)
+	if connection == nil {
+		return fmt.Errorf("bridge %s not found", connectionID)
+	}
+
+	if bc.db != nil {
+		if err := bc.db.SetRoomMappingPriority(connection.TargetPlatform, connection.TargetChannelID, priority); err != nil {
+			return fmt.Errorf("failed to save bridge priority: %v", err)
+		}
+	}
+
+	bc.connectionsMu.Lock()
+	bc.updateConnections(func(conn *types.BridgeConnection) bool {
+		return conn.TargetPlatform == connection.TargetPlatform && conn.TargetChannelID == connection.TargetChannelID
+	}, func(conn *types.BridgeConnection) {
+		conn.Priority = priority
+	})
+	bc.connectionsMu.Unlock()
+
+	log.Printf("🔢 Bridges into %s channel %s now have priority %d", connection.TargetPlatform, connection.TargetChannelID, priority)
+	return nil
+}
+
+// sortByPriority returns the connections ordered by priority, highest first, keeping the order of equal ones
+func sortByPriority(connections []*types.BridgeConnection) []*types.BridgeConnection {
+	sorted := make([]*types.BridgeConnection, len(connections))
+	copy(sorted, connections)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Priority > sorted[j].Priority
+	})
+	return sorted
+}
+
+// ValidateBridgeName checks that a bridge name is between 1 and 50 characters
+func ValidateBridgeName(name string) error {
+	length := utf8.RuneCountInString(strings.TrimSpace(name))
+	if length < 1 || length > 50 {
+		return fmt.Errorf("bridge name must be 1-50 characters")
+	}
 	return nil
 }
 
 // saveBridgeToDatabase saves a bridge configuration to the database
-func (bc *BridgeCore) saveBridgeToDatabase(sourcePlatform, sourceChannelID, targetPlatform, targetChannelID string) error {
+func (bc *BridgeCore) saveBridgeToDatabase(sourcePlatform, sourceChannelID, sourceGuildID, targetPlatform, targetChannelID, targetGuildID string) error {
 	// Create a unique room name for this bridge
 	roomName := fmt.Sprintf("bridge_%s_%s_%s_%s", sourcePlatform, sourceChannelID, targetPlatform, targetChannelID)
 	
@@ -182,6 +704,18 @@ func (bc *BridgeCore) saveBridgeToDatabase(sourcePlatform, sourceChannelID, targ
 		return fmt.Errorf("failed to create target room mapping: %v", err)
 	}
 
+	// Record Discord servers so cross-guild bridges survive restarts
+	if sourceGuildID != "" {
+		if err := bc.db.SetRoomMappingGuild(room.ID, sourcePlatform, sourceChannelID, sourceGuildID); err != nil {
+			return err
+		}
+	}
+	if targetGuildID != "" {
+		if err := bc.db.SetRoomMappingGuild(room.ID, targetPlatform, targetChannelID, targetGuildID); err != nil {
+			return err
+		}
+	}
+
 	// Create bridge config
 	_, err = bc.db.CreateOrGetBridgeConfig(room.ID)
 	if err != nil {
@@ -193,45 +727,65 @@ func (bc *BridgeCore) saveBridgeToDatabase(sourcePlatform, sourceChannelID, targ
 
 // RemoveBridge removes a bridge connection and updates database
 func (bc *BridgeCore) RemoveBridge(sourceChannelID, targetPlatform string) error {
-	connections := bc.connections[sourceChannelID]
+	connections := bc.channelConnections(sourceChannelID)
 	if connections == nil {
 		return fmt.Errorf("no bridges found for channel %s", sourceChannelID)
 	}
 
-	var removedConnection *types.BridgeConnection
-
-	// Find and remove the connection
-	for i, conn := range connections {
+	for _, conn := range connections {
 		if conn.TargetPlatform == targetPlatform {
-			removedConnection = conn
-			// Remove from source connections
+			bc.removeConnection(conn)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("bridge to %s not found for channel %s", targetPlatform, sourceChannelID)
+}
+
+// RemoveBridgeByID removes the bridge connection with the given ID and updates database
+func (bc *BridgeCore) RemoveBridgeByID(connectionID string) error {
+	if conn := bc.findConnection(connectionID); conn != nil {
+		bc.removeConnection(conn)
+		return nil
+	}
+
+	return fmt.Errorf("bridge %s not found", connectionID)
+}
+
+// removeConnection removes a bridge connection together with its reverse connection
+func (bc *BridgeCore) removeConnection(conn *types.BridgeConnection) {
+	sourceChannelID := conn.SourceChannelID
+
+	bc.connectionsMu.Lock()
+	connections := bc.connections[sourceChannelID]
+	for i, c := range connections {
+		if c.ID == conn.ID {
 			bc.connections[sourceChannelID] = append(connections[:i], connections[i+1:]...)
-			
-			// Remove reverse connection
-			reverseConnections := bc.connections[conn.TargetChannelID]
-			for j, reverseConn := range reverseConnections {
-				if reverseConn.TargetChannelID == sourceChannelID && reverseConn.TargetPlatform == conn.SourcePlatform {
-					bc.connections[conn.TargetChannelID] = append(reverseConnections[:j], reverseConnections[j+1:]...)
-					break
-				}
-			}
 			break
 		}
 	}
 
-	if removedConnection == nil {
-		return fmt.Errorf("bridge to %s not found for channel %s", targetPlatform, sourceChannelID)
+	// Remove reverse connection
+	reverseConnections := bc.connections[conn.TargetChannelID]
+	for j, reverseConn := range reverseConnections {
+		if reverseConn.TargetChannelID == sourceChannelID && reverseConn.TargetPlatform == conn.SourcePlatform {
+			bc.connections[conn.TargetChannelID] = append(reverseConnections[:j], reverseConnections[j+1:]...)
+			break
+		}
 	}
+	bc.connectionsMu.Unlock()
+
+	bc.clearDeliveryFailures(conn.ID, connectionID(conn.TargetPlatform, conn.TargetChannelID, conn.SourcePlatform, sourceChannelID))
 
 	// Remove from database if available
 	if bc.db != nil {
-		if err := bc.removeBridgeFromDatabase(removedConnection.SourcePlatform, sourceChannelID, targetPlatform); err != nil {
+		if err := bc.removeBridgeFromDatabase(conn.SourcePlatform, sourceChannelID, conn.TargetPlatform); err != nil {
 			log.Printf("⚠️ Failed to remove bridge from database: %v", err)
 		}
 	}
 
-	log.Printf("🗑️ Bridge removed: %s #%s ↔ %s #%s", removedConnection.SourcePlatform, sourceChannelID, targetPlatform, removedConnection.TargetChannelID)
-	return nil
+	log.Printf("🗑️ Bridge removed: %s #%s ↔ %s #%s", conn.SourcePlatform, sourceChannelID, conn.TargetPlatform, conn.TargetChannelID)
+	bc.publishBridgeEvent(types.EventBridgeRemoved, conn.SourcePlatform, sourceChannelID, conn.TargetPlatform, conn.TargetChannelID)
 }
 
 // removeBridgeFromDatabase removes a bridge from the database
@@ -254,20 +808,95 @@ func (bc *BridgeCore) removeBridgeFromDatabase(sourcePlatform, sourceChannelID,
 
 // ProcessMessage processes and bridges a message to connected platforms
 func (bc *BridgeCore) ProcessMessage(message *types.BridgeMessage) error {
+	return bc.ProcessMessageContext(context.Background(), message)
+}
+
+// ProcessMessageContext bridges a message; its trace spans are children of the span carried by ctx, if any
+func (bc *BridgeCore) ProcessMessageContext(ctx context.Context, message *types.BridgeMessage) error {
+	ctx, span := tracer.Start(ctx, "process_message", oteltrace.WithAttributes(
+		attribute.String("bridge.source_platform", message.SourcePlatform),
+		attribute.String("bridge.source_channel", message.SourceChannelID),
+		attribute.String("bridge.source_user", message.SourceUserID),
+		attribute.String("bridge.message_type", message.MessageType),
+	))
+	defer span.End()
+
+	trace := newProcessingTrace()
+
 	// Get connections for this channel
-	connections := bc.connections[message.SourceChannelID]
+	connections := bc.channelConnections(message.SourceChannelID)
 	if len(connections) == 0 {
 		log.Printf("⚠️ No bridges configured for %s channel %s", message.SourcePlatform, message.SourceChannelID)
+		bc.recordFiltered(message, "none", FilterTypeNoConnection)
+		return nil
+	}
+
+	// Drop messages from senders outside the bridge's whitelist
+	if !bc.isSenderAllowed(message) {
+		log.Printf("⛔ Dropping message from %s user %s (not in bridge whitelist)", message.SourcePlatform, message.SourceUserID)
+		bc.recordFilteredForAll(message, connections, FilterTypeSenderNotAllowed)
+		return nil
+	}
+
+	// Check whether this message type is enabled for the bridge
+	if !bc.isMessageTypeEnabled(message) {
+		log.Printf("⏭️ Skipping %s message from %s channel %s (disabled in bridge config)", message.MessageType, message.SourcePlatform, message.SourceChannelID)
+		bc.recordFilteredForAll(message, connections, FilterTypeMediaNotAllowed)
+		return nil
+	}
+
+	// Drop messages over the bridge's rate limit
+	if !bc.allowMessage(message) {
+		log.Printf("🚦 Dropping message from %s channel %s (rate limit exceeded)", message.SourcePlatform, message.SourceChannelID)
+		bc.recordFilteredForAll(message, connections, FilterTypeRateLimit)
 		return nil
 	}
 
+	// Shorten long messages sent in quick succession by the same user
+	bc.truncateUserSpam(message, connections)
+
+	// Apply content filters configured for this bridge
+	if filters := bc.channelFilters(message.SourceChannelID); len(filters) > 0 {
+		content, blocked := applyFilters(filters, message.Content)
+		if blocked {
+			log.Printf("🚫 Message from %s (room: %s) blocked by content filter", message.SourcePlatform, message.SourceChannelID)
+			bc.recordFilteredForAll(message, connections, FilterTypeWordFilter)
+			return nil
+		}
+		message.Content = content
+	}
+
+	// Skip messages already bridged, e.g. redelivered after a restart
+	if bc.deduplicator.isDuplicate(message) {
+		log.Printf("♻️ Skipping duplicate message from %s channel %s", message.SourcePlatform, message.SourceChannelID)
+		bc.recordFilteredForAll(message, connections, FilterTypeDuplicate)
+		return nil
+	}
+
+	// Run the transformer pipeline
+	transformStart := time.Now()
+	bc.applyTransformers(message)
+	trace.recordTransformers(transformStart)
+	defer bc.logProcessingTrace(message, trace)
+
 	log.Printf("🔄 Processing message from %s (room: %s): %s", message.SourcePlatform, message.SourceChannelID, message.Content)
 	log.Printf("   Found %d bridge connections for this channel", len(connections))
 
-	// Bridge to all connected platforms
-	for _, connection := range connections {
+	// Bridges with a delivery delay hand their messages to the queue instead
+	delay := bc.deliveryDelay(message)
+
+	// Bridge to all connected platforms, highest priority first
+	delivered := false
+	for _, connection := range sortByPriority(connections) {
+		start := time.Now()
 		if !connection.IsActive {
 			log.Printf("⏭️ Skipping inactive bridge: %s → %s", connection.SourcePlatform, connection.TargetPlatform)
+			bc.recordFiltered(message, connection.TargetPlatform, FilterTypeBridgeInactive)
+			bc.recordBridgeEvent(BridgeEventDelivery, message, connection, DeliveryStatusSkipped, fmt.Errorf("bridge is inactive"), start)
+			continue
+		}
+
+		if delay > 0 && bc.enqueueDelayed(connection, message, delay) {
 			continue
 		}
 
@@ -277,44 +906,160 @@ func (bc *BridgeCore) ProcessMessage(message *types.BridgeMessage) error {
 		targetPlatform := bc.platforms[connection.TargetPlatform]
 		if targetPlatform == nil || !targetPlatform.IsConnected() {
 			log.Printf("⚠️ Target platform %s not available or not connected", connection.TargetPlatform)
+			bc.recordFiltered(message, connection.TargetPlatform, FilterTypeNoConnection)
+			bc.recordBridgeEvent(BridgeEventDelivery, message, connection, DeliveryStatusSkipped, fmt.Errorf("%s is not connected", connection.TargetPlatform), start)
 			continue
 		}
 
-		// Special handling for Discord webhook messages
-		if connection.TargetPlatform == types.PlatformDiscord {
-			if discordAdapter, ok := targetPlatform.(*DiscordAdapter); ok {
-				err := discordAdapter.SendBridgeMessage(connection.TargetChannelID, message)
-				if err != nil {
-					log.Printf("❌ Failed to send Discord webhook message: %v", err)
-					// Fallback to regular message
-					formattedMessage := targetPlatform.FormatMessage(message)
-					err = targetPlatform.SendMessage(connection.TargetChannelID, formattedMessage)
-				}
-			} else {
-				// Fallback to regular message
-				formattedMessage := targetPlatform.FormatMessage(message)
-				err := targetPlatform.SendMessage(connection.TargetChannelID, formattedMessage)
-				if err != nil {
-					log.Printf("❌ Failed to bridge message to %s: %v", connection.TargetPlatform, err)
-					continue
-				}
-			}
-		} else {
-			// Send regular message
-			formattedMessage := targetPlatform.FormatMessage(message)
-			err := targetPlatform.SendMessage(connection.TargetChannelID, formattedMessage)
-			if err != nil {
-				log.Printf("❌ Failed to bridge message to %s: %v", connection.TargetPlatform, err)
+		err := bc.sendToPlatform(ctx, connection, targetPlatform, message)
+		trace.recordSend(connection.TargetPlatform, start)
+		if err != nil {
+			dbStart := time.Now()
+			if err == errDeliveryTimeout {
+				log.Printf("⏱️ Delivery to %s channel %s timed out after %s", connection.TargetPlatform, connection.TargetChannelID, bc.deliveryTimeout)
+				metrics.IncMessageDeliveryTimeouts(connection.TargetPlatform)
+				bc.saveMessageMappingStatus(message, connection, "timeout_"+message.ID, "timeout")
+				bc.recordBridgeEvent(BridgeEventDelivery, message, connection, DeliveryStatusTimeout, err, start)
+				bc.enqueueRetry(connection, message, err)
+				trace.recordDBWrite(dbStart)
+				bc.acknowledgeDelivery(message, connection, false)
 				continue
 			}
+			log.Printf("❌ Failed to bridge message to %s: %v", connection.TargetPlatform, err)
+			bc.recordBridgeEvent(BridgeEventDelivery, message, connection, DeliveryStatusFailed, err, start)
+			bc.enqueueRetry(connection, message, err)
+			trace.recordDBWrite(dbStart)
+			bc.acknowledgeDelivery(message, connection, false)
+			continue
 		}
 
+		dbStart := time.Now()
+		bc.recordBridgeEvent(BridgeEventDelivery, message, connection, DeliveryStatusDelivered, nil, start)
+		bc.recordUserActivity(message, connection)
+		trace.recordDBWrite(dbStart)
+
+		bc.recordBridgedMessage(message.SourceChannelID, connection.TargetChannelID)
+		bc.acknowledgeDelivery(message, connection, true)
+		bc.publishMessageBridged(message, connection)
+		if len(message.MediaBytes) > 0 {
+			metrics.AddMediaBytes(connection.TargetPlatform, len(message.MediaBytes))
+		}
 		log.Printf("✅ Message bridged: %s → %s", message.SourcePlatform, connection.TargetPlatform)
+		delivered = true
 	}
 
+	if delivered {
+		bc.recordMessageRate()
+	}
 	return nil
 }
 
+// sendToPlatform delivers a message to one bridge target inside a "send_to_platform" trace span
+func (bc *BridgeCore) sendToPlatform(ctx context.Context, connection *types.BridgeConnection, targetPlatform types.Platform, message *types.BridgeMessage) error {
+	_, span := tracer.Start(ctx, "send_to_platform", oteltrace.WithAttributes(
+		attribute.String("bridge.connection_id", connection.ID),
+		attribute.String("bridge.target_platform", connection.TargetPlatform),
+		attribute.String("bridge.target_channel", connection.TargetChannelID),
+	))
+	defer span.End()
+
+	err := bc.deliverWithTimeout(connection, targetPlatform, message)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+// AddTransformer appends a transformer to the message pipeline
+func (bc *BridgeCore) AddTransformer(transformer types.Transformer) {
+	bc.transformers = append(bc.transformers, transformer)
+	log.Printf("🔧 Transformer registered: %s", transformer.Name())
+}
+
+// applyTransformers runs all registered transformers, skipping any that fail
+func (bc *BridgeCore) applyTransformers(message *types.BridgeMessage) {
+	for _, transformer := range bc.transformers {
+		if !bc.isTransformerEnabled(transformer, message) {
+			continue
+		}
+		if err := transformer.Transform(message); err != nil {
+			log.Printf("⚠️ Transformer %s failed: %v", transformer.Name(), err)
+		}
+	}
+}
+
+// isTransformerEnabled checks built-in transformers against the source bridge's configuration
+func (bc *BridgeCore) isTransformerEnabled(transformer types.Transformer, message *types.BridgeMessage) bool {
+	if transformer.Name() != transformers.EmojiNormalizerName {
+		return true
+	}
+
+	config, err := bc.getBridgeConfig(message.SourcePlatform, message.SourceChannelID)
+	if err != nil {
+		log.Printf("⚠️ Failed to get bridge config for %s channel %s: %v", message.SourcePlatform, message.SourceChannelID, err)
+		return true
+	}
+	return config.NormalizeEmoji
+}
+
+// isMessageTypeEnabled checks the message type against the source bridge's allowed types and opt-in settings
+func (bc *BridgeCore) isMessageTypeEnabled(message *types.BridgeMessage) bool {
+	optIn := false
+	switch message.MessageType {
+	case types.MessageTypeEvent, types.MessageTypeVoiceEvent, types.MessageTypeVoiceActivity, types.MessageTypePin, types.MessageTypeSystem:
+		optIn = true
+	}
+	if !optIn && bc.db == nil {
+		return true
+	}
+
+	config, err := bc.getBridgeConfig(message.SourcePlatform, message.SourceChannelID)
+	if err != nil {
+		log.Printf("⚠️ Failed to get bridge config for %s channel %s: %v", message.SourcePlatform, message.SourceChannelID, err)
+		return !optIn
+	}
+
+	if !config.AllowsMessageType(message.MessageType) {
+		return false
+	}
+	if message.MessageType == types.MessageTypeLocation {
+		return config.BridgeLocations
+	}
+	if !optIn {
+		return true
+	}
+
+	if message.MessageType == types.MessageTypeVoiceEvent {
+		return config.BridgeVoiceEvents
+	}
+	if message.MessageType == types.MessageTypeVoiceActivity {
+		return config.BridgeVoiceActivity
+	}
+	if message.MessageType == types.MessageTypePin {
+		return config.BridgePins
+	}
+	if message.MessageType == types.MessageTypeSystem {
+		return config.BridgeSystemEvents
+	}
+	return config.BridgeChatPhotoChanges
+}
+
+// isSenderAllowed checks the message sender against the source bridge's whitelist
+func (bc *BridgeCore) isSenderAllowed(message *types.BridgeMessage) bool {
+	// Voice activity notifications are not sent by a user
+	if bc.db == nil || message.MessageType == types.MessageTypeVoiceActivity {
+		return true
+	}
+
+	config, err := bc.getBridgeConfig(message.SourcePlatform, message.SourceChannelID)
+	if err != nil {
+		log.Printf("⚠️ Failed to get bridge config for %s channel %s: %v", message.SourcePlatform, message.SourceChannelID, err)
+		return true
+	}
+	return config.IsSenderAllowed(message.SourcePlatform, message.SourceUserID)
+}
+
 // ProcessMessageLegacy processes and bridges a message (legacy method for backward compatibility)
 func (bc *BridgeCore) ProcessMessageLegacy(sourcePlatform, channelID, userID, messageType, content string) error {
 	log.Printf("🔄 ProcessMessageLegacy called:")
@@ -325,7 +1070,7 @@ func (bc *BridgeCore) ProcessMessageLegacy(sourcePlatform, channelID, userID, me
 	log.Printf("   Content: %s", content)
 	
 	// Check if we have any connections for this channel
-	connections := bc.connections[channelID]
+	connections := bc.channelConnections(channelID)
 	log.Printf("   Connections found: %d", len(connections))
 	
 	if len(connections) == 0 {
@@ -348,26 +1093,224 @@ func (bc *BridgeCore) ProcessMessageLegacy(sourcePlatform, channelID, userID, me
 	return bc.ProcessMessage(message)
 }
 
+// getBridgeConfig returns the bridge configuration of the room a channel is mapped to
+func (bc *BridgeCore) getBridgeConfig(platform, channelID string) (*models.BridgeConfig, error) {
+	if bc.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	mapping, err := bc.db.GetRoomMappingByPlatformRoom(platform, channelID)
+	if err != nil {
+		return nil, fmt.Errorf("no bridge configured for %s channel %s", platform, channelID)
+	}
+
+	return bc.db.CreateOrGetBridgeConfig(mapping.RoomID)
+}
+
+// isSilent reports whether messages to a Telegram chat should be sent without notification
+func (bc *BridgeCore) isSilent(chatID string) bool {
+	if bc.db == nil {
+		return false
+	}
+
+	config, err := bc.getBridgeConfig(types.PlatformTelegram, chatID)
+	if err != nil {
+		return false
+	}
+	return config.IsSilentAt(time.Now())
+}
+
+// GetFilterRules returns the content filter rules for the bridge a channel belongs to
+func (bc *BridgeCore) GetFilterRules(platform, channelID string) ([]models.FilterRule, error) {
+	config, err := bc.getBridgeConfig(platform, channelID)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFilterRules(config.FilterWords)
+}
+
+// AddFilterRule appends a content filter rule to the bridge a channel belongs to
+func (bc *BridgeCore) AddFilterRule(platform, channelID string, rule models.FilterRule) error {
+	if err := ValidateFilterRule(rule); err != nil {
+		return err
+	}
+
+	config, err := bc.getBridgeConfig(platform, channelID)
+	if err != nil {
+		return err
+	}
+
+	rules, err := ParseFilterRules(config.FilterWords)
+	if err != nil {
+		return err
+	}
+	rules = append(rules, rule)
+
+	if err := bc.db.UpdateFilterRules(config.RoomID, rules); err != nil {
+		return err
+	}
+
+	// Refresh compiled filters for every channel in this room
+	mappings, err := bc.db.GetActiveRoomMappings(config.RoomID)
+	if err != nil {
+		return fmt.Errorf("failed to get room mappings: %v", err)
+	}
+	if err := bc.loadFiltersForRoom(config.RoomID, mappings); err != nil {
+		log.Printf("⚠️ Failed to reload content filters for room %d: %v", config.RoomID, err)
+	}
+
+	log.Printf("🛡️ Filter rule added for %s channel %s: %s (%s, %s)", platform, channelID, rule.Pattern, rule.Mode, rule.Action)
+	return nil
+}
+
+// SetBridgeEmbedColor sets the Discord embed color of the bridge a channel belongs to
+func (bc *BridgeCore) SetBridgeEmbedColor(platform, channelID string, color int) error {
+	if color < 0 || color > 0xFFFFFF {
+		return fmt.Errorf("color must be between #000000 and #FFFFFF")
+	}
+
+	config, err := bc.getBridgeConfig(platform, channelID)
+	if err != nil {
+		return err
+	}
+
+	if err := bc.db.UpdateBridgeEmbedColor(config.RoomID, color); err != nil {
+		return err
+	}
+
+	log.Printf("🎨 Embed color of room %d set to #%06X", config.RoomID, color)
+	return nil
+}
+
+// GetBridgeConfig returns the configuration of the bridge a channel belongs to
+func (bc *BridgeCore) GetBridgeConfig(platform, channelID string) (*models.BridgeConfig, error) {
+	return bc.getBridgeConfig(platform, channelID)
+}
+
+// BulkUpdateBridgeConfig sets one config field of every bridge with a channel in a Discord server
+func (bc *BridgeCore) BulkUpdateBridgeConfig(guildID, field, value string) (int, error) {
+	if bc.db == nil {
+		return 0, fmt.Errorf("database not initialized")
+	}
+	return bc.db.BulkUpdateBridgeConfig(guildID, field, value)
+}
+
+// UpdateBridgeConfig replaces the configuration of the bridge a channel belongs to
+func (bc *BridgeCore) UpdateBridgeConfig(platform, channelID string, config *models.BridgeConfig) error {
+	current, err := bc.getBridgeConfig(platform, channelID)
+	if err != nil {
+		return err
+	}
+	if config.RoomID != current.RoomID {
+		return fmt.Errorf("config belongs to a different bridge")
+	}
+
+	if err := bc.db.UpdateBridgeConfig(config); err != nil {
+		return err
+	}
+
+	mappings, err := bc.db.GetActiveRoomMappings(config.RoomID)
+	if err != nil {
+		return fmt.Errorf("failed to get room mappings: %v", err)
+	}
+	if err := bc.loadFiltersForRoom(config.RoomID, mappings); err != nil {
+		log.Printf("⚠️ Failed to reload content filters for room %d: %v", config.RoomID, err)
+	}
+
+	// Refresh the in-memory state of the room's connections
+	name := ""
+	if config.Name != nil {
+		name = *config.Name
+	}
+	roomChannels := make(map[string]bool)
+	for _, mapping := range mappings {
+		roomChannels[mapping.PlatformRoomID] = true
+	}
+	bc.connectionsMu.Lock()
+	bc.updateConnections(func(conn *types.BridgeConnection) bool {
+		return roomChannels[conn.SourceChannelID] && roomChannels[conn.TargetChannelID]
+	}, func(conn *types.BridgeConnection) {
+		conn.Name = name
+		conn.IsActive = config.IsActive
+	})
+	bc.connectionsMu.Unlock()
+
+	log.Printf("⚙️ Bridge config updated for room %d", config.RoomID)
+	return nil
+}
+
 // GetBridges returns all bridge connections for a channel
 func (bc *BridgeCore) GetBridges(channelID string) []*types.BridgeConnection {
-	return bc.connections[channelID]
+	return bc.channelConnections(channelID)
 }
 
 // GetAllBridges returns all bridge connections
 func (bc *BridgeCore) GetAllBridges() map[string][]*types.BridgeConnection {
-	return bc.connections
+	return bc.allConnections()
+}
+
+// SendSystemMessage sends a bot notice (not a bridged user message) to a channel
+func (bc *BridgeCore) SendSystemMessage(platform, channelID, content string) error {
+	target := bc.platforms[platform]
+	if target == nil || !target.IsConnected() {
+		return fmt.Errorf("platform %s not available", platform)
+	}
+	ctx, cancel := bc.deliveryContext()
+	defer cancel()
+	return target.SendMessage(ctx, channelID, content)
 }
 
 // SetUserMapping sets a display name for a user on a platform
 func (bc *BridgeCore) SetUserMapping(platform, userID, displayName string) {
+	bc.userMappingsMu.Lock()
+	defer bc.userMappingsMu.Unlock()
+
 	if bc.userMappings[platform] == nil {
 		bc.userMappings[platform] = make(map[string]string)
 	}
 	bc.userMappings[platform][userID] = displayName
 }
 
+// GetUserMappings returns a copy of the known display names of a platform's users, by user ID
+func (bc *BridgeCore) GetUserMappings(platform string) map[string]string {
+	bc.userMappingsMu.RLock()
+	defer bc.userMappingsMu.RUnlock()
+
+	mappings := make(map[string]string, len(bc.userMappings[platform]))
+	for userID, displayName := range bc.userMappings[platform] {
+		mappings[userID] = displayName
+	}
+	return mappings
+}
+
+// GetUserMapping returns the known display name of a platform user
+func (bc *BridgeCore) GetUserMapping(platform, userID string) (string, bool) {
+	bc.userMappingsMu.RLock()
+	defer bc.userMappingsMu.RUnlock()
+
+	displayName, exists := bc.userMappings[platform][userID]
+	return displayName, exists
+}
+
+// DeleteUserMapping forgets the display name of a platform user, in memory and in the database
+func (bc *BridgeCore) DeleteUserMapping(platform, userID string) error {
+	if bc.db != nil {
+		if err := bc.db.DeleteUserMapping(platform, userID); err != nil {
+			return err
+		}
+	}
+
+	bc.userMappingsMu.Lock()
+	delete(bc.userMappings[platform], userID)
+	bc.userMappingsMu.Unlock()
+	return nil
+}
+
 // getDisplayName gets the display name for a user, falling back to user ID
 func (bc *BridgeCore) getDisplayName(platform, userID string) string {
+	bc.userMappingsMu.RLock()
+	defer bc.userMappingsMu.RUnlock()
+
 	if bc.userMappings[platform] != nil {
 		if displayName, exists := bc.userMappings[platform][userID]; exists {
 			return displayName
@@ -378,13 +1321,112 @@ func (bc *BridgeCore) getDisplayName(platform, userID string) string {
 
 // GetPlatformStatus returns the status of all registered platforms
 func (bc *BridgeCore) GetPlatformStatus() map[string]bool {
+	bc.healthMu.RLock()
+	defer bc.healthMu.RUnlock()
+
 	status := make(map[string]bool)
 	for name, platform := range bc.platforms {
-		status[name] = platform.IsConnected()
+		healthy := true
+		if health, exists := bc.health[name]; exists {
+			healthy = health.lastPingError == nil
+		}
+		status[name] = platform.IsConnected() && healthy
 	}
 	return status
 }
 
+// PauseBridge stops bridging messages from and to a channel until it is resumed
+func (bc *BridgeCore) PauseBridge(channelID string) error {
+	return bc.setBridgeActive(channelID, false)
+}
+
+// ResumeBridge resumes a paused bridge
+func (bc *BridgeCore) ResumeBridge(channelID string) error {
+	return bc.setBridgeActive(channelID, true)
+}
+
+// setBridgeActive updates both directions of every bridge of a channel and persists the state
+func (bc *BridgeCore) setBridgeActive(channelID string, active bool) error {
+	connections := bc.channelConnections(channelID)
+	if len(connections) == 0 {
+		return fmt.Errorf("no bridges found for channel %s", channelID)
+	}
+
+	if bc.db != nil {
+		config, err := bc.getBridgeConfig(connections[0].SourcePlatform, channelID)
+		if err != nil {
+			return err
+		}
+		if err := bc.db.SetBridgeActive(config.RoomID, active); err != nil {
+			return err
+		}
+	}
+
+	targets := make(map[string]bool, len(connections))
+	for _, conn := range connections {
+		targets[conn.TargetChannelID] = true
+	}
+
+	bc.connectionsMu.Lock()
+	bc.updateConnections(func(conn *types.BridgeConnection) bool {
+		return conn.SourceChannelID == channelID || (conn.TargetChannelID == channelID && targets[conn.SourceChannelID])
+	}, func(conn *types.BridgeConnection) {
+		conn.IsActive = active
+	})
+	bc.connectionsMu.Unlock()
+
+	state := "resumed"
+	if !active {
+		state = "paused"
+	}
+	log.Printf("⏯️ Bridges of channel %s %s", channelID, state)
+	return nil
+}
+
+// recordBridgedMessage counts a message delivered from one channel to another
+func (bc *BridgeCore) recordBridgedMessage(sourceChannelID, targetChannelID string) {
+	bc.channelStatsMu.Lock()
+	defer bc.channelStatsMu.Unlock()
+
+	if bc.channelStats[sourceChannelID] == nil {
+		bc.channelStats[sourceChannelID] = &channelStats{}
+	}
+	if bc.channelStats[targetChannelID] == nil {
+		bc.channelStats[targetChannelID] = &channelStats{}
+	}
+	bc.channelStats[sourceChannelID].sent++
+	bc.channelStats[targetChannelID].received++
+}
+
+// GetChannelStats returns how many messages were bridged from and into a channel since startup
+func (bc *BridgeCore) GetChannelStats(channelID string) map[string]int {
+	bc.channelStatsMu.Lock()
+	defer bc.channelStatsMu.Unlock()
+
+	stats := map[string]int{"sent": 0, "received": 0}
+	if counts := bc.channelStats[channelID]; counts != nil {
+		stats["sent"] = counts.sent
+		stats["received"] = counts.received
+	}
+	return stats
+}
+
+// RecordAudit writes an administrative action to the audit log
+func (bc *BridgeCore) RecordAudit(entry *models.AuditLog) error {
+	if bc.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+	return bc.db.AddAuditLog(entry)
+}
+
+// GetAuditLog returns audit log entries, newest first, optionally filtered by actor platform and action
+func (bc *BridgeCore) GetAuditLog(limit, offset int, platform, action string) ([]*models.AuditLog, error) {
+	if bc.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	return bc.db.GetAuditLog(limit, offset, platform, action)
+}
+
 // GetBridgeStats returns statistics about the bridge system
 func (bc *BridgeCore) GetBridgeStats() map[string]int {
 	stats := make(map[string]int)
@@ -392,8 +1434,21 @@ func (bc *BridgeCore) GetBridgeStats() map[string]int {
 	totalBridges := 0
 	activeBridges := 0
 	
+	// Count each bidirectional pair once using its canonical ID,
+	// unidirectional bridges are counted by their directional ID
+	seenBridgeIDs := make(map[string]struct{})
+	bc.connectionsMu.RLock()
 	for _, connections := range bc.connections {
 		for _, conn := range connections {
+			bridgeID := conn.ID
+			if bc.hasReverseConnection(conn) {
+				bridgeID = canonicalBridgeID(conn)
+			}
+			if _, seen := seenBridgeIDs[bridgeID]; seen {
+				continue
+			}
+			seenBridgeIDs[bridgeID] = struct{}{}
+
 			totalBridges++
 			if conn.IsActive {
 				activeBridges++
@@ -401,11 +1456,21 @@ func (bc *BridgeCore) GetBridgeStats() map[string]int {
 		}
 	}
 	
-	// Divide by 2 because we count bidirectional bridges twice
-	stats["total_bridges"] = totalBridges / 2
-	stats["active_bridges"] = activeBridges / 2
-	stats["registered_platforms"] = len(bc.platforms)
 	stats["bridged_channels"] = len(bc.connections)
+	bc.connectionsMu.RUnlock()
+
+	stats["total_bridges"] = totalBridges
+	stats["active_bridges"] = activeBridges
+	stats["registered_platforms"] = len(bc.platforms)
+
+	bc.filteredCountsMu.Lock()
+	total := 0
+	for _, filterType := range FilterTypes {
+		stats["filtered_"+filterType] = bc.filteredCounts[filterType]
+		total += bc.filteredCounts[filterType]
+	}
+	bc.filteredCountsMu.Unlock()
+	stats["filtered_total"] = total
 	
 	return stats
 }
@@ -0,0 +1,186 @@
+package bridge
+
+import (
+	"strings"
+	"testing"
+
+	"dcbot/internal/types"
+)
+
+// testConnection describes one direction of a bridge for TestGetBridgeStats
+type testConnection struct {
+	source, target string
+	active         bool
+}
+
+func TestGetBridgeStats(t *testing.T) {
+	tests := []struct {
+		name         string
+		connections  []testConnection
+		wantTotal    int
+		wantActive   int
+		wantChannels int
+	}{
+		{
+			name: "bidirectional",
+			connections: []testConnection{
+				{"discord:1", "telegram:2", true},
+				{"telegram:2", "discord:1", true},
+			},
+			wantTotal:    1,
+			wantActive:   1,
+			wantChannels: 2,
+		},
+		{
+			name: "unidirectional",
+			connections: []testConnection{
+				{"discord:1", "telegram:2", true},
+				{"discord:3", "telegram:4", false},
+			},
+			wantTotal:    2,
+			wantActive:   1,
+			wantChannels: 2,
+		},
+		{
+			name: "fan-out",
+			connections: []testConnection{
+				{"discord:1", "telegram:2", true},
+				{"telegram:2", "discord:1", true},
+				{"discord:1", "telegram:3", true},
+				{"telegram:3", "discord:1", true},
+				{"discord:1", "telegram:4", true},
+			},
+			wantTotal:    3,
+			wantActive:   3,
+			wantChannels: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bc := &BridgeCore{connections: make(map[string][]*types.BridgeConnection)}
+			for _, c := range tt.connections {
+				addTestConnection(bc, c)
+			}
+
+			stats := bc.GetBridgeStats()
+			if stats["total_bridges"] != tt.wantTotal {
+				t.Errorf("total_bridges = %d, want %d", stats["total_bridges"], tt.wantTotal)
+			}
+			if stats["active_bridges"] != tt.wantActive {
+				t.Errorf("active_bridges = %d, want %d", stats["active_bridges"], tt.wantActive)
+			}
+			if stats["bridged_channels"] != tt.wantChannels {
+				t.Errorf("bridged_channels = %d, want %d", stats["bridged_channels"], tt.wantChannels)
+			}
+		})
+	}
+}
+
+// addTestConnection adds one direction of a bridge, given as platform:channel endpoints, to the connections map
+func addTestConnection(bc *BridgeCore, c testConnection) {
+	sourcePlatform, sourceChannelID, _ := strings.Cut(c.source, ":")
+	targetPlatform, targetChannelID, _ := strings.Cut(c.target, ":")
+
+	bc.connections[sourceChannelID] = append(bc.connections[sourceChannelID], &types.BridgeConnection{
+		ID:              connectionID(sourcePlatform, sourceChannelID, targetPlatform, targetChannelID),
+		SourcePlatform:  sourcePlatform,
+		SourceChannelID: sourceChannelID,
+		TargetPlatform:  targetPlatform,
+		TargetChannelID: targetChannelID,
+		IsActive:        c.active,
+	})
+}
+
+func TestRemoveBridgeByIDAfterRename(t *testing.T) {
+	bc := &BridgeCore{connections: make(map[string][]*types.BridgeConnection)}
+	addTestConnection(bc, testConnection{"discord:1", "telegram:2", true})
+	addTestConnection(bc, testConnection{"telegram:2", "discord:1", true})
+
+	conn := bc.GetBridges("1")[0]
+	if err := bc.SetBridgeName(conn.ID, "renamed"); err != nil {
+		t.Fatalf("SetBridgeName: %v", err)
+	}
+	if conn.Name != "" {
+		t.Errorf("handed out connection was renamed in place to %q", conn.Name)
+	}
+	bc.removeConnection(conn)
+
+	for channel, connections := range bc.GetAllBridges() {
+		if len(connections) != 0 {
+			t.Errorf("channel %s kept %d connections after removing a renamed bridge", channel, len(connections))
+		}
+	}
+}
+
+func TestSetBridgeNameStoresNameOfThatBridge(t *testing.T) {
+	bc := newTestBridgeCore(t)
+	bc.RegisterPlatform(&fakePlatform{name: types.PlatformDiscord})
+	bc.RegisterPlatform(&fakePlatform{name: types.PlatformTelegram})
+	for _, channels := range [][2]string{{"100", "-100"}, {"200", "-200"}} {
+		if err := bc.AddBridge(types.PlatformDiscord, channels[0], types.PlatformTelegram, channels[1]); err != nil {
+			t.Fatalf("AddBridge: %v", err)
+		}
+	}
+
+	renamed := connectionID(types.PlatformTelegram, "-200", types.PlatformDiscord, "200")
+	if err := bc.SetBridgeName(renamed, "second"); err != nil {
+		t.Fatalf("SetBridgeName: %v", err)
+	}
+
+	for channelID, want := range map[string]string{"100": "", "200": "second"} {
+		config, err := bc.GetBridgeConfig(types.PlatformDiscord, channelID)
+		if err != nil {
+			t.Fatalf("GetBridgeConfig: %v", err)
+		}
+		got := ""
+		if config.Name != nil {
+			got = *config.Name
+		}
+		if got != want {
+			t.Errorf("stored name of the bridge of channel %s = %q, want %q", channelID, got, want)
+		}
+	}
+}
+
+func TestSetBridgePriorityKeepsHandedOutConnections(t *testing.T) {
+	bc := &BridgeCore{connections: make(map[string][]*types.BridgeConnection)}
+	addTestConnection(bc, testConnection{"discord:1", "telegram:2", true})
+	addTestConnection(bc, testConnection{"telegram:2", "discord:1", true})
+
+	before := bc.GetBridges("1")[0]
+	if err := bc.SetBridgePriority(before.ID, 5); err != nil {
+		t.Fatalf("SetBridgePriority: %v", err)
+	}
+
+	if before.Priority != 0 {
+		t.Errorf("handed out connection changed to priority %d", before.Priority)
+	}
+	if after := bc.GetBridges("1")[0]; after.Priority != 5 {
+		t.Errorf("priority = %d, want 5", after.Priority)
+	}
+}
+
+func TestSetBridgeActiveUpdatesBothDirections(t *testing.T) {
+	bc := &BridgeCore{connections: make(map[string][]*types.BridgeConnection)}
+	addTestConnection(bc, testConnection{"discord:1", "telegram:2", true})
+	addTestConnection(bc, testConnection{"telegram:2", "discord:1", true})
+	addTestConnection(bc, testConnection{"telegram:3", "discord:1", true})
+
+	before := bc.GetBridges("2")[0]
+	if err := bc.setBridgeActive("1", false); err != nil {
+		t.Fatalf("setBridgeActive: %v", err)
+	}
+
+	if !before.IsActive {
+		t.Error("handed out connection was paused in place")
+	}
+	for _, channel := range []string{"1", "2"} {
+		if conn := bc.GetBridges(channel)[0]; conn.IsActive {
+			t.Errorf("bridge from channel %s still active", channel)
+		}
+	}
+	if conn := bc.GetBridges("3")[0]; !conn.IsActive {
+		t.Error("unrelated bridge into channel 1 was paused")
+	}
+}
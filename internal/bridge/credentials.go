@@ -0,0 +1,35 @@
+package bridge
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"dcbot/internal/types"
+)
+
+// TestCredentials verifies the API credentials of every registered platform concurrently, ordered by platform name
+func (bc *BridgeCore) TestCredentials(ctx context.Context) []types.CredentialCheck {
+	var (
+		checks []types.CredentialCheck
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+	)
+	for name, platform := range bc.platforms {
+		wg.Add(1)
+		go func(name string, platform types.Platform) {
+			defer wg.Done()
+			info, latency, err := platform.TestCredentials(ctx)
+
+			mu.Lock()
+			checks = append(checks, types.CredentialCheck{Platform: name, Info: info, Latency: latency, Err: err})
+			mu.Unlock()
+		}(name, platform)
+	}
+	wg.Wait()
+
+	sort.Slice(checks, func(a, b int) bool {
+		return checks[a].Platform < checks[b].Platform
+	})
+	return checks
+}
@@ -0,0 +1,68 @@
+package bridge
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"dcbot/internal/cache"
+	"dcbot/internal/types"
+)
+
+// deduplicationTTL is how long a bridged message ID is remembered to catch duplicate deliveries
+const deduplicationTTL = 5 * time.Minute
+
+// contentDeduplicationWindow is how long the content of a message without an ID is remembered; kept short so a user repeating themselves is still bridged
+const contentDeduplicationWindow = time.Minute
+
+// defaultDeduplicationCacheSize is the most recently bridged messages remembered
+const defaultDeduplicationCacheSize = 10000
+
+// deduplicator remembers recently bridged messages so one processed twice, e.g. after a restart or a repeated webhook delivery, is only bridged once
+type deduplicator struct {
+	mu        sync.Mutex // Makes checking and remembering a message one step
+	byID      *cache.LRU[string, struct{}]
+	byContent *cache.LRU[string, struct{}]
+}
+
+// newDeduplicator creates a deduplicator remembering at most size messages of each kind
+func newDeduplicator(size int) *deduplicator {
+	return &deduplicator{
+		byID:      cache.NewLRU[string, struct{}](size, deduplicationTTL),
+		byContent: cache.NewLRU[string, struct{}](size, contentDeduplicationWindow),
+	}
+}
+
+// isDuplicate reports whether the message was already bridged, remembering it otherwise.
+// Messages are matched by their source message ID, or by sender and content within contentDeduplicationWindow when they have none.
+func (d *deduplicator) isDuplicate(message *types.BridgeMessage) bool {
+	seen, key := d.byID, message.SourceMessageID
+	if key == "" {
+		// Media and events without text cannot be told apart by content
+		if message.Content == "" {
+			return false
+		}
+		seen, key = d.byContent, message.SourceUserID+"\x00"+message.Content
+	}
+
+	sum := sha256.Sum256([]byte(message.SourcePlatform + "\x00" + message.SourceChannelID + "\x00" + key))
+	hash := hex.EncodeToString(sum[:])
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := seen.Get(hash); ok {
+		return true
+	}
+	seen.Add(hash, struct{}{})
+	return false
+}
+
+// SetDeduplicationCacheSize sets how many recently bridged messages are remembered to skip duplicates
+func (bc *BridgeCore) SetDeduplicationCacheSize(size int) {
+	if size <= 0 {
+		size = defaultDeduplicationCacheSize
+	}
+	bc.deduplicator = newDeduplicator(size)
+}
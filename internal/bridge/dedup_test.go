@@ -0,0 +1,73 @@
+package bridge
+
+import (
+	"testing"
+
+	"dcbot/internal/types"
+)
+
+func TestDeduplicatorIsDuplicate(t *testing.T) {
+	message := func(id, user, content string) *types.BridgeMessage {
+		return &types.BridgeMessage{
+			SourcePlatform:  types.PlatformTelegram,
+			SourceChannelID: "1",
+			SourceUserID:    user,
+			SourceMessageID: id,
+			Content:         content,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		first, second *types.BridgeMessage
+		want          bool
+	}{
+		{"same message ID", message("10", "a", "hi"), message("10", "a", "hi"), true},
+		{"repeated text with new message ID", message("10", "a", "hi"), message("11", "a", "hi"), false},
+		{"same sender and content without ID", message("", "a", "hi"), message("", "a", "hi"), true},
+		{"different sender without ID", message("", "a", "hi"), message("", "b", "hi"), false},
+		{"fields do not run together", message("", "a", "bc"), message("", "ab", "c"), false},
+		{"empty content without ID", message("", "a", ""), message("", "a", ""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newDeduplicator(defaultDeduplicationCacheSize)
+			if d.isDuplicate(tt.first) {
+				t.Fatal("first message reported as duplicate")
+			}
+			if got := d.isDuplicate(tt.second); got != tt.want {
+				t.Errorf("isDuplicate = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProcessMessageCountsDuplicates(t *testing.T) {
+	bc := newTestBridgeCore(t)
+	target := &fakePlatform{name: types.PlatformDiscord}
+	bc.RegisterPlatform(target)
+	addTestConnection(bc, testConnection{"telegram:1", "discord:2", true})
+
+	for range 2 {
+		message := &types.BridgeMessage{
+			ID:              "telegram_1_10",
+			SourcePlatform:  types.PlatformTelegram,
+			SourceChannelID: "1",
+			SourceUserID:    "3",
+			SourceMessageID: "10",
+			Content:         "hello",
+			MessageType:     types.MessageTypeText,
+		}
+		if err := bc.ProcessMessage(message); err != nil {
+			t.Fatalf("ProcessMessage: %v", err)
+		}
+	}
+
+	if len(target.sent) != 1 {
+		t.Errorf("sent %d messages, want 1", len(target.sent))
+	}
+	if got := bc.GetBridgeStats()["filtered_"+FilterTypeDuplicate]; got != 1 {
+		t.Errorf("filtered_duplicate = %d, want 1", got)
+	}
+}
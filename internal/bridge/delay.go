@@ -0,0 +1,37 @@
+package bridge
+
+import (
+	"log"
+	"time"
+
+	"dcbot/internal/types"
+)
+
+// deliveryDelay returns how long messages from a channel wait before delivery, 0 to deliver them immediately
+func (bc *BridgeCore) deliveryDelay(message *types.BridgeMessage) time.Duration {
+	// Streamed media cannot be stored in the queue
+	if bc.db == nil || message.MediaStream != nil {
+		return 0
+	}
+
+	config, err := bc.getBridgeConfig(message.SourcePlatform, message.SourceChannelID)
+	if err != nil || config.DeliveryDelaySeconds <= 0 {
+		return 0
+	}
+	return time.Duration(config.DeliveryDelaySeconds) * time.Second
+}
+
+// enqueueDelayed queues a message for delivery to a connection once the delay has passed, reporting whether it was queued
+func (bc *BridgeCore) enqueueDelayed(connection *types.BridgeConnection, message *types.BridgeMessage, delay time.Duration) bool {
+	if err := bc.enqueuePending(connection, message, "", time.Now().Add(delay)); err != nil {
+		log.Printf("⚠️ Failed to delay message %s, delivering it now: %v", message.ID, err)
+		return false
+	}
+
+	// The bridge logger is set together with the timing logs
+	if bc.timingLogger != nil {
+		bc.timingLogger.Debug("⏳ Message delayed", "message_id", message.ID, "target_platform", connection.TargetPlatform,
+			"target_channel", connection.TargetChannelID, "delay", delay.String())
+	}
+	return true
+}
@@ -0,0 +1,149 @@
+package bridge
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/types"
+)
+
+// defaultDeliveryTimeout is used when no delivery timeout has been configured
+const defaultDeliveryTimeout = 30 * time.Second
+
+// errDeliveryTimeout is returned when a target platform does not accept a message in time
+var errDeliveryTimeout = errors.New("message delivery timed out")
+
+// deliveryContext returns a context bounded by the configured delivery timeout
+func (bc *BridgeCore) deliveryContext() (context.Context, context.CancelFunc) {
+	if bc.deliveryTimeout <= 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), bc.deliveryTimeout)
+}
+
+// runWithContext runs fn, returning early if ctx is done first.
+// Used for clients without context support, whose request keeps running in the background.
+func runWithContext(ctx context.Context, fn func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- fn()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// deliverWithTimeout delivers a message to one connection, failing with errDeliveryTimeout past the deadline
+func (bc *BridgeCore) deliverWithTimeout(connection *types.BridgeConnection, targetPlatform types.Platform, message *types.BridgeMessage) error {
+	ctx, cancel := bc.deliveryContext()
+	defer cancel()
+
+	err := runWithContext(ctx, func() error {
+		err := bc.deliver(ctx, connection, targetPlatform, message)
+		if err == nil && ctx.Err() == context.DeadlineExceeded {
+			// The send finished after the deadline and the message was queued for a retry, which must not send it again
+			log.Printf("🐢 Timed out delivery of message %s to %s channel %s completed late", message.ID, connection.TargetPlatform, connection.TargetChannelID)
+			bc.saveMessageMappingStatus(message, connection, "late_"+connection.TargetChannelID+"_"+message.ID, "sent")
+		}
+		return err
+	})
+	if ctx.Err() == context.DeadlineExceeded {
+		return errDeliveryTimeout
+	}
+	return err
+}
+
+// deliver sends a message to a connection's target channel using the best method of the target platform
+func (bc *BridgeCore) deliver(ctx context.Context, connection *types.BridgeConnection, targetPlatform types.Platform, message *types.BridgeMessage) error {
+	switch adapter := targetPlatform.(type) {
+	case *DiscordAdapter:
+		// Telegram forum topics are bridged into their own Discord thread
+		threadID := bc.discordThread(adapter, connection, message)
+
+		// Webhooks show the sender's name and avatar, fall back to a regular message
+		err := adapter.SendThreadMessage(ctx, connection.TargetChannelID, threadID, message)
+		if err == nil && message.MessageType == types.MessageTypePin {
+			bc.pinDiscordOriginal(adapter, connection, message)
+		}
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+		log.Printf("❌ Failed to send Discord webhook message: %v", err)
+		if threadID != "" {
+			return adapter.SendMessage(ctx, threadID, adapter.FormatMessage(message))
+		}
+		return adapter.SendMessage(ctx, connection.TargetChannelID, adapter.FormatMessage(message))
+
+	case *WebhookAdapter:
+		// Incoming webhooks can show the sender's name like bot webhooks
+		return adapter.SendBridgeMessage(ctx, connection.TargetChannelID, message)
+
+	case *TelegramAdapter:
+		// Discord forum posts are bridged into their own Telegram topic
+		topicID := bc.telegramTopic(adapter, connection, message.SourceChannelID, message.SourceThreadID, message.TopicName)
+
+		// Media is sent as a photo, album or document with the formatted message as caption
+		messageID, err := adapter.SendBridgeMessage(connection.TargetChannelID, topicID, message)
+		if err != nil {
+			return err
+		}
+		if messageID != "" && (message.SourceMessageID != "" || topicID != 0) {
+			// Keep track of the Telegram message so later reactions can edit it
+			bc.saveMessageMapping(message, connection, messageID)
+		}
+		return nil
+	}
+
+	return targetPlatform.SendMessage(ctx, connection.TargetChannelID, targetPlatform.FormatMessage(message))
+}
+
+// deliveredLate reports whether a delivery that timed out completed in the background after all
+func (bc *BridgeCore) deliveredLate(message *types.BridgeMessage, connection *types.BridgeConnection) bool {
+	originalID := message.SourceMessageID
+	if originalID == "" {
+		originalID = message.ID
+	}
+
+	delivered, err := bc.db.IsMessageDelivered(message.SourcePlatform, originalID, connection.TargetPlatform, connection.TargetChannelID)
+	if err != nil {
+		log.Printf("⚠️ %v", err)
+		return false
+	}
+	return delivered
+}
+
+// saveMessageMappingStatus records a delivery attempt that did not produce a target message
+func (bc *BridgeCore) saveMessageMappingStatus(message *types.BridgeMessage, connection *types.BridgeConnection, targetMessageID, status string) {
+	if bc.db == nil {
+		return
+	}
+
+	originalID := message.SourceMessageID
+	if originalID == "" {
+		originalID = message.ID
+	}
+
+	err := bc.db.SaveMessageMapping(&models.Message{
+		OriginalID:     originalID,
+		SourcePlatform: message.SourcePlatform,
+		SourceRoomID:   message.SourceChannelID,
+		SourceUserID:   message.SourceUserID,
+		Content:        message.Content,
+		MessageType:    message.MessageType,
+	}, &models.MessageMapping{
+		Platform:       connection.TargetPlatform,
+		PlatformMsgID:  targetMessageID,
+		PlatformRoomID: connection.TargetChannelID,
+		Status:         status,
+	})
+	if err != nil {
+		log.Printf("⚠️ Failed to save %s message mapping for %s message %s: %v", status, message.SourcePlatform, originalID, err)
+	}
+}
@@ -1,22 +1,33 @@
 package bridge
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"dcbot/internal/platforms/discord"
 	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
 )
 
 // DiscordAdapter implements the Platform interface for Discord
 type DiscordAdapter struct {
-	client *discord.Client
+	client       *discord.Client
+	reportError  func(err error, context map[string]string) // Optional, told when a channel keeps falling back to bot messages
+	bridgeConfig bridgeConfigResolver                       // Optional, provides the sender header templates of bridges
+
+	webhookFallbacks map[string]int // channelID -> consecutive messages sent without webhook
+	fallbackMu       sync.Mutex
 }
 
 // NewDiscordAdapter creates a new Discord adapter
 func NewDiscordAdapter(client *discord.Client) *DiscordAdapter {
 	return &DiscordAdapter{
-		client: client,
+		client:           client,
+		webhookFallbacks: make(map[string]int),
 	}
 }
 
@@ -30,14 +41,87 @@ func (da *DiscordAdapter) IsConnected() bool {
 	return da.client.IsConnected()
 }
 
+// Ping checks that the Discord gateway connection is usable
+func (da *DiscordAdapter) Ping(ctx context.Context) error {
+	return da.client.Ping()
+}
+
+// TestCredentials checks that the Discord bot token is still accepted by the API
+func (da *DiscordAdapter) TestCredentials(ctx context.Context) (string, time.Duration, error) {
+	start := time.Now()
+	username, err := da.client.TestCredentials(ctx)
+	return username, time.Since(start), err
+}
+
+// VerifyChannel checks that a Discord channel exists and the bot can see it
+func (da *DiscordAdapter) VerifyChannel(ctx context.Context, channelID string) error {
+	if _, err := da.client.GetChannel(channelID); err != nil {
+		return fmt.Errorf("channel %s not accessible: %v", channelID, err)
+	}
+	return nil
+}
+
 // SendMessage sends a message to a Discord channel using webhook
-func (da *DiscordAdapter) SendMessage(channelID, content string) error {
+func (da *DiscordAdapter) SendMessage(ctx context.Context, channelID, content string) error {
 	// Try to send as regular message if no formatting is needed
-	return da.client.SendMessage(channelID, content)
+	return da.client.SendMessageContext(ctx, channelID, content)
 }
 
 // SendBridgeMessage sends a bridge message using webhook for better formatting
-func (da *DiscordAdapter) SendBridgeMessage(channelID string, message *types.BridgeMessage) error {
+func (da *DiscordAdapter) SendBridgeMessage(ctx context.Context, channelID string, message *types.BridgeMessage) error {
+	return da.SendThreadMessage(ctx, channelID, "", message)
+}
+
+// SendThreadMessage sends a bridge message into a thread of a channel, or the channel itself if threadID is empty
+func (da *DiscordAdapter) SendThreadMessage(ctx context.Context, channelID, threadID string, message *types.BridgeMessage) error {
+	// Threads are channels of their own, except for webhooks which belong to the parent channel
+	targetID := channelID
+	if threadID != "" {
+		targetID = threadID
+	}
+
+	// Events carrying media (e.g. group photo changes) are sent as a file attachment with the text
+	if message.MessageType == types.MessageTypeEvent && len(message.MediaBytes) > 0 {
+		return da.client.SendFileMessage(targetID, message.Content, "photo.jpg", bytes.NewReader(message.MediaBytes))
+	}
+
+	// System messages such as group renames are shown as italic bot messages
+	if message.MessageType == types.MessageTypeSystem {
+		return da.client.SendMessageContext(ctx, targetID, "*"+message.Content+"*")
+	}
+
+	// Files are uploaded natively with the formatted caption
+	if message.MessageType == types.MessageTypeFile && len(message.MediaBytes) > 0 {
+		return da.client.SendFileMessage(targetID, da.FormatMessage(message), message.MediaFileName, bytes.NewReader(message.MediaBytes))
+	}
+
+	// Streamed videos are uploaded as native attachments, so Discord shows its video player
+	if message.MessageType == types.MessageTypeVideo && message.MediaStream != nil {
+		return da.sendStreamedFile(targetID, message)
+	}
+
+	// Photos, videos and audio are sent as rich embeds
+	switch message.MessageType {
+	case types.MessageTypeImage, types.MessageTypeVideo, types.MessageTypeAudio:
+		return da.sendMediaEmbed(targetID, message)
+	}
+
+	// Telegram stickers are sent as an embed naming their sticker set
+	if message.MessageType == types.MessageTypeSticker && message.Sticker != nil && da.stickerInfoEnabled(message) {
+		return da.sendStickerEmbed(targetID, message)
+	}
+
+	// Shared locations are sent as an embed linking to the map
+	if message.MessageType == types.MessageTypeLocation && message.Location != nil {
+		return da.sendLocationEmbed(targetID, message)
+	}
+
+	// Without a webhook (e.g. the bot lacks Manage Webhooks), fall back to a plain bot message
+	if _, err := da.client.GetOrCreateWebhook(channelID); err != nil {
+		return da.sendWebhookFallback(ctx, channelID, targetID, message, err)
+	}
+	da.resetWebhookFallbacks(channelID)
+
 	// Clean and format username
 	username := message.Username
 	if username == "" {
@@ -49,45 +133,204 @@ func (da *DiscordAdapter) SendBridgeMessage(channelID string, message *types.Bri
 		username = username[1:]
 	}
 	
-	// Add platform prefix to username
-	var platformPrefix string
-	switch message.SourcePlatform {
-	case types.PlatformTelegram:
-		platformPrefix = "[TELEGRAM] "
-		username = platformPrefix + username
-	default:
-		platformPrefix = "[BRIDGE] "
-		username = platformPrefix + username
-	}
+	// Prefix the username with the bridge's sender header
+	username = formatHeader(da.bridgeConfig, message, headerPlatform(message.SourcePlatform), username)
 	
 	// Get user-specific avatar if possible, fallback to platform avatar
 	avatarURL := da.client.GetUserAvatar(message.SourcePlatform, message.SourceUserID, message.Username)
 	
 	// Send via webhook
-	return da.client.SendWebhookMessage(channelID, message.Content, username, avatarURL)
+	content := forwardPrefix(da.bridgeConfig, message) + message.Content
+	return da.client.SendWebhookThreadMessage(ctx, channelID, threadID, content, username, avatarURL)
 }
 
-// FormatMessage formats a bridge message for Discord (fallback method)
-func (da *DiscordAdapter) FormatMessage(message *types.BridgeMessage) string {
-	// Use [PLATFORM] format for consistency
-	var platformPrefix string
-	switch message.SourcePlatform {
+// SetBridgeConfigResolver sets the function looking up the bridge configuration holding sender header templates
+func (da *DiscordAdapter) SetBridgeConfigResolver(resolver bridgeConfigResolver) {
+	da.bridgeConfig = resolver
+}
+
+// CreateThread starts a public thread in a Discord channel and returns its ID
+func (da *DiscordAdapter) CreateThread(parentChannelID, name string) (string, error) {
+	return da.client.CreateThread(parentChannelID, name)
+}
+
+// sendMediaEmbed sends a media message as an embed with the caption as description.
+// The media is uploaded with the message rather than linked, since Telegram file URLs contain the bot token.
+func (da *DiscordAdapter) sendMediaEmbed(channelID string, message *types.BridgeMessage) error {
+	username := message.Username
+	if username == "" {
+		username = "Anonymous"
+	}
+
+	platformName := strings.Title(message.SourcePlatform)
+	embed := &discordgo.MessageEmbed{
+		Description: forwardPrefix(da.bridgeConfig, message) + message.Content,
+		Color:       platformColor(message.SourcePlatform),
+		Author: &discordgo.MessageEmbedAuthor{
+			Name:    username,
+			IconURL: da.client.GetUserAvatar(message.SourcePlatform, message.SourceUserID, message.Username),
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("via %s • %s", platformName, message.Timestamp.Format("2006-01-02 15:04")),
+		},
+	}
+
+	if len(message.MediaBytes) == 0 {
+		return da.client.SendEmbed(channelID, embed)
+	}
+
+	if message.MessageType == types.MessageTypeImage {
+		embed.Image = &discordgo.MessageEmbedImage{URL: "attachment://" + message.MediaFileName}
+	}
+	return da.client.SendEmbedWithFile(channelID, embed, message.MediaFileName, bytes.NewReader(message.MediaBytes))
+}
+
+// sendLocationEmbed sends a shared location as an embed linking to OpenStreetMap
+func (da *DiscordAdapter) sendLocationEmbed(channelID string, message *types.BridgeMessage) error {
+	username := message.Username
+	if username == "" {
+		username = "Anonymous"
+	}
+
+	location := message.Location
+	description := fmt.Sprintf("Latitude: %.6f, Longitude: %.6f", location.Latitude, location.Longitude)
+	if location.LivePeriod > 0 {
+		description += fmt.Sprintf("\nLive location (expires in %d minutes)", location.LivePeriod/60)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "📍 Location",
+		Description: description,
+		URL:         fmt.Sprintf("https://www.openstreetmap.org/?mlat=%f&mlon=%f&zoom=15", location.Latitude, location.Longitude),
+		Color:       platformColor(message.SourcePlatform),
+		Author: &discordgo.MessageEmbedAuthor{
+			Name:    username,
+			IconURL: da.client.GetUserAvatar(message.SourcePlatform, message.SourceUserID, message.Username),
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("via %s • %s", strings.Title(message.SourcePlatform), message.Timestamp.Format("2006-01-02 15:04")),
+		},
+	}
+	return da.client.SendEmbed(channelID, embed)
+}
+
+// stickerInfoEnabled reports whether the source bridge of a sticker shows sticker set info, which is the default
+func (da *DiscordAdapter) stickerInfoEnabled(message *types.BridgeMessage) bool {
+	if da.bridgeConfig == nil {
+		return true
+	}
+	config, err := da.bridgeConfig(message.SourcePlatform, message.SourceChannelID)
+	return err != nil || config.BridgeStickerInfo
+}
+
+// sendStickerEmbed sends a sticker as an embed with its image as thumbnail and the title of its sticker set
+func (da *DiscordAdapter) sendStickerEmbed(channelID string, message *types.BridgeMessage) error {
+	username := message.Username
+	if username == "" {
+		username = "Anonymous"
+	}
+
+	sticker := message.Sticker
+	footer := fmt.Sprintf("via %s • %s", strings.Title(message.SourcePlatform), message.Timestamp.Format("2006-01-02 15:04"))
+	if sticker.SetName != "" {
+		footer = fmt.Sprintf("Sticker set: %s • %s", sticker.SetName, footer)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: strings.TrimSpace(sticker.Emoji + " " + sticker.SetName),
+		Color: platformColor(message.SourcePlatform),
+		Author: &discordgo.MessageEmbedAuthor{
+			Name:    username,
+			IconURL: da.client.GetUserAvatar(message.SourcePlatform, message.SourceUserID, message.Username),
+		},
+		Footer: &discordgo.MessageEmbedFooter{Text: footer},
+	}
+	if sticker.SetTitle != "" {
+		embed.Description = "From sticker set: " + sticker.SetTitle
+	}
+	if embed.Title == "" {
+		embed.Title = "🎨 Sticker"
+	}
+
+	if len(message.MediaBytes) == 0 {
+		return da.client.SendEmbed(channelID, embed)
+	}
+	embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: "attachment://" + message.MediaFileName}
+	return da.client.SendEmbedWithFile(channelID, embed, message.MediaFileName, bytes.NewReader(message.MediaBytes))
+}
+
+// sendStreamedFile uploads a streamed media file with the formatted caption, without reading it into memory first
+func (da *DiscordAdapter) sendStreamedFile(channelID string, message *types.BridgeMessage) error {
+	stream, err := message.MediaStream()
+	if err != nil {
+		return fmt.Errorf("failed to open media stream: %v", err)
+	}
+	defer stream.Close()
+
+	return da.client.SendFileMessage(channelID, da.FormatMessage(message), message.MediaFileName, stream)
+}
+
+// platformColor returns the embed color used for messages from a platform
+func platformColor(platform string) int {
+	switch platform {
 	case types.PlatformTelegram:
-		platformPrefix = "[TELEGRAM]"
+		return 0x0088cc
 	case types.PlatformDiscord:
-		platformPrefix = "[DISCORD]"
+		return 0x5865f2
 	default:
-		platformPrefix = "[BRIDGE]"
+		return 0x808080
+	}
+}
+
+// ChannelGuildID returns the Discord server a channel belongs to
+func (da *DiscordAdapter) ChannelGuildID(channelID string) (string, error) {
+	channel, err := da.client.GetChannel(channelID)
+	if err != nil {
+		return "", err
+	}
+	return channel.GuildID, nil
+}
+
+// PinMessage pins a message in a Discord channel if the bot may manage its messages
+func (da *DiscordAdapter) PinMessage(channelID, messageID string) error {
+	return da.client.PinMessage(channelID, messageID)
+}
+
+// SetChannelTopic replaces the topic of a Discord channel if the bot may manage it
+func (da *DiscordAdapter) SetChannelTopic(channelID, topic string) error {
+	return da.client.SetChannelTopic(channelID, topic)
+}
+
+// SetMemberRole gives a role to a Discord server member or takes it away
+func (da *DiscordAdapter) SetMemberRole(guildID, userID, roleID string, granted bool) error {
+	return da.client.SetMemberRole(guildID, userID, roleID, granted)
+}
+
+// AddTemporaryReaction reacts to a message as the bot and removes the reaction again after duration
+func (da *DiscordAdapter) AddTemporaryReaction(channelID, messageID, emoji string, duration time.Duration) error {
+	return da.client.AddTemporaryReaction(channelID, messageID, emoji, duration)
+}
+
+// ChatInfo returns the name and approximate member count of a Discord server
+func (da *DiscordAdapter) ChatInfo(guildID string) (*types.ChatInfo, error) {
+	guild, err := da.client.GetGuildWithCounts(guildID)
+	if err != nil {
+		return nil, err
 	}
+	return &types.ChatInfo{Title: guild.Name, MemberCount: guild.ApproximateMemberCount}, nil
+}
 
+// FormatMessage formats a bridge message for Discord (fallback method)
+func (da *DiscordAdapter) FormatMessage(message *types.BridgeMessage) string {
 	// Format username (preserve Telegram @ format if present)
 	username := message.Username
 	if username == "" {
 		username = "anonymous"
 	}
 	
-	// Format the message for Discord
-	formattedMessage := fmt.Sprintf("%s **%s**: %s", platformPrefix, username, message.Content)
+	// Format the message for Discord with the bridge's sender header
+	header := formatHeader(da.bridgeConfig, message, headerPlatform(message.SourcePlatform), "**"+username+"**")
+	formattedMessage := fmt.Sprintf("%s: %s%s", header, forwardPrefix(da.bridgeConfig, message), message.Content)
 	
 	return formattedMessage
 }
@@ -0,0 +1,47 @@
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"dcbot/internal/metrics"
+	"dcbot/internal/types"
+)
+
+// webhookFallbackNotifyThreshold is how many consecutive fallbacks in a channel trigger an admin notification
+const webhookFallbackNotifyThreshold = 3
+
+// SetErrorReporter sets the function told about problems admins should fix, such as a missing permission
+func (da *DiscordAdapter) SetErrorReporter(report func(err error, context map[string]string)) {
+	da.reportError = report
+}
+
+// sendWebhookFallback sends a bridge message as a plain bot message because the channel has no usable webhook
+func (da *DiscordAdapter) sendWebhookFallback(ctx context.Context, channelID, targetID string, message *types.BridgeMessage, webhookErr error) error {
+	log.Printf("⚠️ No webhook for Discord channel %s, sending as bot message: %v", channelID, webhookErr)
+	metrics.IncWebhookFallbacks(channelID)
+
+	da.fallbackMu.Lock()
+	da.webhookFallbacks[channelID]++
+	notify := da.webhookFallbacks[channelID] == webhookFallbackNotifyThreshold
+	da.fallbackMu.Unlock()
+
+	if notify && da.reportError != nil {
+		da.reportError(fmt.Errorf("webhook unavailable: %v", webhookErr), map[string]string{
+			"level":      "warning",
+			"title":      "Bridged messages sent without webhook",
+			"channel_id": channelID,
+			"hint":       fmt.Sprintf("Grant the bot the Manage Webhooks permission in <#%s> to restore usernames and avatars", channelID),
+		})
+	}
+
+	return da.client.SendMessageContext(ctx, targetID, da.FormatMessage(message))
+}
+
+// resetWebhookFallbacks clears the fallback count of a channel once its webhook works again
+func (da *DiscordAdapter) resetWebhookFallbacks(channelID string) {
+	da.fallbackMu.Lock()
+	defer da.fallbackMu.Unlock()
+	delete(da.webhookFallbacks, channelID)
+}
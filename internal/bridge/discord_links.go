@@ -0,0 +1,116 @@
+package bridge
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"dcbot/internal/platforms/discord"
+)
+
+const (
+	discordLinkCacheTTL     = 5 * time.Minute
+	discordLinkPreviewLimit = 100
+)
+
+// discordMessageLinkPattern matches links to Discord messages: guild, channel and message ID
+var discordMessageLinkPattern = regexp.MustCompile(`https?://(?:(?:www|ptb|canary)\.)?discord(?:app)?\.com/channels/(\d+)/(\d+)/(\d+)`)
+
+// discordLinkPreview is a cached preview of a linked Discord message
+type discordLinkPreview struct {
+	text      string // Empty when the message could not be fetched
+	expiresAt time.Time
+}
+
+// discordLinkResolver turns links to Discord messages into readable previews
+type discordLinkResolver struct {
+	client *discord.Client
+
+	mu    sync.Mutex
+	cache map[string]discordLinkPreview // channelID/messageID -> preview
+}
+
+// newDiscordLinkResolver creates a resolver using the given Discord client
+func newDiscordLinkResolver(client *discord.Client) *discordLinkResolver {
+	return &discordLinkResolver{
+		client: client,
+		cache:  make(map[string]discordLinkPreview),
+	}
+}
+
+// enrich replaces Discord message links in content with Telegram Markdown links showing a preview
+func (r *discordLinkResolver) enrich(content string) string {
+	return discordMessageLinkPattern.ReplaceAllStringFunc(content, func(link string) string {
+		match := discordMessageLinkPattern.FindStringSubmatch(link)
+		preview := r.preview(match[2], match[3])
+		if preview == "" {
+			return link
+		}
+		return fmt.Sprintf("[%s](%s)", preview, link)
+	})
+}
+
+// preview returns the cached preview of a message, fetching it from Discord when needed
+func (r *discordLinkResolver) preview(channelID, messageID string) string {
+	key := channelID + "/" + messageID
+
+	r.mu.Lock()
+	cached, ok := r.cache[key]
+	r.mu.Unlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.text
+	}
+
+	text, err := r.fetch(channelID, messageID)
+	if err != nil {
+		log.Printf("⚠️ Failed to preview Discord message %s: %v", key, err)
+	}
+
+	// Failed lookups are cached too so a broken link does not hit the API on every message
+	r.mu.Lock()
+	r.cache[key] = discordLinkPreview{text: text, expiresAt: time.Now().Add(discordLinkCacheTTL)}
+	for k, entry := range r.cache {
+		if time.Now().After(entry.expiresAt) {
+			delete(r.cache, k)
+		}
+	}
+	r.mu.Unlock()
+
+	return text
+}
+
+// fetch builds the preview text of a Discord message
+func (r *discordLinkResolver) fetch(channelID, messageID string) (string, error) {
+	message, err := r.client.GetMessage(channelID, messageID)
+	if err != nil {
+		return "", err
+	}
+
+	channelName := channelID
+	if channel, err := r.client.GetChannel(channelID); err == nil {
+		channelName = channel.Name
+	}
+
+	author := "unknown"
+	if message.Author != nil {
+		author = message.Author.Username
+	}
+
+	body := strings.Join(strings.Fields(message.Content), " ")
+	if runes := []rune(body); len(runes) > discordLinkPreviewLimit {
+		body = string(runes[:discordLinkPreviewLimit-3]) + "..."
+	}
+	if body == "" {
+		body = "attachment"
+	}
+
+	return fmt.Sprintf("💬 @%s: \"%s\" (in #%s)", escapeLinkText(author), escapeLinkText(body), escapeLinkText(channelName)), nil
+}
+
+// escapeLinkText removes characters that would break a Telegram Markdown link label
+func escapeLinkText(text string) string {
+	return strings.NewReplacer("[", "", "]", "", "*", "", "_", "", "`", "").Replace(text)
+}
@@ -0,0 +1,127 @@
+package bridge
+
+import (
+	"log"
+	"time"
+
+	"dcbot/internal/metrics"
+	"dcbot/internal/types"
+)
+
+// Downtime notification defaults
+const (
+	defaultDowntimeNotifyDelay = 30 * time.Second
+	defaultDowntimeMessage     = "⚠️ Bridge is temporarily unavailable (Discord connection lost). Messages may be delayed."
+	defaultRestoredMessage     = "✅ Bridge restored."
+)
+
+// downtimeState tracks a lost Discord connection and whether Telegram chats were told about it
+type downtimeState struct {
+	discordDown bool
+	downSince   time.Time
+	notified    bool
+	timer       *time.Timer
+
+	delay           time.Duration
+	downMessage     string
+	restoredMessage string
+}
+
+// SetDowntimeNotifications configures the delay and messages of Discord downtime notifications
+func (bc *BridgeCore) SetDowntimeNotifications(delay time.Duration, downMessage, restoredMessage string) {
+	bc.downtimeMu.Lock()
+	defer bc.downtimeMu.Unlock()
+
+	if delay > 0 {
+		bc.downtime.delay = delay
+	}
+	if downMessage != "" {
+		bc.downtime.downMessage = downMessage
+	}
+	if restoredMessage != "" {
+		bc.downtime.restoredMessage = restoredMessage
+	}
+}
+
+// DiscordDisconnected marks Discord as down and schedules a notification to bridged Telegram chats
+func (bc *BridgeCore) DiscordDisconnected() {
+	bc.downtimeMu.Lock()
+	defer bc.downtimeMu.Unlock()
+
+	if bc.downtime.discordDown {
+		return
+	}
+	bc.downtime.discordDown = true
+	bc.downtime.downSince = time.Now()
+	bc.downtime.notified = false
+	bc.downtime.timer = time.AfterFunc(bc.downtime.delay, bc.notifyDiscordDown)
+}
+
+// DiscordConnected marks Discord as up again, telling Telegram chats if they were notified of the outage
+func (bc *BridgeCore) DiscordConnected() {
+	bc.downtimeMu.Lock()
+	if !bc.downtime.discordDown {
+		bc.downtimeMu.Unlock()
+		return
+	}
+	if bc.downtime.timer != nil {
+		bc.downtime.timer.Stop()
+	}
+	downFor := time.Since(bc.downtime.downSince)
+	notified := bc.downtime.notified
+	message := bc.downtime.restoredMessage
+	bc.downtime.discordDown = false
+	bc.downtime.notified = false
+	bc.downtimeMu.Unlock()
+
+	metrics.AddDiscordDowntime(downFor.Seconds())
+	log.Printf("✅ Discord connection restored after %s", downFor.Round(time.Second))
+
+	if notified {
+		bc.notifyTelegramChats(message)
+	}
+}
+
+// notifyDiscordDown tells bridged Telegram chats that Discord is unreachable, unless it recovered meanwhile
+func (bc *BridgeCore) notifyDiscordDown() {
+	bc.downtimeMu.Lock()
+	if !bc.downtime.discordDown {
+		bc.downtimeMu.Unlock()
+		return
+	}
+	bc.downtime.notified = true
+	message := bc.downtime.downMessage
+	delay := bc.downtime.delay
+	bc.downtimeMu.Unlock()
+
+	log.Printf("⚠️ Discord has been down for %s, notifying Telegram chats", delay)
+	bc.notifyTelegramChats(message)
+}
+
+// notifyTelegramChats sends a notice to every Telegram chat bridged to Discord that has downtime notifications enabled
+func (bc *BridgeCore) notifyTelegramChats(message string) {
+	telegram := bc.platforms[types.PlatformTelegram]
+	if telegram == nil || !telegram.IsConnected() {
+		return
+	}
+
+	notified := make(map[string]bool)
+	for channelID, connections := range bc.allConnections() {
+		for _, conn := range connections {
+			if conn.SourcePlatform != types.PlatformTelegram || conn.TargetPlatform != types.PlatformDiscord || notified[channelID] {
+				continue
+			}
+			notified[channelID] = true
+
+			if config, err := bc.getBridgeConfig(types.PlatformTelegram, channelID); err == nil && !config.SendDowntimeNotifications {
+				continue
+			}
+			ctx, cancel := bc.deliveryContext()
+			err := telegram.SendMessage(ctx, channelID, message)
+			cancel()
+			if err != nil {
+				log.Printf("❌ Failed to send downtime notification to Telegram chat %s: %v", channelID, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package bridge
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/types"
+)
+
+// Types of events written to the bridge event log
+const (
+	BridgeEventDelivery = "delivery" // First delivery attempt of a message
+	BridgeEventRetry    = "retry"    // Delivery attempt from the retry queue
+)
+
+// Outcomes of a delivery attempt in the bridge event log
+const (
+	DeliveryStatusDelivered = "delivered"
+	DeliveryStatusFailed    = "failed"
+	DeliveryStatusTimeout   = "timeout"
+	DeliveryStatusSkipped   = "skipped"
+	DeliveryStatusDropped   = "dropped"
+)
+
+// recordBridgeEvent writes the outcome of a delivery attempt to the bridge event log
+func (bc *BridgeCore) recordBridgeEvent(eventType string, message *types.BridgeMessage, connection *types.BridgeConnection, status string, deliveryErr error, start time.Time) {
+	bc.recordDeliveryOutcome(connection.ID, status)
+	if bc.db == nil {
+		return
+	}
+
+	event := &models.BridgeEvent{
+		EventType:        eventType,
+		BridgeID:         connection.ID,
+		SourcePlatform:   message.SourcePlatform,
+		SourceChannelID:  message.SourceChannelID,
+		SourceMsgID:      message.SourceMessageID,
+		TargetPlatform:   connection.TargetPlatform,
+		TargetChannelID:  connection.TargetChannelID,
+		Status:           status,
+		ProcessingTimeMs: time.Since(start).Milliseconds(),
+	}
+	if deliveryErr != nil {
+		event.ErrorMsg = deliveryErr.Error()
+	}
+
+	if err := bc.db.AddBridgeEvent(event); err != nil {
+		log.Printf("⚠️ Failed to record bridge event: %v", err)
+	}
+}
+
+// GetBridgeEvents returns the most recent delivery events of a bridge, newest first
+func (bc *BridgeCore) GetBridgeEvents(bridgeID string, limit int) ([]*models.BridgeEvent, error) {
+	if bc.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	return bc.db.GetBridgeEvents(bridgeID, limit)
+}
@@ -0,0 +1,104 @@
+package bridge
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"dcbot/internal/types"
+)
+
+// eventHistorySize is how many recent events are kept for subscribers catching up after a reconnect
+const eventHistorySize = 256
+
+// eventBus fans bridge events out to subscribers
+type eventBus struct {
+	mu          sync.Mutex
+	subscribers map[chan types.Event]struct{}
+	lastID      uint64
+	history     []types.Event // Most recent events, oldest first
+}
+
+// Subscribe returns a channel receiving bridge events, buffered for bufSize events, and a function that
+// unsubscribes and closes the channel. Events are dropped while the channel is full.
+func (bc *BridgeCore) Subscribe(bufSize int) (<-chan types.Event, func()) {
+	ch := make(chan types.Event, bufSize)
+
+	bc.events.mu.Lock()
+	if bc.events.subscribers == nil {
+		bc.events.subscribers = make(map[chan types.Event]struct{})
+	}
+	bc.events.subscribers[ch] = struct{}{}
+	bc.events.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			bc.events.mu.Lock()
+			defer bc.events.mu.Unlock()
+			delete(bc.events.subscribers, ch)
+			close(ch)
+		})
+	}
+	return ch, unsubscribe
+}
+
+// EventsSince returns the recent events with an ID above lastID, oldest first
+func (bc *BridgeCore) EventsSince(lastID uint64) []types.Event {
+	bc.events.mu.Lock()
+	defer bc.events.mu.Unlock()
+
+	var events []types.Event
+	for _, event := range bc.events.history {
+		if event.ID > lastID {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// publishEvent numbers an event and sends it to every subscriber
+func (bc *BridgeCore) publishEvent(eventType string, data map[string]string) {
+	bc.events.mu.Lock()
+	defer bc.events.mu.Unlock()
+
+	bc.events.lastID++
+	event := types.Event{ID: bc.events.lastID, Type: eventType, Timestamp: time.Now(), Data: data}
+
+	bc.events.history = append(bc.events.history, event)
+	if len(bc.events.history) > eventHistorySize {
+		bc.events.history = bc.events.history[len(bc.events.history)-eventHistorySize:]
+	}
+
+	for ch := range bc.events.subscribers {
+		select {
+		case ch <- event:
+		default:
+			log.Printf("⚠️ Event subscriber is not keeping up, dropped %s event %d", event.Type, event.ID)
+		}
+	}
+}
+
+// publishMessageBridged announces a message delivered through a connection
+func (bc *BridgeCore) publishMessageBridged(message *types.BridgeMessage, connection *types.BridgeConnection) {
+	bc.publishEvent(types.EventMessageBridged, map[string]string{
+		"bridge_id":         connection.ID,
+		"message_id":        message.ID,
+		"message_type":      message.MessageType,
+		"source_platform":   connection.SourcePlatform,
+		"source_channel_id": connection.SourceChannelID,
+		"target_platform":   connection.TargetPlatform,
+		"target_channel_id": connection.TargetChannelID,
+	})
+}
+
+// publishBridgeEvent announces a created or removed bridge
+func (bc *BridgeCore) publishBridgeEvent(eventType, sourcePlatform, sourceChannelID, targetPlatform, targetChannelID string) {
+	bc.publishEvent(eventType, map[string]string{
+		"bridge_id":         connectionID(sourcePlatform, sourceChannelID, targetPlatform, targetChannelID),
+		"source_platform":   sourcePlatform,
+		"source_channel_id": sourceChannelID,
+		"target_platform":   targetPlatform,
+		"target_channel_id": targetChannelID,
+	})
+}
@@ -0,0 +1,140 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"dcbot/internal/types"
+)
+
+// ExportConnections serializes every bridge with its configuration in the versioned export format.
+// Both directions of a bridge are exported as a single entry.
+func (bc *BridgeCore) ExportConnections() ([]byte, error) {
+	export := types.BridgeExport{
+		Version:     types.BridgeExportVersion,
+		ExportedAt:  time.Now().UTC(),
+		Connections: []types.ExportedBridge{},
+	}
+
+	seen := make(map[string]bool)
+	for _, connections := range bc.allConnections() {
+		for _, conn := range connections {
+			bridgeID := canonicalBridgeID(conn)
+			if seen[bridgeID] {
+				continue
+			}
+			seen[bridgeID] = true
+
+			entry := types.ExportedBridge{
+				SourcePlatform:  conn.SourcePlatform,
+				SourceChannelID: conn.SourceChannelID,
+				TargetPlatform:  conn.TargetPlatform,
+				TargetChannelID: conn.TargetChannelID,
+			}
+			if bc.db != nil {
+				if config, err := bc.getBridgeConfig(conn.SourcePlatform, conn.SourceChannelID); err == nil {
+					entry.Config = config
+				}
+			}
+			export.Connections = append(export.Connections, entry)
+		}
+	}
+
+	sort.Slice(export.Connections, func(i, j int) bool {
+		a, b := export.Connections[i], export.Connections[j]
+		return connectionID(a.SourcePlatform, a.SourceChannelID, a.TargetPlatform, a.TargetChannelID) <
+			connectionID(b.SourcePlatform, b.SourceChannelID, b.TargetPlatform, b.TargetChannelID)
+	})
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode bridge export: %v", err)
+	}
+	return data, nil
+}
+
+// ImportConnections creates the bridges of an export that do not exist yet and applies their configuration.
+// An error is returned only when the export itself cannot be read; invalid entries are counted as failed.
+func (bc *BridgeCore) ImportConnections(data []byte) (imported, skipped, failed int, err error) {
+	var export types.BridgeExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid bridge export: %v", err)
+	}
+	if export.Version < 1 || export.Version > types.BridgeExportVersion {
+		return 0, 0, 0, fmt.Errorf("unsupported bridge export version %d (supported: 1-%d)", export.Version, types.BridgeExportVersion)
+	}
+
+	for index, entry := range export.Connections {
+		if err := validateExportedBridge(entry); err != nil {
+			log.Printf("⚠️ Skipping invalid bridge #%d in import: %v", index+1, err)
+			failed++
+			continue
+		}
+		if bc.bridgeExists(entry) {
+			skipped++
+			continue
+		}
+
+		if err := bc.AddBridge(entry.SourcePlatform, entry.SourceChannelID, entry.TargetPlatform, entry.TargetChannelID); err != nil {
+			log.Printf("❌ Failed to import bridge #%d: %v", index+1, err)
+			failed++
+			continue
+		}
+		imported++
+
+		if entry.Config != nil && bc.db != nil {
+			if err := bc.applyImportedConfig(entry); err != nil {
+				log.Printf("⚠️ Imported bridge #%d without its configuration: %v", index+1, err)
+			}
+		}
+	}
+
+	log.Printf("📥 Bridge import: %d imported, %d skipped, %d failed", imported, skipped, failed)
+	return imported, skipped, failed, nil
+}
+
+// validateExportedBridge checks that an export entry names two different channels
+func validateExportedBridge(entry types.ExportedBridge) error {
+	if entry.SourcePlatform == "" || entry.SourceChannelID == "" || entry.TargetPlatform == "" || entry.TargetChannelID == "" {
+		return fmt.Errorf("source_platform, source_channel_id, target_platform and target_channel_id are required")
+	}
+	if entry.SourcePlatform == entry.TargetPlatform && entry.SourceChannelID == entry.TargetChannelID {
+		return fmt.Errorf("source and target are the same channel")
+	}
+	return nil
+}
+
+// bridgeExists reports whether a bridge between the two channels of an export entry exists, in either direction
+func (bc *BridgeCore) bridgeExists(entry types.ExportedBridge) bool {
+	bc.connectionsMu.RLock()
+	defer bc.connectionsMu.RUnlock()
+
+	for _, conn := range bc.connections[entry.SourceChannelID] {
+		if conn.SourcePlatform == entry.SourcePlatform && conn.TargetPlatform == entry.TargetPlatform && conn.TargetChannelID == entry.TargetChannelID {
+			return true
+		}
+	}
+	for _, conn := range bc.connections[entry.TargetChannelID] {
+		if conn.SourcePlatform == entry.TargetPlatform && conn.TargetPlatform == entry.SourcePlatform && conn.TargetChannelID == entry.SourceChannelID {
+			return true
+		}
+	}
+	return false
+}
+
+// applyImportedConfig stores the exported configuration on the room of a newly imported bridge
+func (bc *BridgeCore) applyImportedConfig(entry types.ExportedBridge) error {
+	current, err := bc.getBridgeConfig(entry.SourcePlatform, entry.SourceChannelID)
+	if err != nil {
+		return err
+	}
+
+	config := *entry.Config
+	config.ID = current.ID
+	config.RoomID = current.RoomID
+	config.CreatedAt = current.CreatedAt
+	return bc.UpdateBridgeConfig(entry.SourcePlatform, entry.SourceChannelID, &config)
+}
@@ -0,0 +1,224 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"dcbot/internal/database/models"
+)
+
+// wordPattern splits message content into words while preserving the surrounding whitespace
+var wordPattern = regexp.MustCompile(`\S+`)
+
+// filterWordPunctuation is stripped from words before exact and glob matching
+const filterWordPunctuation = ".,!?;:\"'()[]{}"
+
+// compiledFilter is a filter rule prepared for matching against message content
+type compiledFilter struct {
+	rule   models.FilterRule
+	regex  *regexp.Regexp // Only set for regex mode
+	phrase *regexp.Regexp // Only set for exact rules of several words
+}
+
+// ParseFilterRules parses the filter_words JSON column into filter rules.
+// Legacy configs that store a plain array of words are converted to exact block rules.
+func ParseFilterRules(raw string) ([]models.FilterRule, error) {
+	if strings.TrimSpace(raw) == "" {
+		return []models.FilterRule{}, nil
+	}
+
+	var rules []models.FilterRule
+	if err := json.Unmarshal([]byte(raw), &rules); err == nil {
+		return rules, nil
+	}
+
+	// Fallback to legacy format: ["word1", "word2"]
+	var words []string
+	if err := json.Unmarshal([]byte(raw), &words); err != nil {
+		return nil, fmt.Errorf("invalid filter rules: %v", err)
+	}
+
+	rules = make([]models.FilterRule, 0, len(words))
+	for _, word := range words {
+		rules = append(rules, models.FilterRule{
+			Pattern: word,
+			Mode:    models.FilterModeExact,
+			Action:  models.FilterActionBlock,
+		})
+	}
+	return rules, nil
+}
+
+// ValidateFilterRule checks that a filter rule has a known mode, action and a usable pattern
+func ValidateFilterRule(rule models.FilterRule) error {
+	if rule.Pattern == "" {
+		return fmt.Errorf("filter pattern cannot be empty")
+	}
+
+	switch rule.Action {
+	case models.FilterActionBlock, models.FilterActionReplace:
+	default:
+		return fmt.Errorf("unknown filter action: %s", rule.Action)
+	}
+
+	switch rule.Mode {
+	case models.FilterModeExact:
+	case models.FilterModeGlob:
+		if _, err := path.Match(rule.Pattern, ""); err != nil {
+			return fmt.Errorf("invalid glob pattern: %v", err)
+		}
+		if len(strings.Fields(rule.Pattern)) > 1 {
+			return fmt.Errorf("glob patterns match single words, use exact or regex mode for phrases")
+		}
+	case models.FilterModeRegex:
+		if _, err := regexp.Compile(rule.Pattern); err != nil {
+			return fmt.Errorf("invalid regex pattern: %v", err)
+		}
+	default:
+		return fmt.Errorf("unknown filter mode: %s", rule.Mode)
+	}
+
+	return nil
+}
+
+// compileFilters prepares filter rules for matching, skipping invalid ones
+func compileFilters(rules []models.FilterRule) ([]compiledFilter, error) {
+	filters := make([]compiledFilter, 0, len(rules))
+	var invalid []string
+
+	for _, rule := range rules {
+		if err := ValidateFilterRule(rule); err != nil {
+			invalid = append(invalid, fmt.Sprintf("%q: %v", rule.Pattern, err))
+			continue
+		}
+
+		filter := compiledFilter{rule: rule}
+		switch {
+		case rule.Mode == models.FilterModeRegex:
+			filter.regex = regexp.MustCompile(rule.Pattern)
+		case rule.Mode == models.FilterModeExact && len(strings.Fields(rule.Pattern)) > 1:
+			filter.phrase = phraseRegex(rule.Pattern)
+		}
+		filters = append(filters, filter)
+	}
+
+	if len(invalid) > 0 {
+		return filters, fmt.Errorf("skipped invalid filter rules: %s", strings.Join(invalid, "; "))
+	}
+	return filters, nil
+}
+
+// applyFilters runs content through the filters.
+// It returns the (possibly rewritten) content and whether the message should be blocked.
+func applyFilters(filters []compiledFilter, content string) (string, bool) {
+	for _, filter := range filters {
+		var matched bool
+		content, matched = filter.apply(content)
+		if matched && filter.rule.Action == models.FilterActionBlock {
+			return content, true
+		}
+	}
+	return content, false
+}
+
+// apply matches a single filter against content, replacing matches if the action requires it
+func (f compiledFilter) apply(content string) (string, bool) {
+	replace := f.rule.Action == models.FilterActionReplace
+
+	if f.regex != nil {
+		if !f.regex.MatchString(content) {
+			return content, false
+		}
+		if replace {
+			content = f.regex.ReplaceAllLiteralString(content, f.rule.Replacement)
+		}
+		return content, true
+	}
+
+	if f.phrase != nil {
+		return f.applyPhrase(content, replace)
+	}
+
+	// Exact and glob rules are matched word by word
+	matched := false
+	content = wordPattern.ReplaceAllStringFunc(content, func(word string) string {
+		core := strings.Trim(word, filterWordPunctuation)
+		if core == "" || !f.matchWord(core) {
+			return word
+		}
+		matched = true
+		if !replace {
+			return word
+		}
+		return strings.Replace(word, core, f.rule.Replacement, 1)
+	})
+
+	return content, matched
+}
+
+// phraseRegex matches the words of a multi-word exact pattern case-insensitively, with any whitespace between them
+func phraseRegex(pattern string) *regexp.Regexp {
+	words := strings.Fields(pattern)
+	for i, word := range words {
+		words[i] = regexp.QuoteMeta(word)
+	}
+	return regexp.MustCompile(`(?i)` + strings.Join(words, `\s+`))
+}
+
+// applyPhrase matches a multi-word exact rule against the whole content, where the phrase is not part of longer words
+func (f compiledFilter) applyPhrase(content string, replace bool) (string, bool) {
+	var rewritten strings.Builder
+	matched := false
+	last := 0
+
+	for _, match := range f.phrase.FindAllStringIndex(content, -1) {
+		if !isWholeWords(content, match[0], match[1]) {
+			continue
+		}
+		matched = true
+		if !replace {
+			return content, true
+		}
+		rewritten.WriteString(content[last:match[0]])
+		rewritten.WriteString(f.rule.Replacement)
+		last = match[1]
+	}
+
+	if !matched {
+		return content, false
+	}
+	rewritten.WriteString(content[last:])
+	return rewritten.String(), true
+}
+
+// isWholeWords reports whether content[start:end] begins and ends at whitespace, punctuation or the edges of content
+func isWholeWords(content string, start, end int) bool {
+	before, _ := utf8.DecodeLastRuneInString(content[:start])
+	after, _ := utf8.DecodeRuneInString(content[end:])
+	return (start == 0 || isWordSeparator(before)) && (end == len(content) || isWordSeparator(after))
+}
+
+// isWordSeparator reports whether r ends a word for exact matching
+func isWordSeparator(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune(filterWordPunctuation, r)
+}
+
+// matchWord checks a single word against an exact or glob rule (case-insensitive)
+func (f compiledFilter) matchWord(word string) bool {
+	word = strings.ToLower(word)
+	pattern := strings.ToLower(f.rule.Pattern)
+
+	switch f.rule.Mode {
+	case models.FilterModeExact:
+		return word == pattern
+	case models.FilterModeGlob:
+		ok, _ := path.Match(pattern, word)
+		return ok
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package bridge
+
+import (
+	"testing"
+
+	"dcbot/internal/database/models"
+)
+
+func TestApplyFilters(t *testing.T) {
+	tests := []struct {
+		name        string
+		rule        models.FilterRule
+		content     string
+		wantContent string
+		wantBlocked bool
+	}{
+		{
+			name:        "exact word",
+			rule:        models.FilterRule{Pattern: "spam", Mode: models.FilterModeExact, Action: models.FilterActionBlock},
+			content:     "no SPAM!",
+			wantContent: "no SPAM!",
+			wantBlocked: true,
+		},
+		{
+			name:        "exact phrase",
+			rule:        models.FilterRule{Pattern: "buy now", Mode: models.FilterModeExact, Action: models.FilterActionBlock},
+			content:     "Buy  now, cheap",
+			wantContent: "Buy  now, cheap",
+			wantBlocked: true,
+		},
+		{
+			name:        "exact phrase inside longer words",
+			rule:        models.FilterRule{Pattern: "buy now", Mode: models.FilterModeExact, Action: models.FilterActionBlock},
+			content:     "rebuy nowhere",
+			wantContent: "rebuy nowhere",
+		},
+		{
+			name:        "exact phrase replaced",
+			rule:        models.FilterRule{Pattern: "bad word", Mode: models.FilterModeExact, Action: models.FilterActionReplace, Replacement: "***"},
+			content:     "a bad word and (Bad Word)",
+			wantContent: "a *** and (***)",
+		},
+		{
+			name:        "glob word replaced",
+			rule:        models.FilterRule{Pattern: "foo*", Mode: models.FilterModeGlob, Action: models.FilterActionReplace, Replacement: "***"},
+			content:     "football, food",
+			wantContent: "***, ***",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filters, err := compileFilters([]models.FilterRule{tt.rule})
+			if err != nil {
+				t.Fatalf("compileFilters: %v", err)
+			}
+
+			content, blocked := applyFilters(filters, tt.content)
+			if content != tt.wantContent || blocked != tt.wantBlocked {
+				t.Errorf("applyFilters = %q, %v, want %q, %v", content, blocked, tt.wantContent, tt.wantBlocked)
+			}
+		})
+	}
+}
+
+func TestValidateFilterRuleRejectsGlobPhrase(t *testing.T) {
+	rule := models.FilterRule{Pattern: "buy *", Mode: models.FilterModeGlob, Action: models.FilterActionBlock}
+	if err := ValidateFilterRule(rule); err == nil {
+		t.Error("glob pattern with several words was accepted")
+	}
+}
@@ -0,0 +1,46 @@
+package bridge
+
+import (
+	"dcbot/internal/metrics"
+	"dcbot/internal/types"
+)
+
+// Reasons a message is dropped instead of delivered
+const (
+	FilterTypeWordFilter       = "word_filter"
+	FilterTypeRateLimit        = "rate_limit"
+	FilterTypeMaxLength        = "max_length"
+	FilterTypeMediaNotAllowed  = "media_not_allowed" // Message type disabled in the bridge config
+	FilterTypeBridgeInactive   = "bridge_inactive"
+	FilterTypeNoConnection     = "no_connection"      // No bridge, or the target platform is not connected
+	FilterTypeSenderNotAllowed = "sender_not_allowed" // Sender missing from the bridge's whitelist
+	FilterTypeDuplicate        = "duplicate"          // Same message already bridged
+)
+
+// FilterTypes lists every filter type in display order
+var FilterTypes = []string{
+	FilterTypeWordFilter,
+	FilterTypeRateLimit,
+	FilterTypeMaxLength,
+	FilterTypeMediaNotAllowed,
+	FilterTypeBridgeInactive,
+	FilterTypeNoConnection,
+	FilterTypeSenderNotAllowed,
+	FilterTypeDuplicate,
+}
+
+// recordFiltered counts a message dropped on its way to a target platform
+func (bc *BridgeCore) recordFiltered(message *types.BridgeMessage, targetPlatform, filterType string) {
+	bc.filteredCountsMu.Lock()
+	bc.filteredCounts[filterType]++
+	bc.filteredCountsMu.Unlock()
+
+	metrics.IncMessagesFiltered(message.SourcePlatform, targetPlatform, filterType)
+}
+
+// recordFilteredForAll counts a message dropped before it reached any of its connections
+func (bc *BridgeCore) recordFilteredForAll(message *types.BridgeMessage, connections []*types.BridgeConnection, filterType string) {
+	for _, connection := range connections {
+		bc.recordFiltered(message, connection.TargetPlatform, filterType)
+	}
+}
@@ -0,0 +1,30 @@
+package bridge
+
+import (
+	"strings"
+
+	"dcbot/internal/types"
+)
+
+// forwardPrefix returns the "↩ Forwarded from ..." note put before forwarded messages, or "" if the bridge hides it
+func forwardPrefix(resolve bridgeConfigResolver, message *types.BridgeMessage) string {
+	var origin string
+	switch {
+	case message.ForwardedFromChatTitle != "":
+		origin = "[" + escapeLinkText(message.ForwardedFromChatTitle) + "]"
+		if message.ForwardedFromURL != "" {
+			origin += "(" + message.ForwardedFromURL + ")"
+		}
+	case message.ForwardedFrom != "":
+		origin = "@" + strings.TrimPrefix(message.ForwardedFrom, "@")
+	default:
+		return ""
+	}
+
+	if resolve != nil {
+		if config, err := resolve(message.SourcePlatform, message.SourceChannelID); err == nil && !config.PreserveForwardMetadata {
+			return ""
+		}
+	}
+	return "↩ Forwarded from " + origin + ": "
+}
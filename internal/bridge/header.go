@@ -0,0 +1,37 @@
+package bridge
+
+import (
+	"strings"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/types"
+)
+
+// bridgeConfigResolver looks up the bridge configuration of a platform channel
+type bridgeConfigResolver func(platform, channelID string) (*models.BridgeConfig, error)
+
+// formatHeader renders the sender header of a message with the header template of its source bridge
+func formatHeader(resolve bridgeConfigResolver, message *types.BridgeMessage, platform, username string) string {
+	var config *models.BridgeConfig
+	if resolve != nil {
+		if resolved, err := resolve(message.SourcePlatform, message.SourceChannelID); err == nil {
+			config = resolved
+		}
+	}
+
+	return config.FormatHeader(message.SourcePlatform, models.TemplateData{
+		Username:  username,
+		Platform:  platform,
+		Timestamp: message.Timestamp,
+	})
+}
+
+// headerPlatform returns the label of a bridged platform in sender headers
+func headerPlatform(platform string) string {
+	switch platform {
+	case types.PlatformTelegram, types.PlatformDiscord:
+		return strings.ToUpper(platform)
+	default:
+		return "BRIDGE"
+	}
+}
@@ -0,0 +1,15 @@
+package bridge
+
+import (
+	"fmt"
+
+	"dcbot/internal/database/models"
+)
+
+// RedeemBridgeInvite returns and consumes an unexpired Telegram bridge invite, or nil if the code is unknown
+func (bc *BridgeCore) RedeemBridgeInvite(code string) (*models.PendingBridgeInvite, error) {
+	if bc.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	return bc.db.RedeemBridgeInvite(code)
+}
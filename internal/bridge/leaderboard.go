@@ -0,0 +1,103 @@
+package bridge
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"dcbot/internal/types"
+)
+
+// leaderboardTTL is how long a bridge's leaderboard is served from memory before it is reloaded
+const leaderboardTTL = 5 * time.Minute
+
+// leaderboardSize is the number of users shown on a leaderboard
+const leaderboardSize = 10
+
+// cachedLeaderboard is a leaderboard loaded from the database
+type cachedLeaderboard struct {
+	entries  []*types.LeaderboardEntry
+	loadedAt time.Time
+}
+
+// recordUserActivity counts a bridged message towards its sender's activity on the bridge
+func (bc *BridgeCore) recordUserActivity(message *types.BridgeMessage, connection *types.BridgeConnection) {
+	if bc.db == nil || message.SourceUserID == "" {
+		return
+	}
+
+	if err := bc.db.IncrementUserActivity(message.SourcePlatform, message.SourceUserID, canonicalBridgeID(connection)); err != nil {
+		log.Printf("⚠️ Failed to record user activity: %v", err)
+	}
+}
+
+// GetLeaderboard returns the most active users of the bridge a connection belongs to
+func (bc *BridgeCore) GetLeaderboard(connectionID string) ([]*types.LeaderboardEntry, error) {
+	if bc.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	connection := bc.findConnection(connectionID)
+	if connection == nil {
+		return nil, fmt.Errorf("bridge %s not found", connectionID)
+	}
+	bridgeID := canonicalBridgeID(connection)
+
+	bc.leaderboardsMu.Lock()
+	defer bc.leaderboardsMu.Unlock()
+
+	if cached, ok := bc.leaderboards[bridgeID]; ok && time.Since(cached.loadedAt) < leaderboardTTL {
+		return cached.entries, nil
+	}
+
+	activities, err := bc.db.GetTopUsers(bridgeID, leaderboardSize)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*types.LeaderboardEntry, 0, len(activities))
+	for _, activity := range activities {
+		entries = append(entries, &types.LeaderboardEntry{
+			Platform:     activity.Platform,
+			UserID:       activity.UserID,
+			Username:     bc.getDisplayName(activity.Platform, activity.UserID),
+			MessageCount: activity.MessageCount,
+		})
+	}
+
+	bc.leaderboards[bridgeID] = &cachedLeaderboard{entries: entries, loadedAt: time.Now()}
+	return entries, nil
+}
+
+// ResetBridgeStats clears the activity counts and older delivery events of the bridge a connection belongs to
+func (bc *BridgeCore) ResetBridgeStats(connectionID string) (int64, error) {
+	if bc.db == nil {
+		return 0, fmt.Errorf("database not initialized")
+	}
+
+	connection := bc.findConnection(connectionID)
+	if connection == nil {
+		return 0, fmt.Errorf("bridge %s not found", connectionID)
+	}
+	bridgeID := canonicalBridgeID(connection)
+
+	// Events are recorded per direction, so clear the ones of the reverse connection as well
+	connectionIDs := []string{connection.ID}
+	for _, conn := range bc.channelConnections(connection.TargetChannelID) {
+		if conn.ID != connection.ID && canonicalBridgeID(conn) == bridgeID {
+			connectionIDs = append(connectionIDs, conn.ID)
+		}
+	}
+
+	affected, err := bc.db.ResetBridgeStats(bridgeID, connectionIDs...)
+	if err != nil {
+		return 0, err
+	}
+
+	bc.leaderboardsMu.Lock()
+	delete(bc.leaderboards, bridgeID)
+	bc.leaderboardsMu.Unlock()
+
+	log.Printf("🧹 Reset statistics of bridge %s (%d rows)", bridgeID, affected)
+	return affected, nil
+}
@@ -0,0 +1,110 @@
+package bridge
+
+import (
+	"fmt"
+	"log"
+
+	"dcbot/internal/types"
+)
+
+// migrationNotice is sent to bridged Discord channels after a Telegram group was upgraded to a supergroup
+const migrationNotice = "ℹ️ Telegram group migrated to supergroup (ID updated)"
+
+// MigratePlatformChannel moves all bridges of a channel whose ID changed, such as a Telegram group upgraded to a supergroup or a replaced Discord channel
+func (bc *BridgeCore) MigratePlatformChannel(platform, oldChannelID, newChannelID string) error {
+	if oldChannelID == newChannelID {
+		return nil
+	}
+
+	if bc.db != nil {
+		connectionIDs, bridgeIDs := bc.migratedBridgeIDs(platform, oldChannelID, newChannelID)
+		rows, err := bc.db.MigrateRoomMappings(platform, oldChannelID, newChannelID, connectionIDs, bridgeIDs)
+		if err != nil {
+			return err
+		}
+		log.Printf("💾 Migrated %d room mappings of %s channel %s to %s", rows, platform, oldChannelID, newChannelID)
+	}
+
+	bc.connectionsMu.Lock()
+	migrated := 0
+	var discordChannels []string
+	bc.updateConnections(func(conn *types.BridgeConnection) bool {
+		return (conn.SourcePlatform == platform && conn.SourceChannelID == oldChannelID) ||
+			(conn.TargetPlatform == platform && conn.TargetChannelID == oldChannelID)
+	}, func(conn *types.BridgeConnection) {
+		if conn.SourcePlatform == platform && conn.SourceChannelID == oldChannelID {
+			conn.SourceChannelID = newChannelID
+			migrated++
+			if platform == types.PlatformTelegram && conn.TargetPlatform == types.PlatformDiscord {
+				discordChannels = append(discordChannels, conn.TargetChannelID)
+			}
+		}
+		if conn.TargetPlatform == platform && conn.TargetChannelID == oldChannelID {
+			conn.TargetChannelID = newChannelID
+		}
+		conn.ID = connectionID(conn.SourcePlatform, conn.SourceChannelID, conn.TargetPlatform, conn.TargetChannelID)
+	})
+
+	// Connections and filters are keyed by their source channel
+	if connections, ok := bc.connections[oldChannelID]; ok {
+		bc.connections[newChannelID] = append(bc.connections[newChannelID], connections...)
+		delete(bc.connections, oldChannelID)
+	}
+	if filters, ok := bc.filters[oldChannelID]; ok {
+		bc.filters[newChannelID] = filters
+		delete(bc.filters, oldChannelID)
+	}
+	bc.connectionsMu.Unlock()
+
+	if migrated == 0 {
+		return fmt.Errorf("no bridges found for %s channel %s", platform, oldChannelID)
+	}
+
+	log.Printf("🔀 Migrated %d bridges of %s channel %s to %s", migrated, platform, oldChannelID, newChannelID)
+	bc.notifyDiscordChannels(discordChannels, migrationNotice)
+	return nil
+}
+
+// migratedBridgeIDs maps the connection and canonical bridge IDs involving a channel to the IDs they get once the channel ID changes
+func (bc *BridgeCore) migratedBridgeIDs(platform, oldChannelID, newChannelID string) (connectionIDs, bridgeIDs map[string]string) {
+	migrate := func(channelPlatform, channelID string) string {
+		if channelPlatform == platform && channelID == oldChannelID {
+			return newChannelID
+		}
+		return channelID
+	}
+
+	connectionIDs = make(map[string]string)
+	bridgeIDs = make(map[string]string)
+	for _, connections := range bc.allConnections() {
+		for _, conn := range connections {
+			if (conn.SourcePlatform != platform || conn.SourceChannelID != oldChannelID) && (conn.TargetPlatform != platform || conn.TargetChannelID != oldChannelID) {
+				continue
+			}
+			migrated := *conn
+			migrated.SourceChannelID = migrate(conn.SourcePlatform, conn.SourceChannelID)
+			migrated.TargetChannelID = migrate(conn.TargetPlatform, conn.TargetChannelID)
+
+			connectionIDs[conn.ID] = connectionID(migrated.SourcePlatform, migrated.SourceChannelID, migrated.TargetPlatform, migrated.TargetChannelID)
+			bridgeIDs[canonicalBridgeID(conn)] = canonicalBridgeID(&migrated)
+		}
+	}
+	return connectionIDs, bridgeIDs
+}
+
+// notifyDiscordChannels sends a notice to each of the given Discord channels
+func (bc *BridgeCore) notifyDiscordChannels(channelIDs []string, message string) {
+	discord := bc.platforms[types.PlatformDiscord]
+	if discord == nil || !discord.IsConnected() {
+		return
+	}
+
+	for _, channelID := range channelIDs {
+		ctx, cancel := bc.deliveryContext()
+		err := discord.SendMessage(ctx, channelID, message)
+		cancel()
+		if err != nil {
+			log.Printf("❌ Failed to send notice to Discord channel %s: %v", channelID, err)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package bridge
+
+import (
+	"log"
+
+	"dcbot/internal/types"
+)
+
+// pinDiscordOriginal pins the Discord message a Telegram pin is about, if the pinned message was bridged from that channel
+func (bc *BridgeCore) pinDiscordOriginal(adapter *DiscordAdapter, connection *types.BridgeConnection, message *types.BridgeMessage) {
+	if bc.db == nil || message.PinnedMessageID == "" {
+		return
+	}
+
+	original, err := bc.db.GetMessageByMapping(message.SourcePlatform, message.PinnedMessageID, message.SourceChannelID)
+	if err != nil {
+		log.Printf("⚠️ Failed to look up pinned message %s: %v", message.PinnedMessageID, err)
+		return
+	}
+	if original == nil || original.SourcePlatform != types.PlatformDiscord || original.SourceRoomID != connection.TargetChannelID {
+		return
+	}
+
+	if err := adapter.PinMessage(connection.TargetChannelID, original.OriginalID); err != nil {
+		log.Printf("⚠️ Failed to pin Discord message %s: %v", original.OriginalID, err)
+		return
+	}
+	log.Printf("📌 Pinned Discord message %s to match Telegram", original.OriginalID)
+}
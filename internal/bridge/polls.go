@@ -0,0 +1,85 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/types"
+)
+
+// CreatePoll creates a poll in the Telegram chat bridged to a Discord channel
+func (bc *BridgeCore) CreatePoll(discordChannelID, question string, options []string, anonymous bool) (*models.Poll, error) {
+	if bc.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	var telegramChatID string
+	for _, conn := range bc.channelConnections(discordChannelID) {
+		if conn.SourcePlatform == types.PlatformDiscord && conn.TargetPlatform == types.PlatformTelegram {
+			telegramChatID = conn.TargetChannelID
+			break
+		}
+	}
+	if telegramChatID == "" {
+		return nil, fmt.Errorf("channel %s is not bridged to Telegram", discordChannelID)
+	}
+
+	adapter, ok := bc.platforms[types.PlatformTelegram].(*TelegramAdapter)
+	if !ok || !adapter.IsConnected() {
+		return nil, fmt.Errorf("Telegram is not available")
+	}
+
+	messageID, pollID, err := adapter.SendPoll(telegramChatID, question, options, anonymous)
+	if err != nil {
+		return nil, err
+	}
+
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal poll options: %v", err)
+	}
+	resultsJSON, err := json.Marshal(make([]int, len(options)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal poll results: %v", err)
+	}
+
+	poll := &models.Poll{
+		DiscordChannelID: discordChannelID,
+		TelegramChatID:   telegramChatID,
+		TelegramMsgID:    messageID,
+		TelegramPollID:   pollID,
+		Question:         question,
+		OptionsJSON:      string(optionsJSON),
+		ResultsJSON:      string(resultsJSON),
+		IsAnonymous:      anonymous,
+	}
+	if err := bc.db.StorePoll(poll); err != nil {
+		return nil, err
+	}
+
+	log.Printf("📊 Poll %d created in Telegram chat %s from Discord channel %s", poll.ID, telegramChatID, discordChannelID)
+	return poll, nil
+}
+
+// GetPoll returns a poll created from Discord, or nil if it does not exist
+func (bc *BridgeCore) GetPoll(id int) (*models.Poll, error) {
+	if bc.db == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+	return bc.db.GetPoll(id)
+}
+
+// UpdatePollResults stores the latest vote counts reported by Telegram for a poll
+func (bc *BridgeCore) UpdatePollResults(telegramPollID string, votes []int, totalVoters int) error {
+	if bc.db == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	resultsJSON, err := json.Marshal(votes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal poll results: %v", err)
+	}
+	return bc.db.UpdatePollResults(telegramPollID, string(resultsJSON), totalVoters)
+}
@@ -0,0 +1,237 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/types"
+)
+
+// Defaults of the retry policy, used when none has been configured
+const (
+	defaultMaxRetries    = 5
+	defaultRetryDelay    = time.Second
+	defaultMaxRetryDelay = 5 * time.Minute
+)
+
+// retryPollInterval is how often the retry queue looks for due messages
+const retryPollInterval = time.Second
+
+// retryBatchSize is the most pending messages retried per poll
+const retryBatchSize = 50
+
+// retryPolicy decides how often and how fast failed deliveries are retried
+type retryPolicy struct {
+	maxRetries   int
+	initialDelay time.Duration
+	maxDelay     time.Duration
+}
+
+// SetRetryPolicy sets the global retry policy; bridges may override the retry count and backoff multiplier
+func (bc *BridgeCore) SetRetryPolicy(maxRetries int, initialDelay, maxDelay time.Duration) {
+	if initialDelay <= 0 {
+		initialDelay = defaultRetryDelay
+	}
+	if maxDelay <= 0 {
+		maxDelay = defaultMaxRetryDelay
+	}
+	bc.retry = retryPolicy{maxRetries: maxRetries, initialDelay: initialDelay, maxDelay: maxDelay}
+}
+
+// retryDelay returns initialDelay * multiplier^attempts, capped at maxDelay
+func retryDelay(initialDelay time.Duration, multiplier float64, attempts int, maxDelay time.Duration) time.Duration {
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	delay := float64(initialDelay) * math.Pow(multiplier, float64(attempts))
+	if delay > float64(maxDelay) {
+		return maxDelay
+	}
+	return time.Duration(delay)
+}
+
+// bridgeRetryPolicy returns the retry count and backoff multiplier of the bridge a message came from
+func (bc *BridgeCore) bridgeRetryPolicy(sourcePlatform, sourceChannelID string) (int, float64) {
+	maxRetries, multiplier := bc.retry.maxRetries, 2.0
+
+	config, err := bc.getBridgeConfig(sourcePlatform, sourceChannelID)
+	if err != nil {
+		return maxRetries, multiplier
+	}
+	if config.MaxRetries > 0 {
+		maxRetries = config.MaxRetries
+	}
+	if config.RetryBackoffMultiplier > 0 {
+		multiplier = config.RetryBackoffMultiplier
+	}
+	return maxRetries, multiplier
+}
+
+// enqueueRetry stores a failed delivery in the retry queue
+func (bc *BridgeCore) enqueueRetry(connection *types.BridgeConnection, message *types.BridgeMessage, deliveryErr error) {
+	if bc.db == nil {
+		return
+	}
+
+	maxRetries, multiplier := bc.bridgeRetryPolicy(message.SourcePlatform, message.SourceChannelID)
+	if maxRetries <= 0 {
+		return
+	}
+
+	nextAttemptAt := time.Now().Add(retryDelay(bc.retry.initialDelay, multiplier, 0, bc.retry.maxDelay))
+	if err := bc.enqueuePending(connection, message, deliveryErr.Error(), nextAttemptAt); err != nil {
+		log.Printf("⚠️ Failed to queue message %s for retry: %v", message.ID, err)
+		bc.reportError(err, map[string]string{"level": "error", "title": "Failed to queue message for retry", "bridge_id": connection.ID})
+		return
+	}
+	log.Printf("🔁 Queued message %s for retry to %s channel %s", message.ID, connection.TargetPlatform, connection.TargetChannelID)
+}
+
+// enqueuePending stores a message in the queue for delivery to a connection at nextAttemptAt
+func (bc *BridgeCore) enqueuePending(connection *types.BridgeConnection, message *types.BridgeMessage, lastError string, nextAttemptAt time.Time) error {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %v", err)
+	}
+
+	return bc.db.EnqueuePendingMessage(&models.PendingMessage{
+		BridgeID:        connection.ID,
+		TargetPlatform:  connection.TargetPlatform,
+		TargetChannelID: connection.TargetChannelID,
+		Payload:         string(payload),
+		Media:           message.MediaBytes,
+		LastError:       lastError,
+		NextAttemptAt:   nextAttemptAt,
+	})
+}
+
+// StartRetryQueue retries queued deliveries until the bridge core is stopped
+func (bc *BridgeCore) StartRetryQueue() {
+	if bc.db == nil {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(retryPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				bc.processRetryQueue()
+			case <-bc.stopChan:
+				return
+			}
+		}
+	}()
+}
+
+// processRetryQueue retries every pending message whose next attempt is due
+func (bc *BridgeCore) processRetryQueue() {
+	pending, err := bc.db.GetDuePendingMessages(time.Now(), retryBatchSize)
+	if err != nil {
+		log.Printf("⚠️ Failed to read retry queue: %v", err)
+		return
+	}
+
+	for _, item := range pending {
+		bc.retryPendingMessage(item)
+	}
+}
+
+// retryPendingMessage makes one more delivery attempt, rescheduling or dropping the message on failure.
+// Delayed messages are queued without an error and count their first attempt as a regular delivery.
+func (bc *BridgeCore) retryPendingMessage(item *models.PendingMessage) {
+	var message types.BridgeMessage
+	if err := json.Unmarshal([]byte(item.Payload), &message); err != nil {
+		log.Printf("⚠️ Dropping undecodable queued message %d: %v", item.ID, err)
+		bc.deletePendingMessage(item.ID)
+		return
+	}
+	message.MediaBytes = item.Media
+
+	connection := bc.findConnection(item.BridgeID)
+	if connection == nil || !connection.IsActive {
+		log.Printf("⏭️ Dropping queued message %s, bridge %s is gone or inactive", message.ID, item.BridgeID)
+		bc.deletePendingMessage(item.ID)
+		return
+	}
+
+	// A timed out send keeps running in the background and may have delivered the message meanwhile
+	if item.LastError == errDeliveryTimeout.Error() && bc.deliveredLate(&message, connection) {
+		log.Printf("⏭️ Dropping queued message %s, its timed out delivery to %s completed late", message.ID, connection.TargetPlatform)
+		bc.deletePendingMessage(item.ID)
+		return
+	}
+
+	eventType := BridgeEventRetry
+	if item.Attempts == 0 && item.LastError == "" {
+		eventType = BridgeEventDelivery
+	}
+
+	start := time.Now()
+	err := fmt.Errorf("%s is not connected", connection.TargetPlatform)
+	if targetPlatform := bc.platforms[connection.TargetPlatform]; targetPlatform != nil && targetPlatform.IsConnected() {
+		err = bc.deliverWithTimeout(connection, targetPlatform, &message)
+	}
+	if err == nil {
+		bc.recordBridgeEvent(eventType, &message, connection, DeliveryStatusDelivered, nil, start)
+		if eventType == BridgeEventDelivery {
+			log.Printf("✅ Delayed message %s delivered to %s", message.ID, connection.TargetPlatform)
+		} else {
+			log.Printf("✅ Queued message %s delivered to %s after %d retries", message.ID, connection.TargetPlatform, item.Attempts+1)
+		}
+		bc.recordBridgedMessage(message.SourceChannelID, connection.TargetChannelID)
+		bc.publishMessageBridged(&message, connection)
+		bc.deletePendingMessage(item.ID)
+		return
+	}
+
+	attempts := item.Attempts + 1
+	maxRetries, multiplier := bc.bridgeRetryPolicy(message.SourcePlatform, message.SourceChannelID)
+	if attempts >= maxRetries {
+		bc.recordBridgeEvent(eventType, &message, connection, DeliveryStatusDropped, err, start)
+		log.Printf("❌ Giving up on message %s to %s channel %s after %d retries: %v", message.ID, connection.TargetPlatform, connection.TargetChannelID, attempts, err)
+		bc.reportError(err, map[string]string{
+			"level":     "warning",
+			"title":     "Message dropped after retries",
+			"bridge_id": connection.ID,
+			"retries":   fmt.Sprint(attempts),
+		})
+		bc.deletePendingMessage(item.ID)
+		return
+	}
+
+	bc.recordBridgeEvent(eventType, &message, connection, DeliveryStatusFailed, err, start)
+	nextAttemptAt := time.Now().Add(retryDelay(bc.retry.initialDelay, multiplier, attempts, bc.retry.maxDelay))
+	if err := bc.db.ReschedulePendingMessage(item.ID, attempts, err.Error(), nextAttemptAt); err != nil {
+		log.Printf("⚠️ %v", err)
+	}
+}
+
+// deletePendingMessage removes a message from the retry queue, logging failures
+func (bc *BridgeCore) deletePendingMessage(id int64) {
+	if err := bc.db.DeletePendingMessage(id); err != nil {
+		log.Printf("⚠️ %v", err)
+	}
+}
+
+// findConnection returns the bridge connection with an ID, or nil
+func (bc *BridgeCore) findConnection(connectionID string) *types.BridgeConnection {
+	bc.connectionsMu.RLock()
+	defer bc.connectionsMu.RUnlock()
+
+	for _, connections := range bc.connections {
+		for _, conn := range connections {
+			if conn.ID == connectionID {
+				return conn
+			}
+		}
+	}
+	return nil
+}
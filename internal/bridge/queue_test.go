@@ -0,0 +1,180 @@
+package bridge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"dcbot/internal/database"
+	"dcbot/internal/database/models"
+	"dcbot/internal/types"
+)
+
+// fakePlatform is a connected platform whose sends fail with sendErr
+type fakePlatform struct {
+	name    string
+	sendErr error
+	sent    []string
+}
+
+func (p *fakePlatform) GetName() string                { return p.name }
+func (p *fakePlatform) IsConnected() bool              { return true }
+func (p *fakePlatform) Ping(ctx context.Context) error { return nil }
+func (p *fakePlatform) FormatMessage(message *types.BridgeMessage) string {
+	return message.Content
+}
+func (p *fakePlatform) TestCredentials(ctx context.Context) (string, time.Duration, error) {
+	return p.name, 0, nil
+}
+func (p *fakePlatform) SendMessage(ctx context.Context, channelID, content string) error {
+	if p.sendErr != nil {
+		return p.sendErr
+	}
+	p.sent = append(p.sent, content)
+	return nil
+}
+
+// newTestBridgeCore returns a bridge core backed by a fresh database in a temporary directory
+func newTestBridgeCore(t *testing.T) *BridgeCore {
+	t.Helper()
+
+	db, err := database.NewDatabase(filepath.Join(t.TempDir(), "bridge.db"))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	bc := NewBridgeCore(db)
+	t.Cleanup(bc.Stop)
+	return bc
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name       string
+		multiplier float64
+		attempts   int
+		want       time.Duration
+	}{
+		{"first attempt", 2, 0, time.Second},
+		{"second attempt", 2, 1, 2 * time.Second},
+		{"fourth attempt", 2, 3, 8 * time.Second},
+		{"fractional multiplier", 1.5, 2, 2250 * time.Millisecond},
+		{"capped at max delay", 2, 20, time.Minute},
+		{"multiplier below one", 0.5, 3, time.Second},
+		{"zero multiplier", 0, 3, time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryDelay(time.Second, tt.multiplier, tt.attempts, time.Minute); got != tt.want {
+				t.Errorf("retryDelay(1s, %v, %d, 1m) = %v, want %v", tt.multiplier, tt.attempts, got, tt.want)
+			}
+		})
+	}
+}
+
+// queueFailingDelivery sets up a bridge to a failing platform and queues a message for it that already failed attempts times
+func queueFailingDelivery(t *testing.T, bc *BridgeCore, attempts int) *models.PendingMessage {
+	t.Helper()
+
+	bc.RegisterPlatform(&fakePlatform{name: "fake", sendErr: errors.New("send failed")})
+	connection := &types.BridgeConnection{
+		ID:              connectionID("source", "1", "fake", "2"),
+		SourcePlatform:  "source",
+		SourceChannelID: "1",
+		TargetPlatform:  "fake",
+		TargetChannelID: "2",
+		IsActive:        true,
+	}
+	bc.connections["1"] = []*types.BridgeConnection{connection}
+
+	payload, err := json.Marshal(&types.BridgeMessage{ID: "message", SourcePlatform: "source", SourceChannelID: "1", Content: "hello"})
+	if err != nil {
+		t.Fatalf("failed to encode message: %v", err)
+	}
+	item := &models.PendingMessage{
+		BridgeID:        connection.ID,
+		TargetPlatform:  connection.TargetPlatform,
+		TargetChannelID: connection.TargetChannelID,
+		Payload:         string(payload),
+		Attempts:        attempts,
+		LastError:       "send failed",
+		NextAttemptAt:   time.Now(),
+	}
+	if err := bc.db.EnqueuePendingMessage(item); err != nil {
+		t.Fatalf("failed to queue message: %v", err)
+	}
+	return item
+}
+
+// queuedMessages returns every message in the retry queue, due or not
+func queuedMessages(t *testing.T, bc *BridgeCore) []*models.PendingMessage {
+	t.Helper()
+
+	pending, err := bc.db.GetDuePendingMessages(time.Now().Add(24*time.Hour), 10)
+	if err != nil {
+		t.Fatalf("failed to read retry queue: %v", err)
+	}
+	return pending
+}
+
+func TestRetryPendingMessageReschedulesWithBackoff(t *testing.T) {
+	bc := newTestBridgeCore(t)
+	bc.SetRetryPolicy(5, time.Second, time.Minute)
+	item := queueFailingDelivery(t, bc, 2)
+
+	before := time.Now()
+	bc.retryPendingMessage(item)
+
+	pending := queuedMessages(t, bc)
+	if len(pending) != 1 {
+		t.Fatalf("got %d queued messages, want 1", len(pending))
+	}
+	if pending[0].Attempts != 3 {
+		t.Errorf("attempts = %d, want 3", pending[0].Attempts)
+	}
+
+	// The third failure waits initialDelay * 2^3
+	delay := pending[0].NextAttemptAt.Sub(before)
+	if delay < 8*time.Second || delay > 9*time.Second {
+		t.Errorf("next attempt in %v, want about 8s", delay)
+	}
+}
+
+func TestRetryPendingMessageDropsAfterMaxRetries(t *testing.T) {
+	bc := newTestBridgeCore(t)
+	bc.SetRetryPolicy(5, time.Second, time.Minute)
+	item := queueFailingDelivery(t, bc, 4)
+
+	bc.retryPendingMessage(item)
+
+	if pending := queuedMessages(t, bc); len(pending) != 0 {
+		t.Errorf("got %d queued messages after the last retry, want 0", len(pending))
+	}
+}
+
+func TestRetryPendingMessageSkipsLateTimedOutDelivery(t *testing.T) {
+	bc := newTestBridgeCore(t)
+	item := queueFailingDelivery(t, bc, 0)
+	item.LastError = errDeliveryTimeout.Error()
+
+	platform := &fakePlatform{name: "fake"}
+	bc.RegisterPlatform(platform)
+
+	// The timed out send completed in the background after the message was queued
+	message := &types.BridgeMessage{ID: "message", SourcePlatform: "source", SourceChannelID: "1", Content: "hello"}
+	bc.saveMessageMappingStatus(message, bc.connections["1"][0], "late_2_message", "sent")
+
+	bc.retryPendingMessage(item)
+
+	if len(platform.sent) != 0 {
+		t.Errorf("message was sent again: %v", platform.sent)
+	}
+	if pending := queuedMessages(t, bc); len(pending) != 0 {
+		t.Errorf("got %d queued messages, want 0", len(pending))
+	}
+}
@@ -0,0 +1,103 @@
+package bridge
+
+import (
+	"log"
+	"time"
+
+	"dcbot/internal/types"
+	"golang.org/x/time/rate"
+)
+
+// messageBucket is a token bucket holding up to a minute's worth of messages, refilled continuously
+type messageBucket struct {
+	limit   int // Messages per minute the bucket was created for
+	limiter *rate.Limiter
+}
+
+// newMessageBucket returns a full bucket for a per-minute limit
+func newMessageBucket(limit int) *messageBucket {
+	return &messageBucket{limit: limit, limiter: rate.NewLimiter(rate.Limit(float64(limit)/60), limit)}
+}
+
+// allowAt takes a token for a message arriving at now, reporting false if none is left
+func (b *messageBucket) allowAt(now time.Time) bool {
+	return b.limiter.AllowN(now, 1)
+}
+
+// tokensAt returns how many messages the bucket would let through at now
+func (b *messageBucket) tokensAt(now time.Time) float64 {
+	return b.limiter.TokensAt(now)
+}
+
+// timeUntilFull estimates how long a bucket for a per-minute limit needs to refill completely from tokens left
+func timeUntilFull(limit int, tokens float64) time.Duration {
+	missing := float64(limit) - tokens
+	if missing <= 0 || limit <= 0 {
+		return 0
+	}
+	return time.Duration(missing / float64(limit) * float64(time.Minute))
+}
+
+// allowMessage takes a token from the source channel's bucket, reporting false once the bridge's rate limit is exceeded
+func (bc *BridgeCore) allowMessage(message *types.BridgeMessage) bool {
+	if bc.db == nil {
+		return true
+	}
+
+	config, err := bc.getBridgeConfig(message.SourcePlatform, message.SourceChannelID)
+	if err != nil {
+		log.Printf("⚠️ Failed to get bridge config for %s channel %s: %v", message.SourcePlatform, message.SourceChannelID, err)
+		return true
+	}
+	if config.RateLimitPerMinute <= 0 {
+		return true
+	}
+
+	bc.rateLimitsMu.Lock()
+	defer bc.rateLimitsMu.Unlock()
+
+	bucket := bc.rateLimits[message.SourceChannelID]
+	if bucket == nil || bucket.limit != config.RateLimitPerMinute {
+		bucket = newMessageBucket(config.RateLimitPerMinute)
+		bc.rateLimits[message.SourceChannelID] = bucket
+	}
+	return bucket.allowAt(time.Now())
+}
+
+// SimulateRateLimit reports how a burst of messages arriving at once would fare against a channel's rate limit.
+// It leaves the channel's bucket untouched; a positive limit tries that limit with a full bucket instead.
+func (bc *BridgeCore) SimulateRateLimit(platform, channelID string, messages, limit int) (*types.RateLimitSimulation, error) {
+	return bc.simulateRateLimitAt(platform, channelID, messages, limit, time.Now())
+}
+
+// simulateRateLimitAt is SimulateRateLimit for a burst arriving at now
+func (bc *BridgeCore) simulateRateLimitAt(platform, channelID string, messages, limit int, now time.Time) (*types.RateLimitSimulation, error) {
+	tokens := float64(limit)
+	if limit <= 0 {
+		config, err := bc.getBridgeConfig(platform, channelID)
+		if err != nil {
+			return nil, err
+		}
+		limit = config.RateLimitPerMinute
+		if limit <= 0 {
+			return &types.RateLimitSimulation{Messages: messages, Delivered: messages}, nil
+		}
+
+		tokens = float64(limit)
+		bc.rateLimitsMu.Lock()
+		if current := bc.rateLimits[channelID]; current != nil && current.limit == limit {
+			tokens = current.tokensAt(now)
+		}
+		bc.rateLimitsMu.Unlock()
+	}
+
+	delivered := min(messages, max(int(tokens), 0))
+
+	return &types.RateLimitSimulation{
+		Limit:     limit,
+		Messages:  messages,
+		Delivered: delivered,
+		Dropped:   messages - delivered,
+		RefillIn:  timeUntilFull(limit, tokens-float64(delivered)),
+	}, nil
+}
@@ -0,0 +1,155 @@
+package bridge
+
+import (
+	"testing"
+	"time"
+
+	"dcbot/internal/types"
+)
+
+func TestMessageBucketRefill(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		limit      int
+		taken      int // Messages let through at start
+		elapsed    time.Duration
+		wantTokens float64
+	}{
+		{"full when created", 60, 0, 0, 60},
+		{"empty after a burst", 60, 60, 0, 0},
+		{"refills one per second", 60, 60, time.Second, 1},
+		{"refills continuously", 60, 60, 1500 * time.Millisecond, 1.5},
+		{"refills at the per-minute rate", 6, 6, 10 * time.Second, 1},
+		{"capped at the limit", 60, 10, 2 * time.Minute, 60},
+	}
+
+	for _, tt := range tests {
+		bucket := newMessageBucket(tt.limit)
+		for i := 0; i < tt.taken; i++ {
+			if !bucket.allowAt(start) {
+				t.Fatalf("%s: message %d of a full bucket was rejected", tt.name, i+1)
+			}
+		}
+
+		if got := bucket.tokensAt(start.Add(tt.elapsed)); got != tt.wantTokens {
+			t.Errorf("%s: tokens = %v, want %v", tt.name, got, tt.wantTokens)
+		}
+	}
+}
+
+func TestMessageBucketAllow(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	bucket := newMessageBucket(60)
+	for i := 0; i < 60; i++ {
+		bucket.allowAt(start)
+	}
+
+	steps := []struct {
+		elapsed time.Duration
+		want    bool
+	}{
+		{0, false},
+		{500 * time.Millisecond, false},
+		{time.Second, true},
+		{time.Second, false},
+		{3 * time.Second, true},
+		{3 * time.Second, true},
+		{3 * time.Second, false},
+	}
+
+	for _, step := range steps {
+		if got := bucket.allowAt(start.Add(step.elapsed)); got != step.want {
+			t.Errorf("allowAt(+%s) = %t, want %t", step.elapsed, got, step.want)
+		}
+	}
+}
+
+func TestTimeUntilFull(t *testing.T) {
+	tests := []struct {
+		limit  int
+		tokens float64
+		want   time.Duration
+	}{
+		{60, 60, 0},
+		{60, 0, time.Minute},
+		{60, 30, 30 * time.Second},
+		{6, 5, 10 * time.Second},
+		{60, 0.5, 59500 * time.Millisecond},
+		{0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		if got := timeUntilFull(tt.limit, tt.tokens); got != tt.want {
+			t.Errorf("timeUntilFull(%d, %v) = %s, want %s", tt.limit, tt.tokens, got, tt.want)
+		}
+	}
+}
+
+func TestSimulateRateLimit(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	bc := newTestBridgeCore(t)
+	bc.RegisterPlatform(&fakePlatform{name: types.PlatformDiscord})
+	bc.RegisterPlatform(&fakePlatform{name: types.PlatformTelegram})
+	for _, channels := range [][2]string{{"100", "-100"}, {"200", "-200"}, {"300", "-300"}} {
+		if err := bc.AddBridge(types.PlatformDiscord, channels[0], types.PlatformTelegram, channels[1]); err != nil {
+			t.Fatalf("AddBridge: %v", err)
+		}
+	}
+	// Channel 100 is limited to 60 messages per minute and used 50 of them at start, 200 is limited but idle, 300 is unlimited
+	for _, channelID := range []string{"100", "200"} {
+		config, err := bc.GetBridgeConfig(types.PlatformDiscord, channelID)
+		if err != nil {
+			t.Fatalf("GetBridgeConfig: %v", err)
+		}
+		config.RateLimitPerMinute = 60
+		if err := bc.UpdateBridgeConfig(types.PlatformDiscord, channelID, config); err != nil {
+			t.Fatalf("UpdateBridgeConfig: %v", err)
+		}
+	}
+	bucket := newMessageBucket(60)
+	for i := 0; i < 50; i++ {
+		bucket.allowAt(start)
+	}
+	bc.rateLimits["100"] = bucket
+
+	tests := []struct {
+		name      string
+		channelID string
+		messages  int
+		limit     int
+		elapsed   time.Duration
+		want      types.RateLimitSimulation
+	}{
+		{"partly used bucket", "100", 20, 0, 5 * time.Second,
+			types.RateLimitSimulation{Limit: 60, Messages: 20, Delivered: 15, Dropped: 5, RefillIn: time.Minute}},
+		{"bucket refilled", "100", 20, 0, time.Minute,
+			types.RateLimitSimulation{Limit: 60, Messages: 20, Delivered: 20, RefillIn: 20 * time.Second}},
+		{"limit override starts full", "100", 15, 10, 0,
+			types.RateLimitSimulation{Limit: 10, Messages: 15, Delivered: 10, Dropped: 5, RefillIn: time.Minute}},
+		{"idle channel", "200", 3, 0, 0,
+			types.RateLimitSimulation{Limit: 60, Messages: 3, Delivered: 3, RefillIn: 3 * time.Second}},
+		{"unlimited channel", "300", 500, 0, 0,
+			types.RateLimitSimulation{Messages: 500, Delivered: 500}},
+	}
+
+	for _, tt := range tests {
+		got, err := bc.simulateRateLimitAt(types.PlatformDiscord, tt.channelID, tt.messages, tt.limit, start.Add(tt.elapsed))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if *got != tt.want {
+			t.Errorf("%s: simulation = %+v, want %+v", tt.name, *got, tt.want)
+		}
+	}
+
+	if got := bucket.tokensAt(start); got != 10 {
+		t.Errorf("simulations changed the channel's bucket to %v tokens, want 10", got)
+	}
+	if _, err := bc.simulateRateLimitAt(types.PlatformDiscord, "999", 1, 0, start); err == nil {
+		t.Error("simulating an unbridged channel succeeded")
+	}
+}
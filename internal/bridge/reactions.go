@@ -0,0 +1,190 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/platforms/telegram"
+	"dcbot/internal/types"
+)
+
+// reactionDebounce groups reaction changes arriving close together into a single Telegram edit
+const reactionDebounce = 500 * time.Millisecond
+
+// saveMessageMapping records where a bridged message was delivered
+func (bc *BridgeCore) saveMessageMapping(message *types.BridgeMessage, connection *types.BridgeConnection, targetMessageID string) {
+	if bc.db == nil {
+		return
+	}
+
+	err := bc.db.SaveMessageMapping(&models.Message{
+		OriginalID:     message.SourceMessageID,
+		SourcePlatform: message.SourcePlatform,
+		SourceRoomID:   message.SourceChannelID,
+		SourceUserID:   message.SourceUserID,
+		Content:        message.Content,
+		MessageType:    message.MessageType,
+	}, &models.MessageMapping{
+		Platform:       connection.TargetPlatform,
+		PlatformMsgID:  targetMessageID,
+		PlatformRoomID: connection.TargetChannelID,
+		Status:         "sent",
+	})
+	if err != nil {
+		log.Printf("⚠️ Failed to save message mapping for %s message %s: %v", message.SourcePlatform, message.SourceMessageID, err)
+	}
+}
+
+// BridgeReaction updates the reaction counts of a Discord message and schedules an edit of its Telegram copy
+func (bc *BridgeCore) BridgeReaction(channelID, messageID, emoji string, added bool) error {
+	if bc.db == nil {
+		return nil
+	}
+
+	config, err := bc.getBridgeConfig(types.PlatformDiscord, channelID)
+	if err != nil || !config.BridgeReactions {
+		return nil
+	}
+
+	bc.reactionMu.Lock()
+	defer bc.reactionMu.Unlock()
+
+	reaction, err := bc.db.GetReaction(messageID)
+	if err != nil {
+		return err
+	}
+	if reaction == nil {
+		if !added {
+			return nil
+		}
+		_, mapping, err := bc.db.GetMessageMapping(types.PlatformDiscord, messageID, types.PlatformTelegram)
+		if err != nil {
+			return nil // Message was not bridged to Telegram
+		}
+		reaction = &models.Reaction{
+			DiscordMsgID:   messageID,
+			ReactionsJSON:  "{}",
+			TelegramMsgID:  mapping.PlatformMsgID,
+			TelegramChatID: mapping.PlatformRoomID,
+		}
+	}
+
+	counts := make(map[string]int)
+	if err := json.Unmarshal([]byte(reaction.ReactionsJSON), &counts); err != nil {
+		return fmt.Errorf("invalid reactions for message %s: %v", messageID, err)
+	}
+
+	if added {
+		counts[emoji]++
+	} else if counts[emoji]--; counts[emoji] <= 0 {
+		delete(counts, emoji)
+	}
+
+	data, err := json.Marshal(counts)
+	if err != nil {
+		return fmt.Errorf("failed to marshal reactions: %v", err)
+	}
+	reaction.ReactionsJSON = string(data)
+
+	if err := bc.db.SaveReaction(reaction); err != nil {
+		return err
+	}
+
+	// A pending edit will pick up the new counts
+	if _, pending := bc.reactionTimers[messageID]; !pending {
+		bc.reactionTimers[messageID] = time.AfterFunc(reactionDebounce, func() {
+			bc.reactionMu.Lock()
+			delete(bc.reactionTimers, messageID)
+			bc.reactionMu.Unlock()
+
+			if err := bc.sendReactionEdit(messageID); err != nil {
+				log.Printf("❌ Failed to bridge reactions for Discord message %s: %v", messageID, err)
+			}
+		})
+	}
+
+	return nil
+}
+
+// sendReactionEdit edits the Telegram copy of a Discord message to show its current reactions
+func (bc *BridgeCore) sendReactionEdit(messageID string) error {
+	telegramAdapter, ok := bc.platforms[types.PlatformTelegram].(*TelegramAdapter)
+	if !ok {
+		return fmt.Errorf("telegram platform not registered")
+	}
+
+	reaction, err := bc.db.GetReaction(messageID)
+	if err != nil || reaction == nil {
+		return err
+	}
+
+	message, _, err := bc.db.GetMessageMapping(types.PlatformDiscord, messageID, types.PlatformTelegram)
+	if err != nil {
+		return fmt.Errorf("message mapping not found: %v", err)
+	}
+
+	counts := make(map[string]int)
+	if err := json.Unmarshal([]byte(reaction.ReactionsJSON), &counts); err != nil {
+		return fmt.Errorf("invalid reactions: %v", err)
+	}
+
+	content := telegramAdapter.FormatMessage(&types.BridgeMessage{
+		SourcePlatform:  message.SourcePlatform,
+		SourceChannelID: message.SourceRoomID,
+		SourceUserID:    message.SourceUserID,
+		Username:        bc.getDisplayName(message.SourcePlatform, message.SourceUserID),
+		Content:         message.Content,
+	})
+	if summary := formatReactionSummary(counts); summary != "" {
+		content += "\n" + summary
+	}
+
+	if err := telegramAdapter.EditMessage(reaction.TelegramChatID, reaction.TelegramMsgID, content); err != nil {
+		return err
+	}
+
+	// Also react with the most used emoji Telegram supports, bots can only set one reaction
+	for _, emoji := range sortedReactions(counts) {
+		if !telegram.IsSupportedReaction(emoji) {
+			continue
+		}
+		if err := telegramAdapter.SendReaction(reaction.TelegramChatID, reaction.TelegramMsgID, emoji); err != nil {
+			log.Printf("⚠️ Failed to set Telegram reaction for Discord message %s: %v", messageID, err)
+		}
+		break
+	}
+	return nil
+}
+
+// sortedReactions returns the emoji of reaction counts, most used first
+func sortedReactions(counts map[string]int) []string {
+	emojis := make([]string, 0, len(counts))
+	for emoji := range counts {
+		emojis = append(emojis, emoji)
+	}
+	sort.Slice(emojis, func(i, j int) bool {
+		if counts[emojis[i]] != counts[emojis[j]] {
+			return counts[emojis[i]] > counts[emojis[j]]
+		}
+		return emojis[i] < emojis[j]
+	})
+	return emojis
+}
+
+// formatReactionSummary renders reaction counts like "[👍 3 | ❤️ 1]", most used first
+func formatReactionSummary(counts map[string]int) string {
+	if len(counts) == 0 {
+		return ""
+	}
+
+	parts := make([]string, 0, len(counts))
+	for _, emoji := range sortedReactions(counts) {
+		parts = append(parts, fmt.Sprintf("%s %d", emoji, counts[emoji]))
+	}
+	return "[" + strings.Join(parts, " | ") + "]"
+}
@@ -0,0 +1,78 @@
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// selfTestTimeout limits how long a single bridge check may take
+const selfTestTimeout = 10 * time.Second
+
+// SelfTestResult is the outcome of checking that a bridge's target channel is reachable
+type SelfTestResult struct {
+	BridgeID string
+	OK       bool
+	Error    string
+}
+
+// channelVerifier is implemented by platforms that can check a channel exists and is accessible to the bot
+type channelVerifier interface {
+	VerifyChannel(ctx context.Context, channelID string) error
+}
+
+// SelfTest checks that the target channel of every active bridge connection is reachable
+func (bc *BridgeCore) SelfTest(ctx context.Context) []SelfTestResult {
+	var results []SelfTestResult
+	for _, connections := range bc.GetAllBridges() {
+		for _, connection := range connections {
+			if !connection.IsActive {
+				continue
+			}
+
+			result := SelfTestResult{BridgeID: connection.ID, OK: true}
+			if err := bc.verifyTarget(ctx, connection.TargetPlatform, connection.TargetChannelID); err != nil {
+				result.OK = false
+				result.Error = err.Error()
+			}
+			results = append(results, result)
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].BridgeID < results[j].BridgeID
+	})
+	return results
+}
+
+// verifyTarget checks a single target channel, giving up after selfTestTimeout
+func (bc *BridgeCore) verifyTarget(ctx context.Context, platformName, channelID string) error {
+	platform := bc.platforms[platformName]
+	if platform == nil {
+		return fmt.Errorf("platform %s is not registered", platformName)
+	}
+	if !platform.IsConnected() {
+		return fmt.Errorf("platform %s is not connected", platformName)
+	}
+
+	verifier, ok := platform.(channelVerifier)
+	if !ok {
+		return nil // Nothing to check without API access, e.g. incoming webhooks
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, selfTestTimeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- verifier.VerifyChannel(ctx, channelID)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("%s channel %s did not respond: %v", platformName, channelID, ctx.Err())
+	}
+}
@@ -0,0 +1,74 @@
+package bridge
+
+import (
+	"dcbot/internal/types"
+)
+
+// SimulateMessage runs a message through the filters, transformers and formatters
+// of its bridges without sending it anywhere or touching any counters
+func (bc *BridgeCore) SimulateMessage(message *types.BridgeMessage) *types.SimulationResult {
+	result := &types.SimulationResult{
+		TargetConnections: []*types.BridgeConnection{},
+		FormattedMessages: make(map[string]string),
+	}
+
+	// Work on a copy so filters and transformers don't change the caller's message
+	simulated := *message
+
+	connections := bc.channelConnections(simulated.SourceChannelID)
+	if len(connections) == 0 {
+		result.WouldBeFiltered = true
+		result.FilterReason = FilterTypeNoConnection
+		return result
+	}
+
+	if !bc.isSenderAllowed(&simulated) {
+		result.WouldBeFiltered = true
+		result.FilterReason = FilterTypeSenderNotAllowed
+		return result
+	}
+
+	if !bc.isMessageTypeEnabled(&simulated) {
+		result.WouldBeFiltered = true
+		result.FilterReason = FilterTypeMediaNotAllowed
+		return result
+	}
+
+	if filters := bc.channelFilters(simulated.SourceChannelID); len(filters) > 0 {
+		content, blocked := applyFilters(filters, simulated.Content)
+		if blocked {
+			result.WouldBeFiltered = true
+			result.FilterReason = FilterTypeWordFilter
+			return result
+		}
+		simulated.Content = content
+	}
+
+	bc.applyTransformers(&simulated)
+
+	skipReason := ""
+	for _, connection := range connections {
+		if !connection.IsActive {
+			skipReason = FilterTypeBridgeInactive
+			continue
+		}
+
+		targetPlatform := bc.platforms[connection.TargetPlatform]
+		if targetPlatform == nil || !targetPlatform.IsConnected() {
+			skipReason = FilterTypeNoConnection
+			continue
+		}
+
+		result.TargetConnections = append(result.TargetConnections, connection)
+		result.FormattedMessages[connection.TargetPlatform] = targetPlatform.FormatMessage(&simulated)
+	}
+
+	result.WouldRoute = len(result.TargetConnections) > 0
+	if !result.WouldRoute {
+		// Every bridge is paused or its target platform is down
+		result.WouldBeFiltered = true
+		result.FilterReason = skipReason
+	}
+
+	return result
+}
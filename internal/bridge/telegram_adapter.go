@@ -1,16 +1,23 @@
 package bridge
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
+	"dcbot/internal/platforms/discord"
 	"dcbot/internal/platforms/telegram"
 	"dcbot/internal/types"
 )
 
 // TelegramAdapter implements the Platform interface for Telegram
 type TelegramAdapter struct {
-	client *telegram.Client
+	client       *telegram.Client
+	discordLinks *discordLinkResolver     // Optional, enriches links to Discord messages
+	silentMode   func(chatID string) bool // Optional, reports chats that should not be notified
+	bridgeConfig bridgeConfigResolver     // Optional, provides the sender header templates of bridges
 }
 
 // NewTelegramAdapter creates a new Telegram adapter
@@ -30,22 +37,235 @@ func (ta *TelegramAdapter) IsConnected() bool {
 	return ta.client.IsRunning()
 }
 
+// Ping checks that the Telegram bot API is reachable
+func (ta *TelegramAdapter) Ping(ctx context.Context) error {
+	return ta.client.Ping(ctx)
+}
+
+// TestCredentials checks that the Telegram bot token is still accepted by the API
+func (ta *TelegramAdapter) TestCredentials(ctx context.Context) (string, time.Duration, error) {
+	start := time.Now()
+	username, err := ta.client.TestCredentials(ctx)
+	return username, time.Since(start), err
+}
+
 // SendMessage sends a message to a Telegram chat
-func (ta *TelegramAdapter) SendMessage(chatID, content string) error {
-	return ta.client.SendMessage(chatID, content)
+func (ta *TelegramAdapter) SendMessage(ctx context.Context, chatID, content string) error {
+	// The Telegram library has no context support, so stop waiting once ctx is done
+	return runWithContext(ctx, func() error {
+		_, err := ta.client.SendMessageWithID(chatID, content, ta.isSilent(chatID))
+		return err
+	})
+}
+
+// SendMessageWithID sends a message to a Telegram chat and returns the sent message ID
+func (ta *TelegramAdapter) SendMessageWithID(chatID, content string) (string, error) {
+	messageID, err := ta.client.SendMessageWithID(chatID, content, ta.isSilent(chatID))
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(messageID), nil
+}
+
+// EditMessage replaces the text of a previously bridged message
+func (ta *TelegramAdapter) EditMessage(chatID, messageID, content string) error {
+	id, err := strconv.Atoi(messageID)
+	if err != nil {
+		return fmt.Errorf("invalid message ID: %v", err)
+	}
+	return ta.client.EditMessageText(chatID, id, content)
+}
+
+// ChatInfo returns the title and member count of a Telegram chat
+func (ta *TelegramAdapter) ChatInfo(chatID string) (*types.ChatInfo, error) {
+	id, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chat ID: %v", err)
+	}
+
+	chat, err := ta.client.GetChatInfo(id)
+	if err != nil {
+		return nil, err
+	}
+	count, err := ta.client.GetChatMemberCount(id)
+	if err != nil {
+		return nil, err
+	}
+
+	isForum, err := ta.client.IsForum(id)
+	if err != nil {
+		return nil, err
+	}
+
+	title := chat.Title
+	if title == "" {
+		title = chat.UserName
+	}
+	return &types.ChatInfo{Title: title, MemberCount: count, IsForum: isForum, Description: chat.Description}, nil
+}
+
+// SetChannelTopic replaces the description of a Telegram group
+func (ta *TelegramAdapter) SetChannelTopic(chatID, topic string) error {
+	id, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid chat ID: %v", err)
+	}
+	return ta.client.SetChatDescription(id, topic)
+}
+
+// ChatAdministrators returns the user IDs of the human administrators of a Telegram group
+func (ta *TelegramAdapter) ChatAdministrators(chatID string) ([]string, error) {
+	id, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chat ID: %v", err)
+	}
+
+	adminIDs, err := ta.client.GetChatAdministrators(id)
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]string, 0, len(adminIDs))
+	for _, adminID := range adminIDs {
+		userIDs = append(userIDs, strconv.FormatInt(adminID, 10))
+	}
+	return userIDs, nil
+}
+
+// SetChatAdmin promotes a Telegram group member to administrator or demotes them
+func (ta *TelegramAdapter) SetChatAdmin(chatID, userID string, admin bool) error {
+	id, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid chat ID: %v", err)
+	}
+	user, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %v", err)
+	}
+	return ta.client.SetChatAdmin(id, user, admin)
+}
+
+// CreateForumTopic creates a topic in a Telegram forum supergroup and returns its ID
+func (ta *TelegramAdapter) CreateForumTopic(chatID, name string) (int, error) {
+	id, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid chat ID: %v", err)
+	}
+	return ta.client.CreateForumTopic(id, name)
+}
+
+// SendTopicMessage sends a message into a forum topic, or the chat itself if topicID is zero, and returns the sent message ID
+func (ta *TelegramAdapter) SendTopicMessage(chatID string, topicID int, content string) (string, error) {
+	if topicID == 0 {
+		return ta.SendMessageWithID(chatID, content)
+	}
+
+	messageID, err := ta.client.SendTopicMessage(chatID, topicID, content, ta.isSilent(chatID))
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(messageID), nil
+}
+
+// SendTopicPhoto sends a photo into a forum topic, or the chat itself if topicID is zero
+func (ta *TelegramAdapter) SendTopicPhoto(chatID string, topicID int, caption string, data []byte) error {
+	if topicID == 0 {
+		return ta.SendPhoto(chatID, caption, data)
+	}
+	return ta.client.SendTopicPhoto(chatID, topicID, caption, data, ta.isSilent(chatID))
+}
+
+// SendBridgeMessage sends a bridged message as text, a photo, an album or a document depending on its type and returns the sent message ID, empty for media
+func (ta *TelegramAdapter) SendBridgeMessage(chatID string, topicID int, message *types.BridgeMessage) (string, error) {
+	// Every kind of message honours the bridge's silent mode through isSilent
+	content := ta.FormatMessage(message)
+	switch {
+	case len(message.MediaBytes) == 0:
+		// Text, events and system notices are sent as plain messages
+		return ta.SendTopicMessage(chatID, topicID, content)
+
+	case message.MessageType == types.MessageTypeFile:
+		return "", ta.client.SendTopicDocument(chatID, topicID, content, message.MediaFileName, message.MediaBytes, ta.isSilent(chatID))
+
+	case len(message.MediaGroup) < 2:
+		return "", ta.SendTopicPhoto(chatID, topicID, content, message.MediaBytes)
+	}
+
+	photos := make([][]byte, 0, len(message.MediaGroup))
+	for _, file := range message.MediaGroup {
+		photos = append(photos, file.Bytes)
+	}
+	return "", ta.client.SendMediaGroup(chatID, topicID, content, photos, ta.isSilent(chatID))
+}
+
+// VerifyChannel checks that a Telegram chat exists and the bot is a member
+func (ta *TelegramAdapter) VerifyChannel(ctx context.Context, chatID string) error {
+	id, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid chat ID: %v", err)
+	}
+
+	if _, err := ta.client.GetChatInfo(id); err != nil {
+		return err
+	}
+
+	member, err := ta.client.IsMember(id)
+	if err != nil {
+		return err
+	}
+	if !member {
+		return fmt.Errorf("bot is not a member of chat %s", chatID)
+	}
+	return nil
+}
+
+// SendReaction sets the bot's reaction on a Telegram message, failing for emoji Telegram does not support
+func (ta *TelegramAdapter) SendReaction(chatID, messageID, emoji string) error {
+	id, err := strconv.Atoi(messageID)
+	if err != nil {
+		return fmt.Errorf("invalid message ID: %v", err)
+	}
+	return ta.client.SetMessageReaction(chatID, id, emoji)
+}
+
+// SendPhoto sends a photo with a caption to a Telegram chat
+func (ta *TelegramAdapter) SendPhoto(chatID, caption string, data []byte) error {
+	return ta.client.SendPhoto(chatID, caption, data, ta.isSilent(chatID))
+}
+
+// SetSilentModeResolver sets the function deciding which chats receive messages without notification
+func (ta *TelegramAdapter) SetSilentModeResolver(resolver func(chatID string) bool) {
+	ta.silentMode = resolver
+}
+
+// SetBridgeConfigResolver sets the function looking up the bridge configuration holding sender header templates
+func (ta *TelegramAdapter) SetBridgeConfigResolver(resolver bridgeConfigResolver) {
+	ta.bridgeConfig = resolver
+}
+
+// isSilent reports whether messages to a chat should be sent without notification
+func (ta *TelegramAdapter) isSilent(chatID string) bool {
+	return ta.silentMode != nil && ta.silentMode(chatID)
+}
+
+// SendPoll creates a poll in a Telegram chat and returns the message and poll IDs
+func (ta *TelegramAdapter) SendPoll(chatID, question string, options []string, anonymous bool) (string, string, error) {
+	messageID, pollID, err := ta.client.SendPoll(chatID, question, options, anonymous)
+	if err != nil {
+		return "", "", err
+	}
+	return strconv.Itoa(messageID), pollID, nil
+}
+
+// SetDiscordClient enables previews of linked Discord messages
+func (ta *TelegramAdapter) SetDiscordClient(client *discord.Client) {
+	ta.discordLinks = newDiscordLinkResolver(client)
 }
 
 // FormatMessage formats a bridge message for Telegram
 func (ta *TelegramAdapter) FormatMessage(message *types.BridgeMessage) string {
-	// Use [PLATFORM] format instead of emojis
-	var platformPrefix string
-	switch message.SourcePlatform {
-	case types.PlatformDiscord:
-		platformPrefix = "[DISCORD]"
-	case types.PlatformTelegram:
-		platformPrefix = "[TELEGRAM]"
-	default:
-		platformPrefix = "[BRIDGE]"
+	// Voice activity notifications and system messages have no sender
+	if message.MessageType == types.MessageTypeVoiceActivity || message.MessageType == types.MessageTypeSystem {
+		return fmt.Sprintf("[%s] %s", headerPlatform(message.SourcePlatform), message.Content)
 	}
 
 	// Use Telegram username format (@username)
@@ -57,8 +277,15 @@ func (ta *TelegramAdapter) FormatMessage(message *types.BridgeMessage) string {
 		username = "@anonymous"
 	}
 	
-	// Format the message for Telegram
-	formattedMessage := fmt.Sprintf("%s %s: %s", platformPrefix, username, message.Content)
+	// Replace links to Discord messages, which Telegram users cannot open, with previews
+	content := forwardPrefix(ta.bridgeConfig, message) + message.Content
+	if ta.discordLinks != nil {
+		content = ta.discordLinks.enrich(content)
+	}
+
+	// Format the message for Telegram with the bridge's sender header
+	header := formatHeader(ta.bridgeConfig, message, headerPlatform(message.SourcePlatform), username)
+	formattedMessage := fmt.Sprintf("%s: %s", header, content)
 	
 	return formattedMessage
 }
@@ -0,0 +1,208 @@
+package bridge
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/types"
+)
+
+// maxThreadNameLength is the longest thread name Discord accepts
+const maxThreadNameLength = 100
+
+// maxTopicNameLength is the longest forum topic name Telegram accepts
+const maxTopicNameLength = 128
+
+// discordThread returns the Discord thread a Telegram forum topic message goes to, creating it if allowed.
+// An empty ID means the message is sent to the bridged channel itself.
+func (bc *BridgeCore) discordThread(adapter *DiscordAdapter, connection *types.BridgeConnection, message *types.BridgeMessage) string {
+	if message.SourcePlatform != types.PlatformTelegram || message.TopicID == 0 || bc.db == nil {
+		return ""
+	}
+
+	bc.threadsMu.Lock()
+	defer bc.threadsMu.Unlock()
+
+	mapping, err := bc.db.GetThreadMapping(message.SourceChannelID, message.TopicID, connection.TargetChannelID)
+	if err != nil {
+		log.Printf("⚠️ Failed to look up Discord thread for Telegram topic %d: %v", message.TopicID, err)
+		return ""
+	}
+	if mapping != nil {
+		return mapping.DiscordThreadID
+	}
+	if !bc.autoCreateThreads {
+		return ""
+	}
+
+	name := message.TopicName
+	if runes := []rune(name); len(runes) > maxThreadNameLength {
+		name = string(runes[:maxThreadNameLength])
+	}
+
+	threadID, err := adapter.CreateThread(connection.TargetChannelID, name)
+	if err != nil {
+		log.Printf("❌ Failed to create Discord thread for Telegram topic %q: %v", message.TopicName, err)
+		return ""
+	}
+
+	err = bc.db.SaveThreadMapping(&models.ThreadMapping{
+		TelegramChatID:   message.SourceChannelID,
+		TopicID:          message.TopicID,
+		DiscordChannelID: connection.TargetChannelID,
+		DiscordThreadID:  threadID,
+		TopicName:        message.TopicName,
+	})
+	if err != nil {
+		log.Printf("⚠️ Failed to save Discord thread for Telegram topic %q: %v", message.TopicName, err)
+	}
+
+	log.Printf("🧵 Telegram topic %q now bridges into Discord thread %s", message.TopicName, threadID)
+	return threadID
+}
+
+// telegramTopic returns the Telegram topic a Discord forum post is bridged into, creating it if the chat is a forum.
+// Zero means the message is sent to the bridged chat itself.
+func (bc *BridgeCore) telegramTopic(adapter *TelegramAdapter, connection *types.BridgeConnection, forumChannelID, threadID, name string) int {
+	if threadID == "" || bc.db == nil {
+		return 0
+	}
+
+	bc.threadsMu.Lock()
+	defer bc.threadsMu.Unlock()
+
+	mapping, err := bc.db.GetThreadMappingByDiscordThread(threadID, connection.TargetChannelID)
+	if err != nil {
+		log.Printf("⚠️ Failed to look up Telegram topic for Discord thread %s: %v", threadID, err)
+		return 0
+	}
+	if mapping != nil {
+		return mapping.TopicID
+	}
+
+	info, err := bc.GetChatInfo(types.PlatformTelegram, connection.TargetChannelID)
+	if err != nil {
+		log.Printf("⚠️ Failed to check whether Telegram chat %s is a forum: %v", connection.TargetChannelID, err)
+		return 0
+	}
+	if !info.IsForum {
+		return 0
+	}
+
+	if runes := []rune(name); len(runes) > maxTopicNameLength {
+		name = string(runes[:maxTopicNameLength])
+	}
+
+	topicID, err := adapter.CreateForumTopic(connection.TargetChannelID, name)
+	if err != nil {
+		log.Printf("❌ Failed to create Telegram topic for Discord forum post %q: %v", name, err)
+		return 0
+	}
+
+	err = bc.db.SaveThreadMapping(&models.ThreadMapping{
+		TelegramChatID:   connection.TargetChannelID,
+		TopicID:          topicID,
+		DiscordChannelID: forumChannelID,
+		DiscordThreadID:  threadID,
+		TopicName:        name,
+	})
+	if err != nil {
+		log.Printf("⚠️ Failed to save Telegram topic for Discord forum post %q: %v", name, err)
+	}
+
+	log.Printf("🧵 Discord forum post %q now bridges into Telegram topic %d", name, topicID)
+	return topicID
+}
+
+// UpdateForumPostTags replaces the hashtag line of the Telegram copy of a Discord forum post's starter message
+func (bc *BridgeCore) UpdateForumPostTags(threadID, hashtags string) error {
+	if bc.db == nil {
+		return nil
+	}
+
+	telegramAdapter, ok := bc.platforms[types.PlatformTelegram].(*TelegramAdapter)
+	if !ok {
+		return fmt.Errorf("telegram platform not registered")
+	}
+
+	// The starter message of a forum post has the ID of its thread
+	message, mapping, err := bc.db.GetMessageMapping(types.PlatformDiscord, threadID, types.PlatformTelegram)
+	if err != nil {
+		return nil // Post was not bridged to Telegram
+	}
+
+	content := replaceHashtagLine(message.Content, hashtags)
+	if content == message.Content {
+		return nil
+	}
+	if err := bc.db.UpdateMessageContent(types.PlatformDiscord, threadID, content); err != nil {
+		return err
+	}
+
+	// Reaction edits rebuild the message from the stored content and keep the reaction summary
+	if reaction, err := bc.db.GetReaction(threadID); err == nil && reaction != nil {
+		return bc.sendReactionEdit(threadID)
+	}
+
+	formatted := telegramAdapter.FormatMessage(&types.BridgeMessage{
+		SourcePlatform:  message.SourcePlatform,
+		SourceChannelID: message.SourceRoomID,
+		SourceUserID:    message.SourceUserID,
+		Username:        bc.getDisplayName(message.SourcePlatform, message.SourceUserID),
+		Content:         content,
+	})
+	if err := telegramAdapter.EditMessage(mapping.PlatformRoomID, mapping.PlatformMsgID, formatted); err != nil {
+		return err
+	}
+
+	log.Printf("🏷️ Updated tags of Discord forum post %s on Telegram", threadID)
+	return nil
+}
+
+// replaceHashtagLine swaps the trailing hashtag line of content, separated by a blank line, for hashtags
+func replaceHashtagLine(content, hashtags string) string {
+	if i := strings.LastIndex(content, "\n\n"); i >= 0 && isHashtagLine(content[i+2:]) {
+		content = content[:i]
+	} else if isHashtagLine(content) {
+		content = ""
+	}
+
+	if hashtags == "" {
+		return content
+	}
+	if content == "" {
+		return hashtags
+	}
+	return content + "\n\n" + hashtags
+}
+
+// isHashtagLine reports whether a line consists only of hashtags
+func isHashtagLine(line string) bool {
+	words := strings.Fields(line)
+	if len(words) == 0 || strings.Contains(line, "\n") {
+		return false
+	}
+	for _, word := range words {
+		if len(word) < 2 || word[0] != '#' {
+			return false
+		}
+	}
+	return true
+}
+
+// BridgeForumPost creates a Telegram topic for a new Discord forum post in every bridged forum supergroup
+func (bc *BridgeCore) BridgeForumPost(forumChannelID, threadID, name string) {
+	for _, connection := range bc.GetBridges(forumChannelID) {
+		if !connection.IsActive || connection.TargetPlatform != types.PlatformTelegram {
+			continue
+		}
+
+		adapter, ok := bc.platforms[types.PlatformTelegram].(*TelegramAdapter)
+		if !ok || !adapter.IsConnected() {
+			continue
+		}
+		bc.telegramTopic(adapter, connection, forumChannelID, threadID, name)
+	}
+}
@@ -0,0 +1,74 @@
+package bridge
+
+import (
+	"log/slog"
+	"time"
+
+	"dcbot/internal/metrics"
+	"dcbot/internal/types"
+)
+
+// ProcessingTrace records where the time went while bridging one message
+type ProcessingTrace struct {
+	TransformerDurationMs  int64            `json:"transformer_duration_ms"`
+	DBWriteDurationMs      int64            `json:"db_write_duration_ms"`
+	PlatformSendDurationMs map[string]int64 `json:"platform_send_duration_ms"` // Target platform -> time spent sending
+
+	start time.Time
+}
+
+// newProcessingTrace starts timing a message
+func newProcessingTrace() *ProcessingTrace {
+	return &ProcessingTrace{
+		PlatformSendDurationMs: make(map[string]int64),
+		start:                  time.Now(),
+	}
+}
+
+// recordTransformers adds the time since start to the transformer stage
+func (t *ProcessingTrace) recordTransformers(start time.Time) {
+	elapsed := time.Since(start)
+	t.TransformerDurationMs += elapsed.Milliseconds()
+	metrics.ObserveTransformerDuration(elapsed)
+}
+
+// recordDBWrite adds the time since start to the database write stage
+func (t *ProcessingTrace) recordDBWrite(start time.Time) {
+	elapsed := time.Since(start)
+	t.DBWriteDurationMs += elapsed.Milliseconds()
+	metrics.ObserveDBWriteDuration(elapsed)
+}
+
+// recordSend adds the time since start to the sends to a target platform
+func (t *ProcessingTrace) recordSend(platform string, start time.Time) {
+	elapsed := time.Since(start)
+	t.PlatformSendDurationMs[platform] += elapsed.Milliseconds()
+	metrics.ObservePlatformSendDuration(platform, elapsed)
+}
+
+// SetTimingLogger sets the logger told about each message's processing time at debug level.
+// With detailed set, the time spent in each stage is logged as well.
+func (bc *BridgeCore) SetTimingLogger(logger *slog.Logger, detailed bool) {
+	bc.timingLogger = logger
+	bc.detailedTimingLogs = detailed
+}
+
+// logProcessingTrace logs the timings of a processed message
+func (bc *BridgeCore) logProcessingTrace(message *types.BridgeMessage, trace *ProcessingTrace) {
+	if bc.timingLogger == nil {
+		return
+	}
+
+	attrs := []any{
+		"source_platform", message.SourcePlatform,
+		"source_channel", message.SourceChannelID,
+		"total_ms", time.Since(trace.start).Milliseconds(),
+	}
+	if bc.detailedTimingLogs {
+		attrs = append(attrs, "transformer_ms", trace.TransformerDurationMs, "db_write_ms", trace.DBWriteDurationMs)
+		for platform, duration := range trace.PlatformSendDurationMs {
+			attrs = append(attrs, "send_"+platform+"_ms", duration)
+		}
+	}
+	bc.timingLogger.Debug("⏱️ Message processed", attrs...)
+}
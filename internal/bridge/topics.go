@@ -0,0 +1,139 @@
+package bridge
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"dcbot/internal/types"
+)
+
+// topicSyncInterval is how often Telegram group descriptions are checked for changes, since Telegram sends no update for them
+const topicSyncInterval = 5 * time.Minute
+
+// topicSetter is implemented by platforms that can change a channel's topic or a group's description
+type topicSetter interface {
+	SetChannelTopic(channelID, topic string) error
+}
+
+// SyncChannelTopic copies a changed Discord channel topic or Telegram group description to the other side of bridges with topic sync
+func (bc *BridgeCore) SyncChannelTopic(platform, channelID, topic string) error {
+	// Setting a topic makes the platform report the change back, which must not be synced again
+	if bc.consumeSyncedTopic(platform, channelID, topic) {
+		return nil
+	}
+
+	config, err := bc.getBridgeConfig(platform, channelID)
+	if err != nil || !config.SyncTopics {
+		return nil
+	}
+
+	synced := 0
+	for _, connection := range bc.channelConnections(channelID) {
+		if !connection.IsActive {
+			continue
+		}
+		setter, ok := bc.platforms[connection.TargetPlatform].(topicSetter)
+		if !ok {
+			continue
+		}
+
+		bc.rememberSyncedTopic(connection.TargetPlatform, connection.TargetChannelID, topic)
+		if err := setter.SetChannelTopic(connection.TargetChannelID, topic); err != nil {
+			bc.consumeSyncedTopic(connection.TargetPlatform, connection.TargetChannelID, topic)
+			log.Printf("❌ Failed to sync topic to %s channel %s: %v", connection.TargetPlatform, connection.TargetChannelID, err)
+			continue
+		}
+		synced++
+
+		notice := fmt.Sprintf("📝 Topic updated from %s: %s", strings.Title(platform), topic)
+		if err := bc.SendSystemMessage(connection.TargetPlatform, connection.TargetChannelID, notice); err != nil {
+			log.Printf("⚠️ Failed to announce topic change in %s channel %s: %v", connection.TargetPlatform, connection.TargetChannelID, err)
+		}
+	}
+
+	if synced == 0 {
+		return nil
+	}
+
+	log.Printf("📝 Synced topic of %s channel %s to %d bridged channels", platform, channelID, synced)
+	notice := fmt.Sprintf("📝 Topic synced to %d bridged channel(s)", synced)
+	return bc.SendSystemMessage(platform, channelID, notice)
+}
+
+// rememberSyncedTopic records a topic set by the bridge, so the change it causes is recognized
+func (bc *BridgeCore) rememberSyncedTopic(platform, channelID, topic string) {
+	bc.syncedTopicsMu.Lock()
+	defer bc.syncedTopicsMu.Unlock()
+	bc.syncedTopics[platform+":"+channelID] = topic
+}
+
+// consumeSyncedTopic reports whether the bridge itself set a channel to this topic, forgetting it
+func (bc *BridgeCore) consumeSyncedTopic(platform, channelID, topic string) bool {
+	bc.syncedTopicsMu.Lock()
+	defer bc.syncedTopicsMu.Unlock()
+
+	key := platform + ":" + channelID
+	if synced, ok := bc.syncedTopics[key]; !ok || synced != topic {
+		return false
+	}
+	delete(bc.syncedTopics, key)
+	return true
+}
+
+// StartTopicSync checks bridged Telegram groups for description changes until the bridge core is stopped
+func (bc *BridgeCore) StartTopicSync() {
+	go func() {
+		ticker := time.NewTicker(topicSyncInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				bc.checkTelegramDescriptions()
+			case <-bc.stopChan:
+				return
+			}
+		}
+	}()
+}
+
+// checkTelegramDescriptions syncs the description of every Telegram group with topic sync that changed since the last check
+func (bc *BridgeCore) checkTelegramDescriptions() {
+	provider, ok := bc.platforms[types.PlatformTelegram].(chatInfoProvider)
+	if !ok || !bc.platforms[types.PlatformTelegram].IsConnected() {
+		return
+	}
+
+	for channelID, connections := range bc.allConnections() {
+		if len(connections) == 0 || connections[0].SourcePlatform != types.PlatformTelegram {
+			continue
+		}
+		config, err := bc.getBridgeConfig(types.PlatformTelegram, channelID)
+		if err != nil || !config.SyncTopics {
+			continue
+		}
+
+		info, err := provider.ChatInfo(channelID)
+		if err != nil {
+			log.Printf("⚠️ Failed to check description of Telegram chat %s: %v", channelID, err)
+			continue
+		}
+
+		bc.syncedTopicsMu.Lock()
+		previous, known := bc.telegramDescriptions[channelID]
+		bc.telegramDescriptions[channelID] = info.Description
+		bc.syncedTopicsMu.Unlock()
+
+		// The first check only learns the current description
+		if !known || previous == info.Description {
+			continue
+		}
+
+		log.Printf("📝 Telegram chat %s description changed", channelID)
+		if err := bc.SyncChannelTopic(types.PlatformTelegram, channelID, info.Description); err != nil {
+			log.Printf("❌ Failed to sync description of Telegram chat %s: %v", channelID, err)
+		}
+	}
+}
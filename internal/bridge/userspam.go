@@ -0,0 +1,75 @@
+package bridge
+
+import (
+	"log"
+	"time"
+
+	"dcbot/internal/metrics"
+	"dcbot/internal/types"
+)
+
+// truncatedSuffix marks a message shortened by the per-user length limit
+const truncatedSuffix = " [message truncated by bridge]"
+
+// maxTrackedUsers is how many users a channel remembers before users outside the spam window are forgotten
+const maxTrackedUsers = 1000
+
+// userMessage is the length and time of a user's previous message in a channel
+type userMessage struct {
+	length int
+	sentAt time.Time
+}
+
+// truncateUserSpam shortens a message over the bridge's per-user length limit when its sender's
+// previous message was sent within the bridge's spam window
+func (bc *BridgeCore) truncateUserSpam(message *types.BridgeMessage, connections []*types.BridgeConnection) {
+	if bc.db == nil || message.SourceUserID == "" {
+		return
+	}
+
+	config, err := bc.getBridgeConfig(message.SourcePlatform, message.SourceChannelID)
+	if err != nil || config.MaxMessageLengthPerUser <= 0 {
+		return
+	}
+	window := time.Duration(config.UserSpamWindowSeconds) * time.Second
+
+	now := time.Now()
+	length := len([]rune(message.Content))
+	previous, seen := bc.recordUserMessage(message, userMessage{length: length, sentAt: now}, window)
+	if length <= config.MaxMessageLengthPerUser || !seen || now.Sub(previous.sentAt) > window {
+		return
+	}
+
+	message.Content = string([]rune(message.Content)[:config.MaxMessageLengthPerUser]) + truncatedSuffix
+	for _, connection := range connections {
+		metrics.IncUserMessagesTruncated(message.SourcePlatform, connection.ID)
+	}
+	log.Printf("✂️ Truncated %d character message from %s user %s in channel %s (previous message %s ago)",
+		length, message.SourcePlatform, message.SourceUserID, message.SourceChannelID, now.Sub(previous.sentAt).Round(time.Second))
+}
+
+// recordUserMessage stores a user's latest message in a channel and returns their previous one, if any
+func (bc *BridgeCore) recordUserMessage(message *types.BridgeMessage, latest userMessage, window time.Duration) (userMessage, bool) {
+	bc.lastUserMessagesMu.Lock()
+	defer bc.lastUserMessagesMu.Unlock()
+
+	users := bc.lastUserMessages[message.SourceChannelID]
+	if users == nil {
+		users = make(map[string]userMessage)
+		bc.lastUserMessages[message.SourceChannelID] = users
+	}
+
+	// Keep busy channels from growing without bound
+	if len(users) >= maxTrackedUsers {
+		for key, entry := range users {
+			if latest.sentAt.Sub(entry.sentAt) > window {
+				delete(users, key)
+			}
+		}
+	}
+
+	key := message.SourcePlatform + ":" + message.SourceUserID
+	previous, seen := users[key]
+	users[key] = latest
+	return previous, seen
+}
@@ -0,0 +1,77 @@
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"dcbot/internal/platforms/incomingwebhook"
+	"dcbot/internal/types"
+)
+
+// WebhookAdapter implements the Platform interface for a Discord incoming webhook
+type WebhookAdapter struct {
+	client       *incomingwebhook.Client
+	bridgeConfig bridgeConfigResolver // Optional, provides the sender header templates of bridges
+}
+
+// NewWebhookAdapter creates a new incoming webhook adapter
+func NewWebhookAdapter(client *incomingwebhook.Client) *WebhookAdapter {
+	return &WebhookAdapter{
+		client: client,
+	}
+}
+
+// GetName returns the platform name
+func (wa *WebhookAdapter) GetName() string {
+	return types.PlatformDiscordWebhook
+}
+
+// IsConnected returns whether a webhook URL is configured
+func (wa *WebhookAdapter) IsConnected() bool {
+	return wa.client.IsConfigured()
+}
+
+// Ping checks that the webhook still exists
+func (wa *WebhookAdapter) Ping(ctx context.Context) error {
+	return wa.client.Ping(ctx)
+}
+
+// TestCredentials checks that the webhook URL, which is its credential, still exists
+func (wa *WebhookAdapter) TestCredentials(ctx context.Context) (string, time.Duration, error) {
+	start := time.Now()
+	err := wa.client.Ping(ctx)
+	return "webhook exists", time.Since(start), err
+}
+
+// SendMessage posts a message to the webhook
+func (wa *WebhookAdapter) SendMessage(ctx context.Context, channelID, content string) error {
+	return wa.client.SendMessage(ctx, channelID, content)
+}
+
+// SendBridgeMessage posts a bridge message under the sender's name
+func (wa *WebhookAdapter) SendBridgeMessage(ctx context.Context, channelID string, message *types.BridgeMessage) error {
+	username := strings.TrimPrefix(message.Username, "@")
+	if username == "" {
+		username = "Anonymous"
+	}
+	username = formatHeader(wa.bridgeConfig, message, strings.ToUpper(message.SourcePlatform), username)
+
+	return wa.client.SendWebhookMessage(ctx, channelID, message.Content, username, "")
+}
+
+// FormatMessage formats a bridge message for the webhook (fallback method)
+func (wa *WebhookAdapter) FormatMessage(message *types.BridgeMessage) string {
+	username := message.Username
+	if username == "" {
+		username = "Anonymous"
+	}
+	header := formatHeader(wa.bridgeConfig, message, strings.ToUpper(message.SourcePlatform), "**"+username+"**")
+	return fmt.Sprintf("%s: %s", header, message.Content)
+}
+
+// SetBridgeConfigResolver sets the function looking up the bridge configuration holding sender header templates
+func (wa *WebhookAdapter) SetBridgeConfigResolver(resolver bridgeConfigResolver) {
+	wa.bridgeConfig = resolver
+}
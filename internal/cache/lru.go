@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// LRU is a size-bounded least-recently-used cache whose entries expire after a TTL
+type LRU[K comparable, V any] struct {
+	mu    sync.Mutex
+	size  int
+	ttl   time.Duration // Zero means entries never expire
+	items map[K]*list.Element
+	order *list.List // Front is most recently used
+}
+
+// entry is a single cached value
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+// NewLRU creates a new LRU cache holding at most size entries
+func NewLRU[K comparable, V any](size int, ttl time.Duration) *LRU[K, V] {
+	if size <= 0 {
+		size = 1
+	}
+	return &LRU[K, V]{
+		size:  size,
+		ttl:   ttl,
+		items: make(map[K]*list.Element),
+		order: list.New(),
+	}
+}
+
+// Get returns a cached value if present and not expired
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+	element, exists := c.items[key]
+	if !exists {
+		return zero, false
+	}
+
+	item := element.Value.(*entry[K, V])
+	if c.ttl > 0 && time.Now().After(item.expiresAt) {
+		c.removeElement(element)
+		return zero, false
+	}
+
+	c.order.MoveToFront(element)
+	return item.value, true
+}
+
+// Add stores a value, evicting the least recently used entry if the cache is full
+func (c *LRU[K, V]) Add(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(c.ttl)
+
+	if element, exists := c.items[key]; exists {
+		item := element.Value.(*entry[K, V])
+		item.value = value
+		item.expiresAt = expiresAt
+		c.order.MoveToFront(element)
+		return
+	}
+
+	element := c.order.PushFront(&entry[K, V]{key: key, value: value, expiresAt: expiresAt})
+	c.items[key] = element
+
+	if c.order.Len() > c.size {
+		c.removeElement(c.order.Back())
+	}
+}
+
+// Remove deletes a value from the cache
+func (c *LRU[K, V]) Remove(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, exists := c.items[key]; exists {
+		c.removeElement(element)
+	}
+}
+
+// Len returns the number of cached entries, including expired ones not yet evicted
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// removeElement removes an element from both the list and the index
+func (c *LRU[K, V]) removeElement(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.items, element.Value.(*entry[K, V]).key)
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 )
 
 type Config struct {
@@ -11,53 +12,231 @@ type Config struct {
 	EnableDiscord  bool
 
 	// Telegram configuration
-	TelegramBotToken string
-	TelegramChatID   string
+	TelegramBotToken     string
+	TelegramChatID       string  // Numeric chat ID, or the @username of a public broadcast channel
+	TelegramAdminUserIDs []int64 // Telegram users allowed to run admin commands
 
 	// Discord configuration
 	DiscordBotToken string
-	DiscordGuildID  string
 	DiscordChannelID string
+	DiscordAdminUserIDs []string // Discord users allowed to run admin commands
+	DiscordGatewayIntents uint   // Gateway intents bitmask, 0 uses the bot's default intents
+
+	MaxWebhooksPerChannel int // Webhooks a busy channel's messages are spread over
+
+	// Discord incoming webhook configuration, for servers the bot cannot join
+	DiscordIncomingWebhookURL string
 
 	// Database configuration
 	DatabasePath string
 
 	// Logging configuration
-	LogLevel string
-	LogFile  string
+	LogLevel          string
+	LogFile           string
+	PlatformLogLevels map[string]string // platform -> level, falls back to LogLevel
+
+	EnableDetailedTimingLogs bool // Log each pipeline stage's duration in the debug message timing line
 
 	// API configuration
 	APIPort   int
 	APIEnable bool
+	APIToken  string // Bearer token for protected endpoints such as the event stream
+
+	APIRateLimitRPM   int // Requests per minute allowed from each IP address
+	APIRateLimitBurst int // Requests an IP address may make at once before being limited
+
+	// Health check configuration
+	PingIntervalSeconds     int
+	WatchdogIntervalSeconds int // How often the watchdog checks platform clients, 0 to disable
+
+	// Downtime notification configuration
+	DowntimeNotifyDelaySeconds int    // How long Discord must be down before Telegram chats are notified
+	DowntimeMessage            string // Sent to Telegram chats when Discord is down
+	DowntimeRestoredMessage    string // Sent to Telegram chats when Discord is back
+
+	// Bridge health check configuration
+	BridgeTestTime      string // Daily "HH:MM" time to probe every bridge, empty to disable
+	FailOnSelfTestError bool   // Exit at startup if a bridge target channel is unreachable
+
+	// Delivery configuration
+	MessageDeliveryTimeoutSeconds int  // How long a single send to a target platform may take, 0 to disable
+	AutoCreateDiscordThreads      bool // Create a Discord thread for each Telegram forum topic
+
+	// Retry configuration, bridges may override the retry count and backoff multiplier
+	MaxRetries           int // Retries of a failed delivery before it is dropped, 0 disables retries
+	RetryBackoffMs       int // Delay before the first retry
+	MaxRetryDelaySeconds int // Upper bound of the exponentially growing retry delay
+
+	DeduplicationCacheSize int // Recently bridged messages remembered to skip duplicate deliveries
+
+	// Media configuration
+	MaxMediaSizeBytes   int64 // Larger files are not uploaded to the target platform
+	StreamMediaTransfer bool  // Pipe Telegram videos straight into the Discord upload
+
+	// Database cleanup configuration, 0 disables pruning
+	MaxMessagesPerBridge        int // Newest messages kept per source channel
+	MaxMessageMappingsPerBridge int // Newest message mappings kept per target channel
+	WALCheckpointThreshold      int // Uncheckpointed WAL pages that trigger a truncating checkpoint
+
+	// Bridge templates, applied with /bridge create template:<name>
+	BridgeTemplatesPath string
+
+	// URL shortener configuration
+	URLShortenerEnable  bool
+	URLShortenerAPI     string // "is.gd", "tinyurl.com" or a custom endpoint URL
+	URLShortenMinLength int
+
+	// Tracing configuration, spans are exported over OTLP/HTTP
+	OTLPEnabled  bool
+	OTLPEndpoint string // Collector URL, "/v1/traces" is appended when it has no path
 }
 
 func Load() *Config {
 	apiPort, _ := strconv.Atoi(getEnv("API_PORT", "8080"))
 	apiEnable, _ := strconv.ParseBool(getEnv("API_ENABLE", "false"))
+	apiRateLimitRPM, _ := strconv.Atoi(getEnv("API_RATE_LIMIT_RPM", "60"))
+	apiRateLimitBurst, _ := strconv.Atoi(getEnv("API_RATE_LIMIT_BURST", "10"))
 	
 	// Platform enable/disable flags
 	enableTelegram, _ := strconv.ParseBool(getEnv("ENABLE_TELEGRAM", "true"))
 	enableDiscord, _ := strconv.ParseBool(getEnv("ENABLE_DISCORD", "true"))
+	discordGatewayIntents, _ := strconv.ParseUint(getEnv("DISCORD_GATEWAY_INTENTS", "0"), 10, 32)
+	maxWebhooksPerChannel, _ := strconv.Atoi(getEnv("MAX_WEBHOOKS_PER_CHANNEL", "3"))
+
+	pingInterval, _ := strconv.Atoi(getEnv("PING_INTERVAL_SECONDS", "30"))
+	watchdogInterval, _ := strconv.Atoi(getEnv("WATCHDOG_INTERVAL_SECONDS", "30"))
+
+	downtimeNotifyDelay, _ := strconv.Atoi(getEnv("DOWNTIME_NOTIFY_DELAY_SECONDS", "30"))
+
+	failOnSelfTestError, _ := strconv.ParseBool(getEnv("FAIL_ON_SELF_TEST_ERROR", "false"))
+
+	messageDeliveryTimeout, _ := strconv.Atoi(getEnv("MESSAGE_DELIVERY_TIMEOUT_SECONDS", "30"))
+	autoCreateDiscordThreads, _ := strconv.ParseBool(getEnv("AUTO_CREATE_DISCORD_THREADS", "true"))
+
+	maxRetries, _ := strconv.Atoi(getEnv("MAX_RETRIES", "5"))
+	retryBackoffMs, _ := strconv.Atoi(getEnv("RETRY_BACKOFF_MS", "1000"))
+	maxRetryDelay, _ := strconv.Atoi(getEnv("MAX_RETRY_DELAY_SECONDS", "300"))
+
+	deduplicationCacheSize, _ := strconv.Atoi(getEnv("DEDUPLICATION_CACHE_SIZE", "10000"))
+
+	maxMediaSize, _ := strconv.ParseInt(getEnv("MAX_MEDIA_SIZE_BYTES", "8388608"), 10, 64)
+	streamMediaTransfer, _ := strconv.ParseBool(getEnv("STREAM_MEDIA_TRANSFER", "true"))
+
+	maxMessagesPerBridge, _ := strconv.Atoi(getEnv("MAX_MESSAGES_PER_BRIDGE", "100000"))
+	maxMessageMappingsPerBridge, _ := strconv.Atoi(getEnv("MAX_MESSAGE_MAPPINGS_PER_BRIDGE", "500000"))
+	walCheckpointThreshold, _ := strconv.Atoi(getEnv("WAL_CHECKPOINT_THRESHOLD", "1000"))
+
+	urlShortenerEnable, _ := strconv.ParseBool(getEnv("URL_SHORTENER_ENABLE", "false"))
+	urlShortenMinLength, _ := strconv.Atoi(getEnv("URL_SHORTEN_MIN_LENGTH", "80"))
+
+	otlpEnabled, _ := strconv.ParseBool(getEnv("OTLP_ENABLED", "false"))
+
+	enableDetailedTimingLogs, _ := strconv.ParseBool(getEnv("ENABLE_DETAILED_TIMING_LOGS", "false"))
 
 	return &Config{
 		EnableTelegram: enableTelegram,
 		EnableDiscord:  enableDiscord,
 
-		TelegramBotToken: getEnv("TELEGRAM_BOT_TOKEN", ""),
-		TelegramChatID:   getEnv("TELEGRAM_CHAT_ID", ""),
+		TelegramBotToken:     getEnv("TELEGRAM_BOT_TOKEN", ""),
+		TelegramChatID:       getEnv("TELEGRAM_CHAT_ID", ""),
+		TelegramAdminUserIDs: parseUserIDs(getEnv("TELEGRAM_ADMIN_USER_IDS", "")),
 
 		DiscordBotToken:  getEnv("DISCORD_BOT_TOKEN", ""),
-		DiscordGuildID:   getEnv("DISCORD_GUILD_ID", ""),
 		DiscordChannelID: getEnv("DISCORD_CHANNEL_ID", ""),
+		DiscordAdminUserIDs: parseIDList(getEnv("DISCORD_ADMIN_USER_IDS", "1359619658214412298")),
+		DiscordGatewayIntents: uint(discordGatewayIntents),
+
+		MaxWebhooksPerChannel: maxWebhooksPerChannel,
+
+		DiscordIncomingWebhookURL: getEnv("DISCORD_INCOMING_WEBHOOK_URL", ""),
 
 		DatabasePath: getEnv("DATABASE_PATH", "./bridge.db"),
 
-		LogLevel: getEnv("LOG_LEVEL", "info"),
-		LogFile:  getEnv("LOG_FILE", "./logs/bridge.log"),
+		LogLevel:          getEnv("LOG_LEVEL", "info"),
+		LogFile:           getEnv("LOG_FILE", "./logs/bridge.log"),
+		PlatformLogLevels: parsePlatformLogLevels(getEnv("PLATFORM_LOG_LEVELS", "")),
+
+		EnableDetailedTimingLogs: enableDetailedTimingLogs,
 
 		APIPort:   apiPort,
 		APIEnable: apiEnable,
+		APIToken:  getEnv("API_TOKEN", ""),
+
+		APIRateLimitRPM:   apiRateLimitRPM,
+		APIRateLimitBurst: apiRateLimitBurst,
+
+		PingIntervalSeconds:     pingInterval,
+		WatchdogIntervalSeconds: watchdogInterval,
+
+		DowntimeNotifyDelaySeconds: downtimeNotifyDelay,
+		DowntimeMessage:            getEnv("DOWNTIME_MESSAGE", "⚠️ Bridge is temporarily unavailable (Discord connection lost). Messages may be delayed."),
+		DowntimeRestoredMessage:    getEnv("DOWNTIME_RESTORED_MESSAGE", "✅ Bridge restored."),
+
+		BridgeTestTime:      getEnv("BRIDGE_TEST_TIME", ""),
+		FailOnSelfTestError: failOnSelfTestError,
+
+		MessageDeliveryTimeoutSeconds: messageDeliveryTimeout,
+		AutoCreateDiscordThreads:      autoCreateDiscordThreads,
+
+		MaxRetries:           maxRetries,
+		RetryBackoffMs:       retryBackoffMs,
+		MaxRetryDelaySeconds: maxRetryDelay,
+
+		DeduplicationCacheSize: deduplicationCacheSize,
+
+		MaxMediaSizeBytes:   maxMediaSize,
+		StreamMediaTransfer: streamMediaTransfer,
+
+		MaxMessagesPerBridge:        maxMessagesPerBridge,
+		MaxMessageMappingsPerBridge: maxMessageMappingsPerBridge,
+		WALCheckpointThreshold:      walCheckpointThreshold,
+
+		BridgeTemplatesPath: getEnv("BRIDGE_TEMPLATES_PATH", "./templates.yaml"),
+
+		URLShortenerEnable:  urlShortenerEnable,
+		URLShortenerAPI:     getEnv("URL_SHORTENER_API", "is.gd"),
+		URLShortenMinLength: urlShortenMinLength,
+
+		OTLPEnabled:  otlpEnabled,
+		OTLPEndpoint: getEnv("OTLP_ENDPOINT", "http://localhost:4318"),
+	}
+}
+
+// parsePlatformLogLevels parses "telegram:debug,discord:info" into a platform -> level map
+func parsePlatformLogLevels(value string) map[string]string {
+	levels := make(map[string]string)
+	for _, entry := range strings.Split(value, ",") {
+		platform, level, ok := strings.Cut(entry, ":")
+		if !ok {
+			continue
+		}
+		levels[strings.TrimSpace(platform)] = strings.TrimSpace(level)
+	}
+	return levels
+}
+
+// parseUserIDs parses a comma-separated list of numeric user IDs, skipping invalid entries
+func parseUserIDs(value string) []int64 {
+	var ids []int64
+	for _, entry := range strings.Split(value, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(entry), 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// parseIDList parses a comma-separated list of IDs, skipping empty entries
+func parseIDList(value string) []string {
+	var ids []string
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			ids = append(ids, entry)
+		}
 	}
+	return ids
 }
 
 func getEnv(key, defaultValue string) string {
@@ -0,0 +1,127 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"dcbot/internal/database/models"
+	"gopkg.in/yaml.v3"
+)
+
+// templateProtectedFields are BridgeConfig fields a template may not set; names must stay unique per server
+var templateProtectedFields = map[string]bool{
+	"id": true, "room_id": true, "name": true, "created_at": true, "updated_at": true,
+}
+
+// BridgeTemplate is a named set of bridge config values applied when a bridge is created
+type BridgeTemplate struct {
+	Name        string
+	Description string
+	Config      map[string]interface{} // BridgeConfig JSON field -> value
+}
+
+// templateFile is the YAML representation of a template in the templates file
+type templateFile struct {
+	Description string                 `yaml:"description"`
+	Config      map[string]interface{} `yaml:"config"`
+}
+
+// TemplateLibrary holds the bridge templates read from the templates file
+type TemplateLibrary struct {
+	templates map[string]*BridgeTemplate
+}
+
+// LoadTemplates reads bridge templates from a YAML file; a missing file gives an empty library.
+//
+// Each top-level key is a template name holding a description and a config map:
+//
+//	strict-moderated:
+//	  description: Text only, no edits or deletes
+//	  config:
+//	    allowed_message_types: [text]
+func LoadTemplates(path string) (*TemplateLibrary, error) {
+	library := &TemplateLibrary{templates: make(map[string]*BridgeTemplate)}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return library, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bridge templates: %v", err)
+	}
+
+	var document map[string]templateFile
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&document); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid bridge templates file %s: %v", path, err)
+	}
+
+	for name, file := range document {
+		template := &BridgeTemplate{Name: name, Description: file.Description, Config: file.Config}
+		if template.Config == nil {
+			template.Config = make(map[string]interface{})
+		}
+
+		// Applying to a blank config catches unknown fields and wrong value types at startup
+		if err := template.Apply(&models.BridgeConfig{}); err != nil {
+			return nil, err
+		}
+		library.templates[name] = template
+	}
+
+	return library, nil
+}
+
+// Get returns the template with a name
+func (l *TemplateLibrary) Get(name string) (*BridgeTemplate, bool) {
+	template, ok := l.templates[name]
+	return template, ok
+}
+
+// List returns every template, sorted by name
+func (l *TemplateLibrary) List() []*BridgeTemplate {
+	templates := make([]*BridgeTemplate, 0, len(l.templates))
+	for _, template := range l.templates {
+		templates = append(templates, template)
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+	return templates
+}
+
+// Apply overrides the fields of a bridge config set by the template
+func (t *BridgeTemplate) Apply(config *models.BridgeConfig) error {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("failed to encode bridge config: %v", err)
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return fmt.Errorf("failed to decode bridge config: %v", err)
+	}
+
+	for key, value := range t.Config {
+		if _, known := fields[key]; !known || templateProtectedFields[key] {
+			return fmt.Errorf("template %q sets unknown bridge config field %q", t.Name, key)
+		}
+		fields[key] = value
+	}
+
+	if data, err = json.Marshal(fields); err != nil {
+		return fmt.Errorf("failed to encode template %q: %v", t.Name, err)
+	}
+
+	// Decode into a copy so a bad value leaves the config untouched
+	updated := *config
+	if err := json.Unmarshal(data, &updated); err != nil {
+		return fmt.Errorf("template %q has an invalid value: %v", t.Name, err)
+	}
+	*config = updated
+	return nil
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"dcbot/internal/database/models"
+)
+
+// writeTemplates writes a templates file to a temporary directory and returns its path
+func writeTemplates(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "templates.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write templates: %v", err)
+	}
+	return path
+}
+
+func TestLoadTemplatesExample(t *testing.T) {
+	library, err := LoadTemplates(filepath.Join("..", "..", "docker", "templates.example.yaml"))
+	if err != nil {
+		t.Fatalf("LoadTemplates: %v", err)
+	}
+
+	var names []string
+	for _, template := range library.List() {
+		names = append(names, template.Name)
+	}
+	if got := strings.Join(names, ","); got != "announcements,strict-moderated" {
+		t.Fatalf("templates = %s, want announcements,strict-moderated", got)
+	}
+
+	strict, _ := library.Get("strict-moderated")
+	if strict.Description != "Text only, without edits, deletes or reactions" {
+		t.Errorf("description = %q", strict.Description)
+	}
+
+	config := &models.BridgeConfig{AllowEdits: true, AllowDeletes: true, MaxMessageLength: 4000, SilentMode: true}
+	if err := strict.Apply(config); err != nil {
+		t.Fatalf("Apply strict-moderated: %v", err)
+	}
+	if config.AllowEdits || config.AllowDeletes || config.MaxMessageLength != 500 {
+		t.Errorf("Apply strict-moderated = %+v, want edits and deletes off and 500 characters", config)
+	}
+	if len(config.AllowedMessageTypes) != 1 || config.AllowedMessageTypes[0] != "text" {
+		t.Errorf("allowed message types = %v, want [text]", config.AllowedMessageTypes)
+	}
+	if !config.SilentMode {
+		t.Error("Apply changed a field the template does not set")
+	}
+
+	announcements, _ := library.Get("announcements")
+	config = &models.BridgeConfig{}
+	if err := announcements.Apply(config); err != nil {
+		t.Fatalf("Apply announcements: %v", err)
+	}
+	if !config.SilentMode || config.EmbedColor != 0xFFA500 {
+		t.Errorf("Apply announcements = silent %t, color %#x, want silent and 0xffa500", config.SilentMode, config.EmbedColor)
+	}
+}
+
+func TestLoadTemplatesMissingOrEmpty(t *testing.T) {
+	for name, path := range map[string]string{
+		"missing": filepath.Join(t.TempDir(), "missing.yaml"),
+		"empty":   writeTemplates(t, "# no templates yet\n"),
+	} {
+		library, err := LoadTemplates(path)
+		if err != nil {
+			t.Errorf("%s: LoadTemplates: %v", name, err)
+			continue
+		}
+		if len(library.List()) != 0 {
+			t.Errorf("%s: got %d templates, want none", name, len(library.List()))
+		}
+	}
+}
+
+func TestLoadTemplatesInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"unknown config field", "quiet:\n  config:\n    volume: 0\n", `unknown bridge config field "volume"`},
+		{"protected config field", "renamed:\n  config:\n    name: general\n", `unknown bridge config field "name"`},
+		{"wrong value type", "long:\n  config:\n    max_message_length: lots\n", "invalid value"},
+		{"unknown template key", "typo:\n  confg:\n    silent_mode: true\n", "field confg not found"},
+		{"template not a map", "broken: true\n", "cannot unmarshal"},
+		{"duplicate template", "quiet: {}\nquiet: {}\n", "already defined"},
+	}
+
+	for _, tt := range tests {
+		_, err := LoadTemplates(writeTemplates(t, tt.content))
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: LoadTemplates error = %v, want it to contain %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/metrics"
+)
+
+// CreateAccountLink stores a pending request to link a Telegram account to a Discord account
+func (d *Database) CreateAccountLink(link *models.PendingAccountLink) error {
+	if link.CreatedAt.IsZero() {
+		link.CreatedAt = time.Now()
+	}
+
+	_, err := d.db.Exec(`
+		INSERT INTO pending_account_links (code, platform_user_id, username, created_at, expires_at)
+		VALUES (?, ?, ?, ?, ?)`,
+		link.Code, link.PlatformUserID, link.Username, link.CreatedAt.UTC(), link.ExpiresAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to create account link: %v", err)
+	}
+	return nil
+}
+
+// RedeemAccountLink returns and deletes an unexpired link request, or nil if there is none with that code
+func (d *Database) RedeemAccountLink(code string) (*models.PendingAccountLink, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var link models.PendingAccountLink
+	err = tx.QueryRow(`
+		SELECT code, platform_user_id, username, created_at, expires_at
+		FROM pending_account_links
+		WHERE code = ? AND expires_at > ?`, code, time.Now().UTC()).
+		Scan(&link.Code, &link.PlatformUserID, &link.Username, &link.CreatedAt, &link.ExpiresAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account link: %v", err)
+	}
+
+	if _, err := tx.Exec("DELETE FROM pending_account_links WHERE code = ?", code); err != nil {
+		return nil, fmt.Errorf("failed to delete account link: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return &link, nil
+}
+
+// PruneAccountLinks deletes link requests that expired before the given time
+func (d *Database) PruneAccountLinks(before time.Time) (int64, error) {
+	result, err := d.db.Exec("DELETE FROM pending_account_links WHERE expires_at < ?", before.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune account links: %v", err)
+	}
+
+	deleted, _ := result.RowsAffected()
+	metrics.AddDBPrunedRows("pending_account_links", deleted)
+	return deleted, nil
+}
+
+// LinkUserAccounts stores two platform accounts as the same user, replacing any earlier link of either account
+func (d *Database) LinkUserAccounts(account, linked *models.UserMapping) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec("INSERT INTO users DEFAULT VALUES")
+	if err != nil {
+		return fmt.Errorf("failed to create user: %v", err)
+	}
+	userID, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get user ID: %v", err)
+	}
+
+	for _, account := range []*models.UserMapping{account, linked} {
+		_, err := tx.Exec(`
+			INSERT INTO user_mappings (user_id, platform, platform_user_id, username, display_name, is_active)
+			VALUES (?, ?, ?, ?, ?, 1)
+			ON CONFLICT(platform, platform_user_id) DO UPDATE SET
+				user_id = excluded.user_id, username = excluded.username, display_name = excluded.display_name,
+				is_active = 1, updated_at = CURRENT_TIMESTAMP`,
+			userID, account.Platform, account.PlatformUserID, account.Username, account.DisplayName)
+		if err != nil {
+			return fmt.Errorf("failed to link %s user %s: %v", account.Platform, account.PlatformUserID, err)
+		}
+		account.UserID = int(userID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"dcbot/internal/database/models"
+)
+
+func TestRedeemAccountLink(t *testing.T) {
+	db := newTestDatabase(t)
+	now := time.Now()
+	for _, link := range []*models.PendingAccountLink{
+		{Code: "VALID234", PlatformUserID: "42", Username: "alice", ExpiresAt: now.Add(time.Hour)},
+		{Code: "EXPIRED2", PlatformUserID: "43", Username: "bob", ExpiresAt: now.Add(-time.Minute)},
+	} {
+		if err := db.CreateAccountLink(link); err != nil {
+			t.Fatalf("CreateAccountLink(%s): %v", link.Code, err)
+		}
+	}
+
+	link, err := db.RedeemAccountLink("VALID234")
+	if err != nil {
+		t.Fatalf("RedeemAccountLink: %v", err)
+	}
+	if link == nil || link.PlatformUserID != "42" || link.Username != "alice" {
+		t.Fatalf("RedeemAccountLink = %+v, want the link of Telegram user 42", link)
+	}
+
+	for _, code := range []string{"VALID234", "EXPIRED2", "UNKNOWN2"} {
+		if link, err := db.RedeemAccountLink(code); err != nil || link != nil {
+			t.Errorf("RedeemAccountLink(%s) = %+v, %v, want nil", code, link, err)
+		}
+	}
+}
+
+func TestLinkUserAccounts(t *testing.T) {
+	db := newTestDatabase(t)
+	link := func(discordID, telegramID string) {
+		t.Helper()
+		err := db.LinkUserAccounts(
+			&models.UserMapping{Platform: "discord", PlatformUserID: discordID},
+			&models.UserMapping{Platform: "telegram", PlatformUserID: telegramID},
+		)
+		if err != nil {
+			t.Fatalf("LinkUserAccounts(%s, %s): %v", discordID, telegramID, err)
+		}
+	}
+	linked := func(platform, userID, linkedPlatform string) string {
+		t.Helper()
+		mapping, err := db.GetLinkedUserMapping(platform, userID, linkedPlatform)
+		if err != nil {
+			t.Fatalf("GetLinkedUserMapping: %v", err)
+		}
+		if mapping == nil {
+			return ""
+		}
+		return mapping.PlatformUserID
+	}
+
+	link("100", "42")
+	if got := linked("discord", "100", "telegram"); got != "42" {
+		t.Errorf("linked Telegram user of Discord user 100 = %q, want %q", got, "42")
+	}
+	if got := linked("telegram", "42", "discord"); got != "100" {
+		t.Errorf("linked Discord user of Telegram user 42 = %q, want %q", got, "100")
+	}
+
+	// Linking the Telegram account again moves it to the new Discord account
+	link("200", "42")
+	if got := linked("telegram", "42", "discord"); got != "200" {
+		t.Errorf("linked Discord user of Telegram user 42 after relinking = %q, want %q", got, "200")
+	}
+	if got := linked("discord", "100", "telegram"); got != "" {
+		t.Errorf("linked Telegram user of Discord user 100 after relinking = %q, want none", got)
+	}
+}
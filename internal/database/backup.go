@@ -0,0 +1,290 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"dcbot/internal/database/models"
+)
+
+// BackupVersion is the current backup file format version
+const BackupVersion = 1
+
+// BackupData holds all bridge configuration exported from the database
+type BackupData struct {
+	Version         int                      `json:"version"`
+	CreatedAt       time.Time                `json:"created_at"`
+	Users           []*models.User           `json:"users"`
+	UserMappings    []*models.UserMapping    `json:"user_mappings"`
+	Rooms           []*models.Room           `json:"rooms"`
+	RoomMappings    []*models.RoomMapping    `json:"room_mappings"`
+	BridgeConfigs   []*models.BridgeConfig   `json:"bridge_configs"`
+	Messages        []*models.Message        `json:"messages"`
+	MessageMappings []*models.MessageMapping `json:"message_mappings"`
+}
+
+// ExportAll reads all bridge configuration tables into a BackupData
+func (d *Database) ExportAll() (*BackupData, error) {
+	data := &BackupData{
+		Version:   BackupVersion,
+		CreatedAt: time.Now(),
+	}
+
+	err := d.queryEach("SELECT id, created_at, updated_at FROM users ORDER BY id", func(rows *sql.Rows) error {
+		var user models.User
+		if err := rows.Scan(&user.ID, &user.CreatedAt, &user.UpdatedAt); err != nil {
+			return err
+		}
+		data.Users = append(data.Users, &user)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export users: %v", err)
+	}
+
+	err = d.queryEach(`
+		SELECT id, user_id, platform, platform_user_id, username, display_name, avatar_url, is_active, created_at, updated_at 
+		FROM user_mappings ORDER BY id`, func(rows *sql.Rows) error {
+		var mapping models.UserMapping
+		if err := rows.Scan(&mapping.ID, &mapping.UserID, &mapping.Platform, &mapping.PlatformUserID, &mapping.Username,
+			&mapping.DisplayName, &mapping.AvatarURL, &mapping.IsActive, &mapping.CreatedAt, &mapping.UpdatedAt); err != nil {
+			return err
+		}
+		data.UserMappings = append(data.UserMappings, &mapping)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export user mappings: %v", err)
+	}
+
+	err = d.queryEach("SELECT id, name, created_at, updated_at FROM rooms ORDER BY id", func(rows *sql.Rows) error {
+		var room models.Room
+		if err := rows.Scan(&room.ID, &room.Name, &room.CreatedAt, &room.UpdatedAt); err != nil {
+			return err
+		}
+		data.Rooms = append(data.Rooms, &room)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export rooms: %v", err)
+	}
+
+	err = d.queryEach(`
+		SELECT id, room_id, platform, platform_room_id, room_name, room_type, guild_id, priority, is_active, created_at, updated_at 
+		FROM room_mappings ORDER BY id`, func(rows *sql.Rows) error {
+		var mapping models.RoomMapping
+		if err := rows.Scan(&mapping.ID, &mapping.RoomID, &mapping.Platform, &mapping.PlatformRoomID,
+			&mapping.RoomName, &mapping.RoomType, &mapping.GuildID, &mapping.Priority, &mapping.IsActive, &mapping.CreatedAt, &mapping.UpdatedAt); err != nil {
+			return err
+		}
+		data.RoomMappings = append(data.RoomMappings, &mapping)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export room mappings: %v", err)
+	}
+
+	err = d.queryEach("SELECT "+bridgeConfigColumns+" FROM bridge_config ORDER BY id", func(rows *sql.Rows) error {
+		config, err := scanBridgeConfig(rows)
+		if err != nil {
+			return err
+		}
+		data.BridgeConfigs = append(data.BridgeConfigs, config)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export bridge configs: %v", err)
+	}
+
+	err = d.queryEach(`
+		SELECT id, original_id, source_platform, source_room_id, source_user_id, content, message_type, media_url, media_mime_type,
+			reply_to_id, is_edited, is_deleted, created_at, updated_at
+		FROM messages ORDER BY id`, func(rows *sql.Rows) error {
+		var message models.Message
+		if err := rows.Scan(&message.ID, &message.OriginalID, &message.SourcePlatform, &message.SourceRoomID, &message.SourceUserID,
+			&message.Content, &message.MessageType, &message.MediaURL, &message.MediaMimeType,
+			&message.ReplyToID, &message.IsEdited, &message.IsDeleted, &message.CreatedAt, &message.UpdatedAt); err != nil {
+			return err
+		}
+		data.Messages = append(data.Messages, &message)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export messages: %v", err)
+	}
+
+	err = d.queryEach(`
+		SELECT id, message_id, platform, platform_msg_id, platform_room_id, status, created_at, updated_at 
+		FROM message_mappings ORDER BY id`, func(rows *sql.Rows) error {
+		var mapping models.MessageMapping
+		if err := rows.Scan(&mapping.ID, &mapping.MessageID, &mapping.Platform, &mapping.PlatformMsgID,
+			&mapping.PlatformRoomID, &mapping.Status, &mapping.CreatedAt, &mapping.UpdatedAt); err != nil {
+			return err
+		}
+		data.MessageMappings = append(data.MessageMappings, &mapping)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export message mappings: %v", err)
+	}
+
+	return data, nil
+}
+
+// ImportAll writes a backup into the database in a single transaction.
+// Existing rows with the same IDs are replaced.
+func (d *Database) ImportAll(data *BackupData) error {
+	if err := ValidateBackup(data); err != nil {
+		return err
+	}
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	for _, user := range data.Users {
+		if _, err := tx.Exec("INSERT OR REPLACE INTO users (id, created_at, updated_at) VALUES (?, ?, ?)",
+			user.ID, user.CreatedAt, user.UpdatedAt); err != nil {
+			return fmt.Errorf("failed to import user %d: %v", user.ID, err)
+		}
+	}
+
+	for _, mapping := range data.UserMappings {
+		if _, err := tx.Exec(`
+			INSERT OR REPLACE INTO user_mappings (id, user_id, platform, platform_user_id, username, display_name, avatar_url, is_active, created_at, updated_at) 
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			mapping.ID, mapping.UserID, mapping.Platform, mapping.PlatformUserID, mapping.Username,
+			mapping.DisplayName, mapping.AvatarURL, mapping.IsActive, mapping.CreatedAt, mapping.UpdatedAt); err != nil {
+			return fmt.Errorf("failed to import user mapping %d: %v", mapping.ID, err)
+		}
+	}
+
+	for _, room := range data.Rooms {
+		if _, err := tx.Exec("INSERT OR REPLACE INTO rooms (id, name, created_at, updated_at) VALUES (?, ?, ?, ?)",
+			room.ID, room.Name, room.CreatedAt, room.UpdatedAt); err != nil {
+			return fmt.Errorf("failed to import room %d: %v", room.ID, err)
+		}
+	}
+
+	for _, mapping := range data.RoomMappings {
+		if _, err := tx.Exec(`
+			INSERT OR REPLACE INTO room_mappings (id, room_id, platform, platform_room_id, room_name, room_type, guild_id, priority, is_active, created_at, updated_at) 
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			mapping.ID, mapping.RoomID, mapping.Platform, mapping.PlatformRoomID, mapping.RoomName,
+			mapping.RoomType, mapping.GuildID, mapping.Priority, mapping.IsActive, mapping.CreatedAt, mapping.UpdatedAt); err != nil {
+			return fmt.Errorf("failed to import room mapping %d: %v", mapping.ID, err)
+		}
+	}
+
+	configInsert := fmt.Sprintf("INSERT OR REPLACE INTO bridge_config (%s) VALUES (%s)",
+		bridgeConfigColumns, placeholders(len(bridgeConfigValues(&models.BridgeConfig{}))))
+	for _, config := range data.BridgeConfigs {
+		if _, err := tx.Exec(configInsert, bridgeConfigValues(config)...); err != nil {
+			return fmt.Errorf("failed to import bridge config %d: %v", config.ID, err)
+		}
+	}
+
+	// Messages go first, message mappings reference them
+	for _, message := range data.Messages {
+		if _, err := tx.Exec(`
+			INSERT OR REPLACE INTO messages (id, original_id, source_platform, source_room_id, source_user_id, content, message_type,
+				media_url, media_mime_type, reply_to_id, is_edited, is_deleted, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			message.ID, message.OriginalID, message.SourcePlatform, message.SourceRoomID, message.SourceUserID, message.Content,
+			message.MessageType, message.MediaURL, message.MediaMimeType, message.ReplyToID, message.IsEdited, message.IsDeleted,
+			message.CreatedAt, message.UpdatedAt); err != nil {
+			return fmt.Errorf("failed to import message %d: %v", message.ID, err)
+		}
+	}
+
+	for _, mapping := range data.MessageMappings {
+		if _, err := tx.Exec(`
+			INSERT OR REPLACE INTO message_mappings (id, message_id, platform, platform_msg_id, platform_room_id, status, created_at, updated_at) 
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			mapping.ID, mapping.MessageID, mapping.Platform, mapping.PlatformMsgID,
+			mapping.PlatformRoomID, mapping.Status, mapping.CreatedAt, mapping.UpdatedAt); err != nil {
+			return fmt.Errorf("failed to import message mapping %d: %v", mapping.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit import: %v", err)
+	}
+
+	return nil
+}
+
+// ValidateBackup checks a backup's version and internal references without touching the database
+func ValidateBackup(data *BackupData) error {
+	if data == nil {
+		return fmt.Errorf("backup is empty")
+	}
+	if data.Version != BackupVersion {
+		return fmt.Errorf("unsupported backup version %d (expected %d)", data.Version, BackupVersion)
+	}
+
+	users := make(map[int]bool)
+	for _, user := range data.Users {
+		users[user.ID] = true
+	}
+	for _, mapping := range data.UserMappings {
+		if !users[mapping.UserID] {
+			return fmt.Errorf("user mapping %d references unknown user %d", mapping.ID, mapping.UserID)
+		}
+	}
+
+	rooms := make(map[int]bool)
+	for _, room := range data.Rooms {
+		rooms[room.ID] = true
+	}
+	for _, mapping := range data.RoomMappings {
+		if !rooms[mapping.RoomID] {
+			return fmt.Errorf("room mapping %d references unknown room %d", mapping.ID, mapping.RoomID)
+		}
+		if mapping.PlatformRoomID == "" {
+			return fmt.Errorf("room mapping %d has an empty platform room ID", mapping.ID)
+		}
+	}
+	for _, config := range data.BridgeConfigs {
+		if !rooms[config.RoomID] {
+			return fmt.Errorf("bridge config %d references unknown room %d", config.ID, config.RoomID)
+		}
+	}
+
+	messages := make(map[int]bool)
+	for _, message := range data.Messages {
+		messages[message.ID] = true
+	}
+	for _, mapping := range data.MessageMappings {
+		if !messages[mapping.MessageID] {
+			return fmt.Errorf("message mapping %d references unknown message %d", mapping.ID, mapping.MessageID)
+		}
+	}
+
+	return nil
+}
+
+// queryEach runs a query and calls fn for every returned row
+func (d *Database) queryEach(query string, fn func(rows *sql.Rows) error) error {
+	rows, err := d.db.Query(query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := fn(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// placeholders returns a comma separated list of n SQL placeholders
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
@@ -0,0 +1,71 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+
+	"dcbot/internal/database/models"
+)
+
+// newTestDatabase opens a fresh database in a temporary directory
+func newTestDatabase(t *testing.T) *Database {
+	t.Helper()
+
+	db, err := NewDatabase(filepath.Join(t.TempDir(), "bridge.db"))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestBackupRestoresMessageMappings(t *testing.T) {
+	source := newTestDatabase(t)
+	err := source.SaveMessageMapping(&models.Message{
+		OriginalID:     "100",
+		SourcePlatform: "discord",
+		SourceRoomID:   "1",
+		SourceUserID:   "42",
+		Content:        "hello",
+		MessageType:    "text",
+	}, &models.MessageMapping{
+		Platform:       "telegram",
+		PlatformMsgID:  "200",
+		PlatformRoomID: "-100",
+		Status:         "sent",
+	})
+	if err != nil {
+		t.Fatalf("failed to save message mapping: %v", err)
+	}
+
+	data, err := source.ExportAll()
+	if err != nil {
+		t.Fatalf("ExportAll: %v", err)
+	}
+	if len(data.Messages) != 1 || len(data.MessageMappings) != 1 {
+		t.Fatalf("exported %d messages and %d mappings, want 1 and 1", len(data.Messages), len(data.MessageMappings))
+	}
+
+	restored := newTestDatabase(t)
+	if err := restored.ImportAll(data); err != nil {
+		t.Fatalf("ImportAll: %v", err)
+	}
+
+	message, mapping, err := restored.GetMessageMapping("discord", "100", "telegram")
+	if err != nil {
+		t.Fatalf("restored mapping not found: %v", err)
+	}
+	if message.Content != "hello" || mapping.PlatformMsgID != "200" {
+		t.Errorf("restored message %q with mapping %q, want %q and %q", message.Content, mapping.PlatformMsgID, "hello", "200")
+	}
+}
+
+func TestValidateBackupRejectsDanglingMessageMapping(t *testing.T) {
+	data := &BackupData{
+		Version:         BackupVersion,
+		MessageMappings: []*models.MessageMapping{{ID: 1, MessageID: 7}},
+	}
+	if err := ValidateBackup(data); err == nil {
+		t.Error("ValidateBackup accepted a message mapping without its message")
+	}
+}
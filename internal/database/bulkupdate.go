@@ -0,0 +1,115 @@
+package database
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// bulkUpdateField turns a /config bulkupdate value into the bridge_config column it sets and the column value
+type bulkUpdateField func(value string) (column string, columnValue interface{}, err error)
+
+// bulkUpdateFields are the bridge config fields that are safe to change across many bridges at once
+var bulkUpdateFields = map[string]bulkUpdateField{
+	"max_message_length": func(value string) (string, interface{}, error) {
+		length, err := strconv.Atoi(value)
+		if err != nil || length < 0 {
+			return "", nil, fmt.Errorf("max_message_length must be a non-negative number")
+		}
+		return "max_message_length", length, nil
+	},
+	"silent_mode":  boolField("silent_mode"),
+	"compact_mode": boolField("compact_mode"),
+	"allow_media": func(value string) (string, interface{}, error) {
+		allow, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", nil, fmt.Errorf("allow_media must be true or false")
+		}
+		// allow_media was replaced by allowed_message_types, where an empty list allows every type
+		if allow {
+			return "allowed_message_types", "[]", nil
+		}
+		return "allowed_message_types", `["text"]`, nil
+	},
+}
+
+// boolField accepts true or false for a boolean column
+func boolField(column string) bulkUpdateField {
+	return func(value string) (string, interface{}, error) {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", nil, fmt.Errorf("%s must be true or false", column)
+		}
+		return column, enabled, nil
+	}
+}
+
+// BulkUpdateFields returns the bridge config fields BulkUpdateBridgeConfig accepts, sorted
+func BulkUpdateFields() []string {
+	fields := make([]string, 0, len(bulkUpdateFields))
+	for field := range bulkUpdateFields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// BulkUpdateBridgeConfig sets one config field of every bridge with a channel in a Discord server and returns how many were updated
+func (d *Database) BulkUpdateBridgeConfig(guildID, field, value string) (int, error) {
+	parse, ok := bulkUpdateFields[field]
+	if !ok {
+		return 0, fmt.Errorf("unknown field %q, valid fields are: %s", field, strings.Join(BulkUpdateFields(), ", "))
+	}
+	column, columnValue, err := parse(strings.TrimSpace(value))
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(`
+		SELECT DISTINCT room_id FROM room_mappings
+		WHERE platform = 'discord' AND guild_id = ? AND is_active = 1`, guildID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get bridged rooms: %v", err)
+	}
+	var roomIDs []int
+	for rows.Next() {
+		var roomID int
+		if err := rows.Scan(&roomID); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan room ID: %v", err)
+		}
+		roomIDs = append(roomIDs, roomID)
+	}
+	rows.Close()
+
+	now := time.Now()
+	for _, roomID := range roomIDs {
+		// Rooms still on the default settings have no config row yet
+		_, err := tx.Exec(`
+			INSERT INTO bridge_config (room_id, created_at, updated_at)
+			SELECT ?, ?, ? WHERE NOT EXISTS (SELECT 1 FROM bridge_config WHERE room_id = ?)`,
+			roomID, now, now, roomID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to create bridge config: %v", err)
+		}
+
+		// The column comes from bulkUpdateFields, never from the caller
+		_, err = tx.Exec(fmt.Sprintf("UPDATE bridge_config SET %s = ?, updated_at = ? WHERE room_id = ?", column), columnValue, now, roomID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to update bridge config: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit bulk update: %v", err)
+	}
+	return len(roomIDs), nil
+}
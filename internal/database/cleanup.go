@@ -0,0 +1,296 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/metrics"
+)
+
+// Cleanup schedule, the first run waits so startup is not slowed down
+const (
+	cleanupDelay    = 10 * time.Minute
+	cleanupInterval = 24 * time.Hour
+
+	// walCheckInterval is how often the WAL size is checked
+	walCheckInterval = time.Hour
+)
+
+// botSentMessageRetention is how long messages sent by the bot are remembered for loop prevention
+const botSentMessageRetention = 24 * time.Hour
+
+// bridgeEventRetention is how long delivery events are kept for debugging
+const bridgeEventRetention = 7 * 24 * time.Hour
+
+// trackedTables lists the tables reported by GetTableSizes
+var trackedTables = []string{
+	"users", "user_mappings", "rooms", "room_mappings", "messages", "message_mappings",
+	"bridge_config", "reactions", "audit_log", "user_activity", "webhooks", "polls", "bot_sent_messages",
+	"bridge_events", "pending_bridge_invites", "pending_account_links", "db_snapshots",
+}
+
+// PruneOldMessages keeps only the newest maxPerBridge messages of each source room.
+// Mappings of deleted messages are removed too. Returns the number of deleted rows.
+func (d *Database) PruneOldMessages(maxPerBridge int) (int64, error) {
+	if maxPerBridge <= 0 {
+		return 0, nil
+	}
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`
+		DELETE FROM messages WHERE id IN (
+			SELECT id FROM (
+				SELECT id, ROW_NUMBER() OVER (PARTITION BY source_platform, source_room_id ORDER BY id DESC) AS row_num
+				FROM messages
+			) WHERE row_num > ?
+		)`, maxPerBridge)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune messages: %v", err)
+	}
+	deleted, _ := result.RowsAffected()
+
+	result, err = tx.Exec(`DELETE FROM message_mappings WHERE message_id NOT IN (SELECT id FROM messages)`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune orphaned message mappings: %v", err)
+	}
+	orphans, _ := result.RowsAffected()
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+
+	metrics.AddDBPrunedRows("messages", deleted)
+	metrics.AddDBPrunedRows("message_mappings", orphans)
+	return deleted + orphans, nil
+}
+
+// PruneOldMessageMappings keeps only the newest maxPerBridge message mappings of each target room
+func (d *Database) PruneOldMessageMappings(maxPerBridge int) (int64, error) {
+	if maxPerBridge <= 0 {
+		return 0, nil
+	}
+
+	result, err := d.db.Exec(`
+		DELETE FROM message_mappings WHERE id IN (
+			SELECT id FROM (
+				SELECT id, ROW_NUMBER() OVER (PARTITION BY platform, platform_room_id ORDER BY id DESC) AS row_num
+				FROM message_mappings
+			) WHERE row_num > ?
+		)`, maxPerBridge)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune message mappings: %v", err)
+	}
+
+	deleted, _ := result.RowsAffected()
+	metrics.AddDBPrunedRows("message_mappings", deleted)
+	return deleted, nil
+}
+
+// PruneBotSentMessages forgets messages sent by the bot before the given time
+func (d *Database) PruneBotSentMessages(before time.Time) (int64, error) {
+	result, err := d.db.Exec("DELETE FROM bot_sent_messages WHERE sent_at < ?", before.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune bot sent messages: %v", err)
+	}
+
+	deleted, _ := result.RowsAffected()
+	metrics.AddDBPrunedRows("bot_sent_messages", deleted)
+	return deleted, nil
+}
+
+// GetTableSizes returns the row count of each table, tables that cannot be counted are left out
+func (d *Database) GetTableSizes() map[string]int {
+	sizes := make(map[string]int, len(trackedTables))
+	for _, table := range trackedTables {
+		var count int
+		if err := d.db.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&count); err != nil {
+			log.Printf("⚠️ Failed to count rows in %s: %v", table, err)
+			continue
+		}
+		sizes[table] = count
+	}
+
+	if frames, err := d.GetWALSize(); err != nil {
+		log.Printf("⚠️ Failed to get WAL size: %v", err)
+	} else {
+		sizes["wal_frames"] = int(frames)
+	}
+	return sizes
+}
+
+// walCheckpoint runs a WAL checkpoint in the given mode and returns the WAL size and checkpointed frames, in pages
+func (d *Database) walCheckpoint(mode string) (int64, int64, error) {
+	var busy, logFrames, checkpointed int64
+	if err := d.db.QueryRow("PRAGMA wal_checkpoint("+mode+")").Scan(&busy, &logFrames, &checkpointed); err != nil {
+		return 0, 0, fmt.Errorf("failed to run %s checkpoint: %v", strings.ToLower(mode), err)
+	}
+
+	// Both are -1 when the database is not in WAL mode
+	if logFrames < 0 || checkpointed < 0 {
+		return 0, 0, nil
+	}
+	return logFrames, checkpointed, nil
+}
+
+// GetWALSize runs a passive checkpoint and returns the number of frames in the WAL file
+func (d *Database) GetWALSize() (int64, error) {
+	logFrames, _, err := d.walCheckpoint("PASSIVE")
+	return logFrames, err
+}
+
+// CheckWAL truncates the WAL file when more than threshold of its frames could not be checkpointed passively
+func (d *Database) CheckWAL(threshold int) error {
+	logFrames, checkpointed, err := d.walCheckpoint("PASSIVE")
+	if err != nil {
+		return err
+	}
+
+	pending := logFrames - checkpointed
+	if threshold <= 0 || pending <= int64(threshold) {
+		return nil
+	}
+
+	log.Printf("⚠️ WAL has %d uncheckpointed pages (threshold %d), running a truncating checkpoint", pending, threshold)
+	_, _, err = d.walCheckpoint("TRUNCATE")
+	return err
+}
+
+// formatTableSizes lists table sizes in trackedTables order, followed by the WAL frame count
+func formatTableSizes(sizes map[string]int) string {
+	parts := make([]string, 0, len(sizes))
+	for _, table := range trackedTables {
+		if count, ok := sizes[table]; ok {
+			parts = append(parts, fmt.Sprintf("%s=%d", table, count))
+		}
+	}
+	if frames, ok := sizes["wal_frames"]; ok {
+		parts = append(parts, fmt.Sprintf("wal_frames=%d", frames))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Cleaner prunes old messages, message mappings, delivery events and expired bridge invites so the database does not grow without bound.
+// It also keeps the WAL file from growing by checkpointing it when too many pages are pending.
+type Cleaner struct {
+	db           *Database
+	maxMessages  int
+	maxMappings  int
+	walThreshold int
+	mu           sync.Mutex // Serializes scheduled and manual runs
+}
+
+// NewCleaner creates a cleaner keeping at most maxMessages messages and maxMappings mappings per bridge,
+// and at most walThreshold uncheckpointed WAL pages
+func NewCleaner(db *Database, maxMessages, maxMappings, walThreshold int) *Cleaner {
+	return &Cleaner{
+		db:           db,
+		maxMessages:  maxMessages,
+		maxMappings:  maxMappings,
+		walThreshold: walThreshold,
+	}
+}
+
+// Start prunes the database shortly after startup and then daily, checks the WAL size hourly
+// and takes a size snapshot daily at midnight UTC
+func (c *Cleaner) Start(ctx context.Context) {
+	go func() {
+		timer := time.NewTimer(cleanupDelay)
+		defer timer.Stop()
+
+		walTicker := time.NewTicker(walCheckInterval)
+		defer walTicker.Stop()
+
+		snapshotTimer := time.NewTimer(untilNextSnapshot(time.Now()))
+		defer snapshotTimer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+				if _, err := c.RunNow(); err != nil {
+					log.Printf("❌ Database cleanup failed: %v", err)
+				}
+				timer.Reset(cleanupInterval)
+			case <-walTicker.C:
+				c.mu.Lock()
+				err := c.db.CheckWAL(c.walThreshold)
+				c.mu.Unlock()
+				if err != nil {
+					log.Printf("❌ WAL check failed: %v", err)
+				}
+			case <-snapshotTimer.C:
+				c.mu.Lock()
+				err := c.db.TakeSnapshot()
+				c.mu.Unlock()
+				if err != nil {
+					log.Printf("❌ Database snapshot failed: %v", err)
+				}
+				snapshotTimer.Reset(untilNextSnapshot(time.Now()))
+			}
+		}
+	}()
+}
+
+// TableSizes returns the row count of each table and the WAL frame count
+func (c *Cleaner) TableSizes() map[string]int {
+	return c.db.GetTableSizes()
+}
+
+// SnapshotHistory returns the daily size snapshots of the last days, oldest first
+func (c *Cleaner) SnapshotHistory(days int) ([]*models.DBSnapshot, error) {
+	return c.db.GetSnapshotHistory(days)
+}
+
+// RunNow prunes the database immediately and returns the number of deleted rows
+func (c *Cleaner) RunNow() (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	log.Printf("📊 Table sizes before pruning: %s", formatTableSizes(c.db.GetTableSizes()))
+
+	messages, err := c.db.PruneOldMessages(c.maxMessages)
+	if err != nil {
+		return 0, err
+	}
+
+	mappings, err := c.db.PruneOldMessageMappings(c.maxMappings)
+	if err != nil {
+		return messages, err
+	}
+
+	sent, err := c.db.PruneBotSentMessages(time.Now().Add(-botSentMessageRetention))
+	if err != nil {
+		return messages + mappings, err
+	}
+
+	events, err := c.db.PruneBridgeEvents(time.Now().Add(-bridgeEventRetention))
+	if err != nil {
+		return messages + mappings + sent, err
+	}
+
+	invites, err := c.db.PruneBridgeInvites(time.Now())
+	if err != nil {
+		return messages + mappings + sent + events, err
+	}
+
+	links, err := c.db.PruneAccountLinks(time.Now())
+	if err != nil {
+		return messages + mappings + sent + events + invites, err
+	}
+
+	deleted := messages + mappings + sent + events + invites + links
+	log.Printf("🧹 Pruned %d old database rows", deleted)
+	log.Printf("📊 Table sizes after pruning: %s", formatTableSizes(c.db.GetTableSizes()))
+	return deleted, nil
+}
@@ -0,0 +1,71 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/metrics"
+)
+
+// AddBridgeEvent records the outcome of a delivery attempt through a bridge
+func (d *Database) AddBridgeEvent(event *models.BridgeEvent) error {
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	result, err := d.db.Exec(`
+		INSERT INTO bridge_events (event_type, bridge_id, source_platform, source_channel_id, source_msg_id, target_platform, target_channel_id, status, error_msg, processing_time_ms, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		event.EventType, event.BridgeID, event.SourcePlatform, event.SourceChannelID, event.SourceMsgID,
+		event.TargetPlatform, event.TargetChannelID, event.Status, event.ErrorMsg, event.ProcessingTimeMs, event.CreatedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to add bridge event: %v", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get bridge event ID: %v", err)
+	}
+	event.ID = id
+	return nil
+}
+
+// GetBridgeEvents returns the most recent events of a bridge, newest first
+func (d *Database) GetBridgeEvents(bridgeID string, limit int) ([]*models.BridgeEvent, error) {
+	rows, err := d.db.Query(`
+		SELECT id, event_type, bridge_id, source_platform, source_channel_id, source_msg_id, target_platform, target_channel_id, status, error_msg, processing_time_ms, created_at
+		FROM bridge_events
+		WHERE bridge_id = ?
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?`, bridgeID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bridge events: %v", err)
+	}
+	defer rows.Close()
+
+	var events []*models.BridgeEvent
+	for rows.Next() {
+		var event models.BridgeEvent
+		err := rows.Scan(&event.ID, &event.EventType, &event.BridgeID, &event.SourcePlatform, &event.SourceChannelID, &event.SourceMsgID,
+			&event.TargetPlatform, &event.TargetChannelID, &event.Status, &event.ErrorMsg, &event.ProcessingTimeMs, &event.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan bridge event: %v", err)
+		}
+		events = append(events, &event)
+	}
+
+	return events, rows.Err()
+}
+
+// PruneBridgeEvents deletes bridge events recorded before the given time
+func (d *Database) PruneBridgeEvents(before time.Time) (int64, error) {
+	result, err := d.db.Exec("DELETE FROM bridge_events WHERE created_at < ?", before.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune bridge events: %v", err)
+	}
+
+	deleted, _ := result.RowsAffected()
+	metrics.AddDBPrunedRows("bridge_events", deleted)
+	return deleted, nil
+}
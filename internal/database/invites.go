@@ -0,0 +1,68 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/metrics"
+)
+
+// CreateBridgeInvite stores a pending invitation to bridge a Telegram chat
+func (d *Database) CreateBridgeInvite(invite *models.PendingBridgeInvite) error {
+	if invite.CreatedAt.IsZero() {
+		invite.CreatedAt = time.Now()
+	}
+
+	_, err := d.db.Exec(`
+		INSERT INTO pending_bridge_invites (code, chat_id, chat_title, created_by, created_at, expires_at)
+		VALUES (?, ?, ?, ?, ?, ?)`,
+		invite.Code, invite.ChatID, invite.ChatTitle, invite.CreatedBy, invite.CreatedAt.UTC(), invite.ExpiresAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to create bridge invite: %v", err)
+	}
+	return nil
+}
+
+// RedeemBridgeInvite returns and deletes an unexpired invitation, or nil if there is none with that code
+func (d *Database) RedeemBridgeInvite(code string) (*models.PendingBridgeInvite, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var invite models.PendingBridgeInvite
+	err = tx.QueryRow(`
+		SELECT code, chat_id, chat_title, created_by, created_at, expires_at
+		FROM pending_bridge_invites
+		WHERE code = ? AND expires_at > ?`, code, time.Now().UTC()).
+		Scan(&invite.Code, &invite.ChatID, &invite.ChatTitle, &invite.CreatedBy, &invite.CreatedAt, &invite.ExpiresAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bridge invite: %v", err)
+	}
+
+	if _, err := tx.Exec("DELETE FROM pending_bridge_invites WHERE code = ?", code); err != nil {
+		return nil, fmt.Errorf("failed to delete bridge invite: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return &invite, nil
+}
+
+// PruneBridgeInvites deletes invitations that expired before the given time
+func (d *Database) PruneBridgeInvites(before time.Time) (int64, error) {
+	result, err := d.db.Exec("DELETE FROM pending_bridge_invites WHERE expires_at < ?", before.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune bridge invites: %v", err)
+	}
+
+	deleted, _ := result.RowsAffected()
+	metrics.AddDBPrunedRows("pending_bridge_invites", deleted)
+	return deleted, nil
+}
@@ -0,0 +1,60 @@
+package database
+
+import "testing"
+
+// newTestRoomMapping creates a room with a single mapping for the given channel
+func newTestRoomMapping(t *testing.T, db *Database, name, platform, platformRoomID string) int {
+	t.Helper()
+
+	room, err := db.CreateOrGetRoom(name)
+	if err != nil {
+		t.Fatalf("failed to create room: %v", err)
+	}
+	if _, err := db.CreateOrGetRoomMapping(room.ID, platform, platformRoomID, name, "group"); err != nil {
+		t.Fatalf("failed to create room mapping: %v", err)
+	}
+	return room.ID
+}
+
+func TestMigrateRoomMappingsReplacesInactiveMapping(t *testing.T) {
+	db := newTestDatabase(t)
+	removed := newTestRoomMapping(t, db, "old bridge", "telegram", "-200")
+	if err := db.RemoveRoomMapping(removed, "telegram"); err != nil {
+		t.Fatalf("failed to remove room mapping: %v", err)
+	}
+	newTestRoomMapping(t, db, "bridge", "telegram", "-100")
+
+	rows, err := db.MigrateRoomMappings("telegram", "-100", "-200", nil, nil)
+	if err != nil {
+		t.Fatalf("MigrateRoomMappings: %v", err)
+	}
+	if rows != 1 {
+		t.Errorf("migrated %d room mappings, want 1", rows)
+	}
+
+	mapping, err := db.GetRoomMappingByPlatformRoom("telegram", "-200")
+	if err != nil {
+		t.Fatalf("migrated mapping not found: %v", err)
+	}
+	if mapping.RoomName != "bridge" || !mapping.IsActive {
+		t.Errorf("channel maps to %q (active %v), want active %q", mapping.RoomName, mapping.IsActive, "bridge")
+	}
+}
+
+func TestMigrateRoomMappingsRejectsBridgedChannel(t *testing.T) {
+	db := newTestDatabase(t)
+	newTestRoomMapping(t, db, "bridge", "telegram", "-100")
+	newTestRoomMapping(t, db, "other bridge", "telegram", "-200")
+
+	if _, err := db.MigrateRoomMappings("telegram", "-100", "-200", nil, nil); err == nil {
+		t.Fatal("MigrateRoomMappings moved a channel onto an already bridged channel")
+	}
+
+	mapping, err := db.GetRoomMappingByPlatformRoom("telegram", "-100")
+	if err != nil {
+		t.Fatalf("original mapping not found: %v", err)
+	}
+	if mapping.RoomName != "bridge" {
+		t.Errorf("channel maps to %q, want %q", mapping.RoomName, "bridge")
+	}
+}
@@ -1,6 +1,14 @@
 package models
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
 	"time"
 )
 
@@ -40,7 +48,9 @@ type RoomMapping struct {
 	Platform       string    `db:"platform" json:"platform"`        // "telegram", "discord"
 	PlatformRoomID string    `db:"platform_room_id" json:"platform_room_id"`
 	RoomName       string    `db:"room_name" json:"room_name"`
-	RoomType       string    `db:"room_type" json:"room_type"`       // "channel", "group", "dm"
+	RoomType       string    `db:"room_type" json:"room_type"`         // "channel", "group", "dm"
+	GuildID        string    `db:"guild_id" json:"guild_id,omitempty"` // Discord server of the channel
+	Priority       int       `db:"priority" json:"priority"`           // Delivery priority (0-10) of bridges into this channel, higher first
 	IsActive       bool      `db:"is_active" json:"is_active"`
 	CreatedAt      time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
@@ -78,14 +88,386 @@ type MessageMapping struct {
 
 // BridgeConfig represents bridge configuration for room mappings
 type BridgeConfig struct {
+	ID                        int        `db:"id" json:"id"`
+	RoomID                    int        `db:"room_id" json:"room_id"`
+	IsActive                  bool       `db:"is_active" json:"is_active"`
+	AllowedMessageTypes       StringList `db:"allowed_message_types" json:"allowed_message_types"`             // Message types bridged from the channel, empty allows every type
+	AllowEdits                bool       `db:"allow_edits" json:"allow_edits"`
+	AllowDeletes              bool       `db:"allow_deletes" json:"allow_deletes"`
+	FilterWords               string     `db:"filter_words" json:"filter_words"` // JSON array of filter rules
+	MaxMessageLength          int        `db:"max_message_length" json:"max_message_length"`
+	BridgeChatPhotoChanges    bool       `db:"bridge_chat_photo_changes" json:"bridge_chat_photo_changes"`     // Bridge group photo/icon changes
+	BridgeVoiceEvents         bool       `db:"bridge_voice_events" json:"bridge_voice_events"`                 // Bridge Discord voice channel join/leave events
+	NormalizeEmoji            bool       `db:"normalize_emoji" json:"normalize_emoji"`                         // Normalize platform-specific emoji codes
+	Name                      *string    `db:"name" json:"name"`                                               // Human-readable bridge name, NULL if unset
+	BridgeReactions           bool       `db:"bridge_reactions" json:"bridge_reactions"`                       // Bridge Discord reaction counts to Telegram
+	SendDowntimeNotifications bool       `db:"send_downtime_notifications" json:"send_downtime_notifications"` // Notify Telegram chats while Discord is unreachable
+	SilentMode                bool       `db:"silent_mode" json:"silent_mode"`                                 // Send bridged Telegram messages without notification
+	SilentHoursStart          int        `db:"silent_hours_start" json:"silent_hours_start"`                   // Hour (0-23) silent hours begin
+	SilentHoursEnd            int        `db:"silent_hours_end" json:"silent_hours_end"`                       // Hour (0-23) silent hours end, equal to start disables them
+	EmbedColor                int        `db:"embed_color" json:"embed_color"`                                 // Color of Discord embeds about this bridge, 0x7289DA by default
+	AllowedSenders            StringList `db:"allowed_senders" json:"allowed_senders"`                         // Platform-qualified user IDs (platform:userID) allowed to send, empty allows everyone
+	BridgeVoiceActivity       bool       `db:"bridge_voice_activity" json:"bridge_voice_activity"`             // Notify when Discord voice chats start and end
+	VoiceStartMessage         string     `db:"voice_start_message" json:"voice_start_message"`                 // Voice chat started template with {channel} and {count}, empty uses the default
+	VoiceEndMessage           string     `db:"voice_end_message" json:"voice_end_message"`                     // Voice chat ended template with {channel}, empty uses the default
+	MaxRetries                int        `db:"max_retries" json:"max_retries"`                                 // Delivery attempts before a failed message is dropped, 0 uses the global default
+	RetryBackoffMultiplier    float64    `db:"retry_backoff_multiplier" json:"retry_backoff_multiplier"`       // Growth of the delay between retries
+	BridgePins                bool       `db:"bridge_pins" json:"bridge_pins"`                                 // Bridge pin notices between Telegram and Discord
+	BridgeMessageComponents   bool       `db:"bridge_message_components" json:"bridge_message_components"`     // Describe Discord buttons and menus in bridged messages
+	BridgeForumTags           bool       `db:"bridge_forum_tags" json:"bridge_forum_tags"`                     // Append Discord forum post tags to bridged posts as hashtags
+	SourceFormatHeaders       StringMap  `db:"source_format_headers" json:"source_format_headers"`             // Source platform -> text/template sender header, unset platforms use DefaultFormatHeader
+	PreserveForwardMetadata   bool       `db:"preserve_forward_metadata" json:"preserve_forward_metadata"`     // Show where forwarded Telegram messages came from
+	RateLimitPerMinute        int        `db:"rate_limit_per_minute" json:"rate_limit_per_minute"`             // Messages bridged from the channel per minute before dropping, 0 disables the limit
+	BridgeSystemEvents        bool       `db:"bridge_system_events" json:"bridge_system_events"`               // Bridge Telegram group and Discord channel renames
+	AckWithReaction           bool       `db:"ack_with_reaction" json:"ack_with_reaction"`                     // Briefly react to Discord messages with their delivery result
+	CompactMode               bool       `db:"compact_mode" json:"compact_mode"`                               // Show only the sender name as header, without the platform prefix
+	CompactModeAnnounced      bool       `db:"compact_mode_announced" json:"compact_mode_announced"`           // The one-time compact mode notice was sent
+	DeliveryDelaySeconds      int        `db:"delivery_delay_seconds" json:"delivery_delay_seconds"`           // Messages wait this long in the queue before delivery, 0 delivers immediately
+	BridgeLocations           bool       `db:"bridge_locations" json:"bridge_locations"`                       // Bridge shared Telegram locations as map embeds
+	MaxMessageLengthPerUser   int        `db:"max_message_length_per_user" json:"max_message_length_per_user"` // Characters kept of a user's message sent soon after their previous one, 0 disables truncation
+	UserSpamWindowSeconds     int        `db:"user_spam_window_seconds" json:"user_spam_window_seconds"`       // How soon after a user's previous message MaxMessageLengthPerUser applies
+	BridgeStickerInfo         bool       `db:"bridge_sticker_info" json:"bridge_sticker_info"`                 // Show Telegram stickers as embeds naming their sticker set
+	SyncTopics                bool       `db:"sync_topics" json:"sync_topics"`                                 // Copy Telegram group descriptions and Discord channel topics to the other side
+	SyncAdminRoles            bool       `db:"sync_admin_roles" json:"sync_admin_roles"`                       // Keep Telegram group admins and holders of AdminRoleID in sync, for linked accounts
+	AdminRoleID               string     `db:"admin_role_id" json:"admin_role_id"`                             // Discord role matching Telegram group admins
+	CreatedAt                 time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt                 time.Time  `db:"updated_at" json:"updated_at"`
+}
+
+// Default voice activity notification templates
+const (
+	DefaultVoiceStartMessage = "🎙️ Voice chat started in #{channel} ({count} participants)"
+	DefaultVoiceEndMessage   = "🔇 Voice chat ended in #{channel}"
+)
+
+// FormatVoiceStart renders the voice chat started notification for a channel
+func (c *BridgeConfig) FormatVoiceStart(channelName string, participants int) string {
+	template := c.VoiceStartMessage
+	if template == "" {
+		template = DefaultVoiceStartMessage
+	}
+	return strings.NewReplacer("{channel}", channelName, "{count}", strconv.Itoa(participants)).Replace(template)
+}
+
+// FormatVoiceEnd renders the voice chat ended notification for a channel
+func (c *BridgeConfig) FormatVoiceEnd(channelName string) string {
+	template := c.VoiceEndMessage
+	if template == "" {
+		template = DefaultVoiceEndMessage
+	}
+	return strings.ReplaceAll(template, "{channel}", channelName)
+}
+
+// DefaultFormatHeader is the sender header of messages from platforms without a header template
+const DefaultFormatHeader = "[{{.Platform}}] {{.Username}}"
+
+// TemplateData is the data available to sender header templates
+type TemplateData struct {
+	Username  string    // Sender name as the target shows it, e.g. "@name" on Telegram
+	Platform  string    // Source platform label, e.g. "TELEGRAM"
+	Timestamp time.Time // When the message was sent
+}
+
+// FormatHeader renders the sender header of a message from sourcePlatform; the target adds the separator before the content.
+// In compact mode the header is just the sender name.
+func (c *BridgeConfig) FormatHeader(sourcePlatform string, data TemplateData) string {
+	if c != nil && c.CompactMode {
+		return data.Username
+	}
+
+	format := DefaultFormatHeader
+	if c != nil && c.SourceFormatHeaders[sourcePlatform] != "" {
+		format = c.SourceFormatHeaders[sourcePlatform]
+	}
+
+	header, err := renderHeader(format, data)
+	if err != nil {
+		log.Printf("⚠️ Invalid %s header template %q, using the default: %v", sourcePlatform, format, err)
+		header, _ = renderHeader(DefaultFormatHeader, data)
+	}
+	return header
+}
+
+// renderHeader executes a sender header template
+func renderHeader(format string, data TemplateData) (string, error) {
+	tmpl, err := template.New("header").Parse(format)
+	if err != nil {
+		return "", err
+	}
+
+	var header strings.Builder
+	if err := tmpl.Execute(&header, data); err != nil {
+		return "", err
+	}
+	return header.String(), nil
+}
+
+// StringList is a list of strings stored as a JSON array column
+type StringList []string
+
+// Scan implements sql.Scanner
+func (l *StringList) Scan(value interface{}) error {
+	var raw []byte
+	switch v := value.(type) {
+	case nil:
+		*l = StringList{}
+		return nil
+	case string:
+		raw = []byte(v)
+	case []byte:
+		raw = v
+	default:
+		return fmt.Errorf("unsupported type for string list: %T", value)
+	}
+
+	if len(raw) == 0 {
+		*l = StringList{}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return fmt.Errorf("invalid string list: %v", err)
+	}
+	*l = list
+	return nil
+}
+
+// Value implements driver.Valuer
+func (l StringList) Value() (driver.Value, error) {
+	if l == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal([]string(l))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Contains reports whether the list holds the given value
+func (l StringList) Contains(value string) bool {
+	for _, item := range l {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsMessageType reports whether the bridge's allowed message types include a type
+func (c *BridgeConfig) AllowsMessageType(messageType string) bool {
+	return len(c.AllowedMessageTypes) == 0 || slices.Contains(c.AllowedMessageTypes, messageType)
+}
+
+// StringMap is a string map stored as a JSON object column
+type StringMap map[string]string
+
+// Scan implements sql.Scanner
+func (m *StringMap) Scan(value interface{}) error {
+	var raw []byte
+	switch v := value.(type) {
+	case nil:
+		*m = StringMap{}
+		return nil
+	case string:
+		raw = []byte(v)
+	case []byte:
+		raw = v
+	default:
+		return fmt.Errorf("unsupported type for string map: %T", value)
+	}
+
+	if len(raw) == 0 {
+		*m = StringMap{}
+		return nil
+	}
+
+	var values map[string]string
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return fmt.Errorf("invalid string map: %v", err)
+	}
+	*m = values
+	return nil
+}
+
+// Value implements driver.Valuer
+func (m StringMap) Value() (driver.Value, error) {
+	if m == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(map[string]string(m))
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// IsSenderAllowed reports whether a user may send through the bridge, an empty whitelist allows everyone
+func (c *BridgeConfig) IsSenderAllowed(platform, userID string) bool {
+	if len(c.AllowedSenders) == 0 {
+		return true
+	}
+	return c.AllowedSenders.Contains(platform + ":" + userID)
+}
+
+// IsSilentAt reports whether bridged messages should be sent without notification at the given time
+func (c *BridgeConfig) IsSilentAt(t time.Time) bool {
+	if c.SilentMode {
+		return true
+	}
+	if c.SilentHoursStart == c.SilentHoursEnd {
+		return false
+	}
+
+	hour := t.Hour()
+	if c.SilentHoursStart < c.SilentHoursEnd {
+		return hour >= c.SilentHoursStart && hour < c.SilentHoursEnd
+	}
+	// Window wraps around midnight, e.g. 22:00-08:00
+	return hour >= c.SilentHoursStart || hour < c.SilentHoursEnd
+}
+
+// Filter rule match modes
+const (
+	FilterModeExact = "exact"
+	FilterModeGlob  = "glob"
+	FilterModeRegex = "regex"
+)
+
+// Filter rule actions
+const (
+	FilterActionBlock   = "block"
+	FilterActionReplace = "replace"
+)
+
+// FilterRule represents a single content-moderation rule stored in BridgeConfig.FilterWords
+type FilterRule struct {
+	Pattern     string `json:"pattern"`
+	Mode        string `json:"mode"`                  // "exact", "glob", "regex"
+	Action      string `json:"action"`                // "block", "replace"
+	Replacement string `json:"replacement,omitempty"` // Used when action is "replace"
+}
+
+// Reaction tracks reaction counts of a Discord message bridged to Telegram
+type Reaction struct {
+	DiscordMsgID   string `db:"discord_msg_id" json:"discord_msg_id"`
+	ReactionsJSON  string `db:"reactions_json" json:"reactions_json"` // JSON object of emoji -> count
+	TelegramMsgID  string `db:"telegram_msg_id" json:"telegram_msg_id"`
+	TelegramChatID string `db:"telegram_chat_id" json:"telegram_chat_id"`
+}
+
+// AuditLog records an administrative action
+type AuditLog struct {
+	ID            int       `db:"id" json:"id"`
+	Action        string    `db:"action" json:"action"`
+	ActorPlatform string    `db:"actor_platform" json:"actor_platform"`
+	ActorID       string    `db:"actor_id" json:"actor_id"`
+	Target        string    `db:"target" json:"target"`
+	Details       string    `db:"details" json:"details"`
+	CreatedAt     time.Time `db:"created_at" json:"created_at"`
+}
+
+// BridgeEvent records the outcome of one delivery attempt through a bridge
+type BridgeEvent struct {
+	ID               int64     `db:"id" json:"id"`
+	EventType        string    `db:"event_type" json:"event_type"`
+	BridgeID         string    `db:"bridge_id" json:"bridge_id"`
+	SourcePlatform   string    `db:"source_platform" json:"source_platform"`
+	SourceChannelID  string    `db:"source_channel_id" json:"source_channel_id"`
+	SourceMsgID      string    `db:"source_msg_id" json:"source_msg_id"`
+	TargetPlatform   string    `db:"target_platform" json:"target_platform"`
+	TargetChannelID  string    `db:"target_channel_id" json:"target_channel_id"`
+	Status           string    `db:"status" json:"status"`
+	ErrorMsg         string    `db:"error_msg" json:"error_msg"`
+	ProcessingTimeMs int64     `db:"processing_time_ms" json:"processing_time_ms"`
+	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+}
+
+// DBSnapshot records the size of the database at a point in time, for capacity planning
+type DBSnapshot struct {
+	ID            int64     `db:"id" json:"id"`
+	SnapshotAt    time.Time `db:"snapshot_at" json:"snapshot_at"`
+	MessagesCount int       `db:"messages_count" json:"messages_count"`
+	MappingsCount int       `db:"mappings_count" json:"mappings_count"` // Message mappings
+	UsersCount    int       `db:"users_count" json:"users_count"`
+	RoomsCount    int       `db:"rooms_count" json:"rooms_count"`
+	DBSizeBytes   int64     `db:"db_size_bytes" json:"db_size_bytes"`
+	WALSizeBytes  int64     `db:"wal_size_bytes" json:"wal_size_bytes"`
+}
+
+// PendingBridgeInvite is a Telegram chat waiting to be bridged from Discord with its invite code
+type PendingBridgeInvite struct {
+	Code      string    `db:"code" json:"code"`
+	ChatID    string    `db:"chat_id" json:"chat_id"`
+	ChatTitle string    `db:"chat_title" json:"chat_title"`
+	CreatedBy string    `db:"created_by" json:"created_by"` // Telegram user ID of the inviter
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	ExpiresAt time.Time `db:"expires_at" json:"expires_at"`
+}
+
+// PendingAccountLink is a Telegram account waiting to be linked to a Discord account with its link code
+type PendingAccountLink struct {
+	Code           string    `db:"code" json:"code"`
+	PlatformUserID string    `db:"platform_user_id" json:"platform_user_id"` // Telegram user ID of the requester
+	Username       string    `db:"username" json:"username"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+	ExpiresAt      time.Time `db:"expires_at" json:"expires_at"`
+}
+
+// UserActivity counts a user's messages on a bridge
+type UserActivity struct {
+	Platform     string    `db:"platform" json:"platform"`
+	UserID       string    `db:"user_id" json:"user_id"`
+	BridgeID     string    `db:"bridge_id" json:"bridge_id"`
+	MessageCount int       `db:"message_count" json:"message_count"`
+	LastActive   time.Time `db:"last_active" json:"last_active"`
+}
+
+// Poll tracks a poll created on Telegram from Discord
+type Poll struct {
+	ID               int       `db:"id" json:"id"`
+	DiscordChannelID string    `db:"discord_channel_id" json:"discord_channel_id"`
+	TelegramChatID   string    `db:"telegram_chat_id" json:"telegram_chat_id"`
+	TelegramMsgID    string    `db:"telegram_msg_id" json:"telegram_msg_id"`
+	TelegramPollID   string    `db:"telegram_poll_id" json:"telegram_poll_id"`
+	Question         string    `db:"question" json:"question"`
+	OptionsJSON      string    `db:"options_json" json:"options_json"` // JSON array of option texts
+	ResultsJSON      string    `db:"results_json" json:"results_json"` // JSON array of vote counts per option
+	TotalVoters      int       `db:"total_voters" json:"total_voters"`
+	IsAnonymous      bool      `db:"is_anonymous" json:"is_anonymous"`
+	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+}
+
+// Webhook is a Discord webhook created by the bot to post bridged messages
+type Webhook struct {
+	ChannelID string    `db:"channel_id" json:"channel_id"`
+	WebhookID string    `db:"webhook_id" json:"webhook_id"`
+	Token     string    `db:"token" json:"-"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
+// ThreadMapping links a Telegram forum topic to the Discord thread its messages are bridged into
+type ThreadMapping struct {
 	ID               int       `db:"id" json:"id"`
-	RoomID           int       `db:"room_id" json:"room_id"`
-	IsActive         bool      `db:"is_active" json:"is_active"`
-	AllowMedia       bool      `db:"allow_media" json:"allow_media"`
-	AllowEdits       bool      `db:"allow_edits" json:"allow_edits"`
-	AllowDeletes     bool      `db:"allow_deletes" json:"allow_deletes"`
-	FilterWords      string    `db:"filter_words" json:"filter_words"`        // JSON array of filtered words
-	MaxMessageLength int       `db:"max_message_length" json:"max_message_length"`
+	TelegramChatID   string    `db:"telegram_chat_id" json:"telegram_chat_id"`
+	TopicID          int       `db:"topic_id" json:"topic_id"`
+	DiscordChannelID string    `db:"discord_channel_id" json:"discord_channel_id"` // Parent channel of the thread
+	DiscordThreadID  string    `db:"discord_thread_id" json:"discord_thread_id"`
+	TopicName        string    `db:"topic_name" json:"topic_name"`
 	CreatedAt        time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt        time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// PendingMessage is a failed delivery waiting to be retried
+type PendingMessage struct {
+	ID              int64     `db:"id" json:"id"`
+	BridgeID        string    `db:"bridge_id" json:"bridge_id"` // Connection the message is delivered through
+	TargetPlatform  string    `db:"target_platform" json:"target_platform"`
+	TargetChannelID string    `db:"target_channel_id" json:"target_channel_id"`
+	Payload         string    `db:"payload" json:"payload"` // JSON encoded bridge message
+	Media           []byte    `db:"media" json:"-"`         // Raw media of the message, not part of the payload
+	Attempts        int       `db:"attempts" json:"attempts"`
+	LastError       string    `db:"last_error" json:"last_error"`
+	NextAttemptAt   time.Time `db:"next_attempt_at" json:"next_attempt_at"`
+	CreatedAt       time.Time `db:"created_at" json:"created_at"`
 }
@@ -0,0 +1,74 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"dcbot/internal/database/models"
+)
+
+// EnqueuePendingMessage stores a failed delivery for a later retry
+func (d *Database) EnqueuePendingMessage(message *models.PendingMessage) error {
+	if message.CreatedAt.IsZero() {
+		message.CreatedAt = time.Now()
+	}
+
+	result, err := d.db.Exec(`
+		INSERT INTO pending_messages (bridge_id, target_platform, target_channel_id, payload, media, attempts, last_error, next_attempt_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		message.BridgeID, message.TargetPlatform, message.TargetChannelID, message.Payload, message.Media,
+		message.Attempts, message.LastError, message.NextAttemptAt.UTC(), message.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue pending message: %v", err)
+	}
+
+	message.ID, _ = result.LastInsertId()
+	return nil
+}
+
+// GetDuePendingMessages returns up to limit pending messages whose next attempt is due, oldest first
+func (d *Database) GetDuePendingMessages(now time.Time, limit int) ([]*models.PendingMessage, error) {
+	rows, err := d.db.Query(`
+		SELECT id, bridge_id, target_platform, target_channel_id, payload, media, attempts, last_error, next_attempt_at, created_at
+		FROM pending_messages
+		WHERE next_attempt_at <= ?
+		ORDER BY next_attempt_at
+		LIMIT ?`, now.UTC(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending messages: %v", err)
+	}
+	defer rows.Close()
+
+	var messages []*models.PendingMessage
+	for rows.Next() {
+		var message models.PendingMessage
+		if err := rows.Scan(&message.ID, &message.BridgeID, &message.TargetPlatform, &message.TargetChannelID, &message.Payload,
+			&message.Media, &message.Attempts, &message.LastError, &message.NextAttemptAt, &message.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan pending message: %v", err)
+		}
+		messages = append(messages, &message)
+	}
+
+	return messages, rows.Err()
+}
+
+// ReschedulePendingMessage records a failed retry and when the next one is due
+func (d *Database) ReschedulePendingMessage(id int64, attempts int, lastError string, nextAttemptAt time.Time) error {
+	_, err := d.db.Exec(`
+		UPDATE pending_messages
+		SET attempts = ?, last_error = ?, next_attempt_at = ?
+		WHERE id = ?`,
+		attempts, lastError, nextAttemptAt.UTC(), id)
+	if err != nil {
+		return fmt.Errorf("failed to reschedule pending message: %v", err)
+	}
+	return nil
+}
+
+// DeletePendingMessage removes a delivered or abandoned pending message
+func (d *Database) DeletePendingMessage(id int64) error {
+	if _, err := d.db.Exec("DELETE FROM pending_messages WHERE id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete pending message: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package database
+
+import "testing"
+
+func TestRoomMappingReadersIncludeGuild(t *testing.T) {
+	db := newTestDatabase(t)
+	roomID := newTestRoomMapping(t, db, "bridge", "discord", "100")
+	if err := db.SetRoomMappingGuild(roomID, "discord", "100", "900"); err != nil {
+		t.Fatalf("SetRoomMappingGuild: %v", err)
+	}
+
+	mapping, err := db.GetRoomMappingByPlatformRoom("discord", "100")
+	if err != nil {
+		t.Fatalf("GetRoomMappingByPlatformRoom: %v", err)
+	}
+	if mapping.GuildID != "900" {
+		t.Errorf("GetRoomMappingByPlatformRoom guild = %q, want %q", mapping.GuildID, "900")
+	}
+
+	mappings, err := db.GetActiveRoomMappings(roomID)
+	if err != nil {
+		t.Fatalf("GetActiveRoomMappings: %v", err)
+	}
+	if len(mappings) != 1 || mappings[0].GuildID != "900" {
+		t.Errorf("GetActiveRoomMappings = %+v, want one mapping in guild 900", mappings)
+	}
+
+	existing, err := db.CreateOrGetRoomMapping(roomID, "discord", "100", "bridge", "channel")
+	if err != nil {
+		t.Fatalf("CreateOrGetRoomMapping: %v", err)
+	}
+	if existing.GuildID != "900" {
+		t.Errorf("CreateOrGetRoomMapping guild = %q, want %q", existing.GuildID, "900")
+	}
+}
+
+func TestSetBridgeNameUpdatesRoomOfBothChannels(t *testing.T) {
+	db := newTestDatabase(t)
+	first := newTestRoomMapping(t, db, "first", "discord", "100")
+	newTestRoomMapping(t, db, "first", "telegram", "-100")
+	second := newTestRoomMapping(t, db, "second", "discord", "200")
+	newTestRoomMapping(t, db, "second", "telegram", "-200")
+
+	bridgeName := func(roomID int) string {
+		t.Helper()
+		config, err := db.CreateOrGetBridgeConfig(roomID)
+		if err != nil {
+			t.Fatalf("CreateOrGetBridgeConfig: %v", err)
+		}
+		if config.Name == nil {
+			return ""
+		}
+		return *config.Name
+	}
+	bridgeName(first)
+	bridgeName(second)
+
+	// Renaming from the Telegram side names the same bridge
+	if err := db.SetBridgeName("telegram", "-200", "discord", "200", "second bridge"); err != nil {
+		t.Fatalf("SetBridgeName: %v", err)
+	}
+	if got := bridgeName(second); got != "second bridge" {
+		t.Errorf("name of the renamed bridge = %q, want %q", got, "second bridge")
+	}
+	if got := bridgeName(first); got != "" {
+		t.Errorf("name of the other bridge = %q, want it unchanged", got)
+	}
+
+	if err := db.SetBridgeName("discord", "100", "telegram", "-200", "crossed"); err == nil {
+		t.Error("SetBridgeName of channels in different rooms succeeded")
+	}
+	if got := bridgeName(first) + bridgeName(second); got != "second bridge" {
+		t.Errorf("a failed rename changed bridge names to %q", got)
+	}
+
+	if err := db.SetBridgeName("discord", "200", "telegram", "-200", ""); err != nil {
+		t.Fatalf("SetBridgeName clearing the name: %v", err)
+	}
+	if got := bridgeName(second); got != "" {
+		t.Errorf("name after clearing = %q, want none", got)
+	}
+}
@@ -0,0 +1,90 @@
+package database
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"dcbot/internal/database/models"
+)
+
+// untilNextSnapshot returns how long to wait from now until the next midnight UTC
+func untilNextSnapshot(now time.Time) time.Duration {
+	now = now.UTC()
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+	return midnight.Sub(now)
+}
+
+// TakeSnapshot records the current row counts and file sizes of the database
+func (d *Database) TakeSnapshot() error {
+	snapshot := &models.DBSnapshot{SnapshotAt: time.Now().UTC()}
+
+	counts := []struct {
+		table string
+		count *int
+	}{
+		{"messages", &snapshot.MessagesCount},
+		{"message_mappings", &snapshot.MappingsCount},
+		{"users", &snapshot.UsersCount},
+		{"rooms", &snapshot.RoomsCount},
+	}
+	for _, c := range counts {
+		if err := d.db.QueryRow("SELECT COUNT(*) FROM " + c.table).Scan(c.count); err != nil {
+			return fmt.Errorf("failed to count rows in %s: %v", c.table, err)
+		}
+	}
+
+	// Sizes are derived from pages so they do not depend on where the files are
+	var pageSize, pageCount int64
+	if err := d.db.QueryRow("PRAGMA page_size").Scan(&pageSize); err != nil {
+		return fmt.Errorf("failed to get page size: %v", err)
+	}
+	if err := d.db.QueryRow("PRAGMA page_count").Scan(&pageCount); err != nil {
+		return fmt.Errorf("failed to get page count: %v", err)
+	}
+	walFrames, err := d.GetWALSize()
+	if err != nil {
+		return err
+	}
+	snapshot.DBSizeBytes = pageSize * pageCount
+	snapshot.WALSizeBytes = pageSize * walFrames
+
+	_, err = d.db.Exec(`
+		INSERT INTO db_snapshots (snapshot_at, messages_count, mappings_count, users_count, rooms_count, db_size_bytes, wal_size_bytes)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		snapshot.SnapshotAt, snapshot.MessagesCount, snapshot.MappingsCount, snapshot.UsersCount, snapshot.RoomsCount,
+		snapshot.DBSizeBytes, snapshot.WALSizeBytes)
+	if err != nil {
+		return fmt.Errorf("failed to save database snapshot: %v", err)
+	}
+
+	log.Printf("📸 Database snapshot: %d messages, %d mappings, %d users, %d rooms, %d bytes (WAL %d bytes)",
+		snapshot.MessagesCount, snapshot.MappingsCount, snapshot.UsersCount, snapshot.RoomsCount, snapshot.DBSizeBytes, snapshot.WALSizeBytes)
+	return nil
+}
+
+// GetSnapshotHistory returns the snapshots taken in the last days, oldest first
+func (d *Database) GetSnapshotHistory(days int) ([]*models.DBSnapshot, error) {
+	rows, err := d.db.Query(`
+		SELECT id, snapshot_at, messages_count, mappings_count, users_count, rooms_count, db_size_bytes, wal_size_bytes
+		FROM db_snapshots
+		WHERE snapshot_at >= ?
+		ORDER BY snapshot_at, id`, time.Now().UTC().AddDate(0, 0, -days))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database snapshots: %v", err)
+	}
+	defer rows.Close()
+
+	var snapshots []*models.DBSnapshot
+	for rows.Next() {
+		var snapshot models.DBSnapshot
+		err := rows.Scan(&snapshot.ID, &snapshot.SnapshotAt, &snapshot.MessagesCount, &snapshot.MappingsCount, &snapshot.UsersCount,
+			&snapshot.RoomsCount, &snapshot.DBSizeBytes, &snapshot.WALSizeBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan database snapshot: %v", err)
+		}
+		snapshots = append(snapshots, &snapshot)
+	}
+
+	return snapshots, rows.Err()
+}
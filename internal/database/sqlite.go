@@ -2,10 +2,12 @@ package database
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"dcbot/internal/database/models"
@@ -69,6 +71,18 @@ func (d *Database) migrate() error {
 		createMessagesTable,
 		createMessageMappingsTable,
 		createBridgeConfigTable,
+		createReactionsTable,
+		createAuditLogTable,
+		createUserActivityTable,
+		createPollsTable,
+		createWebhooksTable,
+		createThreadMappingsTable,
+		createPendingMessagesTable,
+		createBotSentMessagesTable,
+		createBridgeEventsTable,
+		createPendingBridgeInvitesTable,
+		createPendingAccountLinksTable,
+		createDBSnapshotsTable,
 		createIndexes,
 	}
 
@@ -78,10 +92,151 @@ func (d *Database) migrate() error {
 		}
 	}
 
+	if err := d.rebuildWebhooksTable(); err != nil {
+		return fmt.Errorf("failed to migrate webhooks table: %v", err)
+	}
+
+	for _, migration := range columnMigrations {
+		added, err := d.addColumnIfMissing(migration.table, migration.column, migration.definition)
+		if err != nil {
+			return fmt.Errorf("failed to add column %s.%s: %v", migration.table, migration.column, err)
+		}
+
+		backfill, ok := columnBackfills[migration.table+"."+migration.column]
+		if !added || !ok {
+			continue
+		}
+		if _, err := d.db.Exec(backfill); err != nil {
+			return fmt.Errorf("failed to backfill column %s.%s: %v", migration.table, migration.column, err)
+		}
+	}
+
 	log.Println("✅ Database migrations completed")
 	return nil
 }
 
+// columnMigrations adds columns introduced after the initial schema to existing databases
+var columnMigrations = []struct {
+	table      string
+	column     string
+	definition string
+}{
+	{"bridge_config", "bridge_chat_photo_changes", "BOOLEAN NOT NULL DEFAULT 0"},
+	{"bridge_config", "bridge_voice_events", "BOOLEAN NOT NULL DEFAULT 0"},
+	{"bridge_config", "normalize_emoji", "BOOLEAN NOT NULL DEFAULT 1"},
+	{"bridge_config", "name", "TEXT"},
+	{"bridge_config", "bridge_reactions", "BOOLEAN NOT NULL DEFAULT 0"},
+	{"room_mappings", "guild_id", "TEXT NOT NULL DEFAULT ''"},
+	{"bridge_config", "send_downtime_notifications", "BOOLEAN NOT NULL DEFAULT 1"},
+	{"bridge_config", "silent_mode", "BOOLEAN DEFAULT 0"},
+	{"bridge_config", "silent_hours_start", "INTEGER DEFAULT 0"},
+	{"bridge_config", "silent_hours_end", "INTEGER DEFAULT 0"},
+	{"bridge_config", "embed_color", "INTEGER NOT NULL DEFAULT 7506394"},
+	{"bridge_config", "allowed_senders", "TEXT NOT NULL DEFAULT '[]'"},
+	{"bridge_config", "bridge_voice_activity", "BOOLEAN NOT NULL DEFAULT 0"},
+	{"bridge_config", "voice_start_message", "TEXT NOT NULL DEFAULT ''"},
+	{"bridge_config", "voice_end_message", "TEXT NOT NULL DEFAULT ''"},
+	{"room_mappings", "priority", "INTEGER NOT NULL DEFAULT 0"},
+	{"bridge_config", "max_retries", "INTEGER NOT NULL DEFAULT 0"},
+	{"bridge_config", "retry_backoff_multiplier", "REAL NOT NULL DEFAULT 2"},
+	{"bridge_config", "bridge_pins", "BOOLEAN NOT NULL DEFAULT 0"},
+	{"bridge_config", "bridge_message_components", "BOOLEAN NOT NULL DEFAULT 1"},
+	{"bridge_config", "bridge_forum_tags", "BOOLEAN NOT NULL DEFAULT 1"},
+	{"bridge_config", "source_format_headers", "TEXT NOT NULL DEFAULT '{}'"},
+	{"bridge_config", "preserve_forward_metadata", "BOOLEAN NOT NULL DEFAULT 1"},
+	{"bridge_config", "rate_limit_per_minute", "INTEGER NOT NULL DEFAULT 0"},
+	{"bridge_config", "bridge_system_events", "BOOLEAN NOT NULL DEFAULT 0"},
+	{"bridge_config", "ack_with_reaction", "BOOLEAN NOT NULL DEFAULT 0"},
+	{"bridge_config", "compact_mode", "BOOLEAN NOT NULL DEFAULT 0"},
+	{"bridge_config", "compact_mode_announced", "BOOLEAN NOT NULL DEFAULT 0"},
+	{"bridge_config", "allowed_message_types", "TEXT NOT NULL DEFAULT '[]'"},
+	{"bridge_config", "delivery_delay_seconds", "INTEGER NOT NULL DEFAULT 0"},
+	{"bridge_config", "bridge_locations", "BOOLEAN NOT NULL DEFAULT 1"},
+	{"bridge_config", "max_message_length_per_user", "INTEGER NOT NULL DEFAULT 0"},
+	{"bridge_config", "user_spam_window_seconds", "INTEGER NOT NULL DEFAULT 60"},
+	{"bridge_config", "bridge_sticker_info", "BOOLEAN NOT NULL DEFAULT 1"},
+	{"bridge_config", "sync_topics", "BOOLEAN NOT NULL DEFAULT 0"},
+	{"bridge_config", "sync_admin_roles", "BOOLEAN NOT NULL DEFAULT 0"},
+	{"bridge_config", "admin_role_id", "TEXT NOT NULL DEFAULT ''"},
+}
+
+// columnBackfills fill a column from older settings when it is added to an existing database, keyed by table.column
+var columnBackfills = map[string]string{
+	// Bridges that blocked media keep bridging text only
+	"bridge_config.allowed_message_types": `UPDATE bridge_config SET allowed_message_types = '["text"]' WHERE allow_media = 0`,
+}
+
+// rebuildWebhooksTable moves webhooks from the old table keyed by channel into one allowing a pool of webhooks per channel
+func (d *Database) rebuildWebhooksTable() error {
+	var primaryKey int
+	err := d.db.QueryRow("SELECT pk FROM pragma_table_info('webhooks') WHERE name = 'channel_id'").Scan(&primaryKey)
+	if err != nil {
+		return err
+	}
+	if primaryKey == 0 {
+		return nil
+	}
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	statements := []string{
+		"ALTER TABLE webhooks RENAME TO webhooks_per_channel",
+		createWebhooksTable,
+		"INSERT INTO webhooks (webhook_id, channel_id, token, created_at) SELECT webhook_id, channel_id, token, created_at FROM webhooks_per_channel",
+		"DROP TABLE webhooks_per_channel",
+		"CREATE INDEX IF NOT EXISTS idx_webhooks_channel_id ON webhooks(channel_id)",
+	}
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	log.Println("✅ Webhooks table migrated to webhook pools")
+	return nil
+}
+
+// addColumnIfMissing adds a column to a table unless it already exists, reporting whether it was added
+func (d *Database) addColumnIfMissing(table, column, definition string) (bool, error) {
+	rows, err := d.db.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			cid        int
+			name       string
+			columnType string
+			notNull    int
+			defaultVal sql.NullString
+			primaryKey int
+		)
+		if err := rows.Scan(&cid, &name, &columnType, &notNull, &defaultVal, &primaryKey); err != nil {
+			return false, err
+		}
+		if name == column {
+			return false, nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return false, err
+	}
+
+	if _, err := d.db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // Migration SQL statements
 const createUsersTable = `
 CREATE TABLE IF NOT EXISTS users (
@@ -168,7 +323,7 @@ CREATE TABLE IF NOT EXISTS bridge_config (
     id INTEGER PRIMARY KEY AUTOINCREMENT,
     room_id INTEGER NOT NULL,
     is_active BOOLEAN NOT NULL DEFAULT 1,
-    allow_media BOOLEAN NOT NULL DEFAULT 1,
+    allow_media BOOLEAN NOT NULL DEFAULT 1, -- Replaced by allowed_message_types, read once when that column is added
     allow_edits BOOLEAN NOT NULL DEFAULT 1,
     allow_deletes BOOLEAN NOT NULL DEFAULT 1,
     filter_words TEXT NOT NULL DEFAULT '[]',
@@ -179,12 +334,157 @@ CREATE TABLE IF NOT EXISTS bridge_config (
     UNIQUE(room_id)
 );`
 
+const createReactionsTable = `
+CREATE TABLE IF NOT EXISTS reactions (
+    discord_msg_id TEXT PRIMARY KEY,
+    reactions_json TEXT NOT NULL DEFAULT '{}',
+    telegram_msg_id TEXT NOT NULL,
+    telegram_chat_id TEXT NOT NULL
+);`
+
+const createAuditLogTable = `
+CREATE TABLE IF NOT EXISTS audit_log (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    action TEXT NOT NULL,
+    actor_platform TEXT NOT NULL DEFAULT '',
+    actor_id TEXT NOT NULL DEFAULT '',
+    target TEXT NOT NULL DEFAULT '',
+    details TEXT NOT NULL DEFAULT '',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);`
+
+const createUserActivityTable = `
+CREATE TABLE IF NOT EXISTS user_activity (
+    platform TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    bridge_id TEXT NOT NULL,
+    message_count INTEGER NOT NULL DEFAULT 0,
+    last_active DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (platform, user_id, bridge_id)
+);`
+
+const createWebhooksTable = `
+CREATE TABLE IF NOT EXISTS webhooks (
+    webhook_id TEXT PRIMARY KEY,
+    channel_id TEXT NOT NULL,
+    token TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);`
+
+const createThreadMappingsTable = `
+CREATE TABLE IF NOT EXISTS thread_mappings (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    telegram_chat_id TEXT NOT NULL,
+    topic_id INTEGER NOT NULL,
+    discord_channel_id TEXT NOT NULL,
+    discord_thread_id TEXT NOT NULL,
+    topic_name TEXT NOT NULL DEFAULT '',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(telegram_chat_id, topic_id, discord_channel_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_thread_mappings_discord_thread ON thread_mappings(discord_thread_id);`
+
+const createPendingMessagesTable = `
+CREATE TABLE IF NOT EXISTS pending_messages (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    bridge_id TEXT NOT NULL,
+    target_platform TEXT NOT NULL,
+    target_channel_id TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    media BLOB,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    next_attempt_at DATETIME NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);`
+
+const createBotSentMessagesTable = `
+CREATE TABLE IF NOT EXISTS bot_sent_messages (
+    platform TEXT NOT NULL,
+    message_id TEXT NOT NULL,
+    sent_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (platform, message_id)
+);`
+
+const createBridgeEventsTable = `
+CREATE TABLE IF NOT EXISTS bridge_events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    event_type TEXT NOT NULL,
+    bridge_id TEXT NOT NULL,
+    source_platform TEXT NOT NULL DEFAULT '',
+    source_channel_id TEXT NOT NULL DEFAULT '',
+    source_msg_id TEXT NOT NULL DEFAULT '',
+    target_platform TEXT NOT NULL DEFAULT '',
+    target_channel_id TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL,
+    error_msg TEXT NOT NULL DEFAULT '',
+    processing_time_ms INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);`
+
+const createPendingBridgeInvitesTable = `
+CREATE TABLE IF NOT EXISTS pending_bridge_invites (
+    code TEXT PRIMARY KEY,
+    chat_id TEXT NOT NULL,
+    chat_title TEXT NOT NULL DEFAULT '',
+    created_by TEXT NOT NULL DEFAULT '',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    expires_at DATETIME NOT NULL
+);`
+
+const createPendingAccountLinksTable = `
+CREATE TABLE IF NOT EXISTS pending_account_links (
+    code TEXT PRIMARY KEY,
+    platform_user_id TEXT NOT NULL,
+    username TEXT NOT NULL DEFAULT '',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    expires_at DATETIME NOT NULL
+);`
+
+const createDBSnapshotsTable = `
+CREATE TABLE IF NOT EXISTS db_snapshots (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    snapshot_at DATETIME NOT NULL,
+    messages_count INTEGER NOT NULL DEFAULT 0,
+    mappings_count INTEGER NOT NULL DEFAULT 0,
+    users_count INTEGER NOT NULL DEFAULT 0,
+    rooms_count INTEGER NOT NULL DEFAULT 0,
+    db_size_bytes INTEGER NOT NULL DEFAULT 0,
+    wal_size_bytes INTEGER NOT NULL DEFAULT 0
+);`
+
+const createPollsTable = `
+CREATE TABLE IF NOT EXISTS polls (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    discord_channel_id TEXT NOT NULL,
+    telegram_chat_id TEXT NOT NULL,
+    telegram_msg_id TEXT NOT NULL,
+    telegram_poll_id TEXT NOT NULL UNIQUE,
+    question TEXT NOT NULL,
+    options_json TEXT NOT NULL DEFAULT '[]',
+    results_json TEXT NOT NULL DEFAULT '[]',
+    total_voters INTEGER NOT NULL DEFAULT 0,
+    is_anonymous BOOLEAN NOT NULL DEFAULT 1,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);`
+
 const createIndexes = `
 CREATE INDEX IF NOT EXISTS idx_user_mappings_platform_user_id ON user_mappings(platform, platform_user_id);
 CREATE INDEX IF NOT EXISTS idx_room_mappings_platform_room_id ON room_mappings(platform, platform_room_id);
 CREATE INDEX IF NOT EXISTS idx_messages_source ON messages(source_platform, source_room_id);
 CREATE INDEX IF NOT EXISTS idx_message_mappings_platform ON message_mappings(platform, platform_msg_id);
 CREATE INDEX IF NOT EXISTS idx_messages_created_at ON messages(created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_log_created_at ON audit_log(created_at);
+CREATE INDEX IF NOT EXISTS idx_user_activity_bridge_id ON user_activity(bridge_id, message_count);
+CREATE INDEX IF NOT EXISTS idx_pending_messages_bridge_next ON pending_messages(bridge_id, next_attempt_at);
+CREATE INDEX IF NOT EXISTS idx_bot_sent_messages_sent_at ON bot_sent_messages(sent_at);
+CREATE INDEX IF NOT EXISTS idx_bridge_events_bridge_created ON bridge_events(bridge_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_bridge_events_created_at ON bridge_events(created_at);
+CREATE INDEX IF NOT EXISTS idx_pending_bridge_invites_expires_at ON pending_bridge_invites(expires_at);
+CREATE INDEX IF NOT EXISTS idx_pending_account_links_expires_at ON pending_account_links(expires_at);
+CREATE INDEX IF NOT EXISTS idx_webhooks_channel_id ON webhooks(channel_id);
+CREATE INDEX IF NOT EXISTS idx_db_snapshots_snapshot_at ON db_snapshots(snapshot_at);
 `
 
 // Bridge persistence methods
@@ -231,12 +531,12 @@ func (d *Database) CreateOrGetRoomMapping(roomID int, platform, platformRoomID,
 	// First try to get existing mapping
 	var mapping models.RoomMapping
 	err := d.db.QueryRow(`
-		SELECT id, room_id, platform, platform_room_id, room_name, room_type, is_active, created_at, updated_at 
+		SELECT id, room_id, platform, platform_room_id, room_name, room_type, guild_id, is_active, created_at, updated_at 
 		FROM room_mappings 
 		WHERE room_id = ? AND platform = ? AND platform_room_id = ?`,
 		roomID, platform, platformRoomID).
 		Scan(&mapping.ID, &mapping.RoomID, &mapping.Platform, &mapping.PlatformRoomID, 
-			&mapping.RoomName, &mapping.RoomType, &mapping.IsActive, &mapping.CreatedAt, &mapping.UpdatedAt)
+			&mapping.RoomName, &mapping.RoomType, &mapping.GuildID, &mapping.IsActive, &mapping.CreatedAt, &mapping.UpdatedAt)
 	
 	if err == nil {
 		// Update existing mapping if needed
@@ -291,7 +591,7 @@ func (d *Database) CreateOrGetRoomMapping(roomID int, platform, platformRoomID,
 // GetActiveRoomMappings returns all active room mappings for a room
 func (d *Database) GetActiveRoomMappings(roomID int) ([]*models.RoomMapping, error) {
 	rows, err := d.db.Query(`
-		SELECT id, room_id, platform, platform_room_id, room_name, room_type, is_active, created_at, updated_at 
+		SELECT id, room_id, platform, platform_room_id, room_name, room_type, guild_id, is_active, created_at, updated_at 
 		FROM room_mappings 
 		WHERE room_id = ? AND is_active = 1`,
 		roomID)
@@ -304,7 +604,7 @@ func (d *Database) GetActiveRoomMappings(roomID int) ([]*models.RoomMapping, err
 	for rows.Next() {
 		var mapping models.RoomMapping
 		err := rows.Scan(&mapping.ID, &mapping.RoomID, &mapping.Platform, &mapping.PlatformRoomID,
-			&mapping.RoomName, &mapping.RoomType, &mapping.IsActive, &mapping.CreatedAt, &mapping.UpdatedAt)
+			&mapping.RoomName, &mapping.RoomType, &mapping.GuildID, &mapping.IsActive, &mapping.CreatedAt, &mapping.UpdatedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan room mapping: %v", err)
 		}
@@ -318,12 +618,12 @@ func (d *Database) GetActiveRoomMappings(roomID int) ([]*models.RoomMapping, err
 func (d *Database) GetRoomMappingByPlatformRoom(platform, platformRoomID string) (*models.RoomMapping, error) {
 	var mapping models.RoomMapping
 	err := d.db.QueryRow(`
-		SELECT id, room_id, platform, platform_room_id, room_name, room_type, is_active, created_at, updated_at 
+		SELECT id, room_id, platform, platform_room_id, room_name, room_type, guild_id, is_active, created_at, updated_at 
 		FROM room_mappings 
 		WHERE platform = ? AND platform_room_id = ? AND is_active = 1`,
 		platform, platformRoomID).
 		Scan(&mapping.ID, &mapping.RoomID, &mapping.Platform, &mapping.PlatformRoomID,
-			&mapping.RoomName, &mapping.RoomType, &mapping.IsActive, &mapping.CreatedAt, &mapping.UpdatedAt)
+			&mapping.RoomName, &mapping.RoomType, &mapping.GuildID, &mapping.IsActive, &mapping.CreatedAt, &mapping.UpdatedAt)
 	
 	if err != nil {
 		return nil, err
@@ -332,6 +632,97 @@ func (d *Database) GetRoomMappingByPlatformRoom(platform, platformRoomID string)
 	return &mapping, nil
 }
 
+// SetRoomMappingGuild records the Discord server a mapped channel belongs to
+func (d *Database) SetRoomMappingGuild(roomID int, platform, platformRoomID, guildID string) error {
+	_, err := d.db.Exec(`
+		UPDATE room_mappings 
+		SET guild_id = ?, updated_at = ? 
+		WHERE room_id = ? AND platform = ? AND platform_room_id = ?`,
+		guildID, time.Now(), roomID, platform, platformRoomID)
+	if err != nil {
+		return fmt.Errorf("failed to set room mapping guild: %v", err)
+	}
+	return nil
+}
+
+// SetRoomMappingPriority sets the delivery priority of bridges into a mapped channel
+func (d *Database) SetRoomMappingPriority(platform, platformRoomID string, priority int) error {
+	result, err := d.db.Exec(`
+		UPDATE room_mappings 
+		SET priority = ?, updated_at = ? 
+		WHERE platform = ? AND platform_room_id = ?`,
+		priority, time.Now(), platform, platformRoomID)
+	if err != nil {
+		return fmt.Errorf("failed to set room mapping priority: %v", err)
+	}
+
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return fmt.Errorf("no room mapping for %s channel %s", platform, platformRoomID)
+	}
+	return nil
+}
+
+// MigrateRoomMappings moves every room mapping of a platform channel to a new channel ID.
+// connectionIDs and bridgeIDs map the old connection and bridge IDs of the channel to their new ones,
+// so queued messages, bridge events and user activity follow the bridges.
+func (d *Database) MigrateRoomMappings(platform, oldPlatformRoomID, newPlatformRoomID string, connectionIDs, bridgeIDs map[string]string) (int64, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var active int
+	err = tx.QueryRow("SELECT COUNT(*) FROM room_mappings WHERE platform = ? AND platform_room_id = ? AND is_active = 1",
+		platform, newPlatformRoomID).Scan(&active)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check room mappings: %v", err)
+	}
+	if active > 0 {
+		return 0, fmt.Errorf("%s channel %s is already bridged", platform, newPlatformRoomID)
+	}
+
+	// Removed bridges keep their deactivated mapping, which would block the channel ID
+	if _, err := tx.Exec("DELETE FROM room_mappings WHERE platform = ? AND platform_room_id = ? AND is_active = 0",
+		platform, newPlatformRoomID); err != nil {
+		return 0, fmt.Errorf("failed to delete inactive room mapping: %v", err)
+	}
+
+	result, err := tx.Exec(`
+		UPDATE room_mappings 
+		SET platform_room_id = ?, updated_at = ? 
+		WHERE platform = ? AND platform_room_id = ?`,
+		newPlatformRoomID, time.Now(), platform, oldPlatformRoomID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to migrate room mappings: %v", err)
+	}
+	rows, _ := result.RowsAffected()
+
+	if _, err := tx.Exec("UPDATE pending_messages SET target_channel_id = ? WHERE target_platform = ? AND target_channel_id = ?",
+		newPlatformRoomID, platform, oldPlatformRoomID); err != nil {
+		return 0, fmt.Errorf("failed to migrate pending messages: %v", err)
+	}
+	for oldID, newID := range connectionIDs {
+		if _, err := tx.Exec("UPDATE pending_messages SET bridge_id = ? WHERE bridge_id = ?", newID, oldID); err != nil {
+			return 0, fmt.Errorf("failed to migrate pending messages: %v", err)
+		}
+		if _, err := tx.Exec("UPDATE bridge_events SET bridge_id = ? WHERE bridge_id = ?", newID, oldID); err != nil {
+			return 0, fmt.Errorf("failed to migrate bridge events: %v", err)
+		}
+	}
+	for oldID, newID := range bridgeIDs {
+		// Activity left by an earlier bridge with the new ID is replaced
+		if _, err := tx.Exec("UPDATE OR REPLACE user_activity SET bridge_id = ? WHERE bridge_id = ?", newID, oldID); err != nil {
+			return 0, fmt.Errorf("failed to migrate user activity: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit room mapping migration: %v", err)
+	}
+	return rows, nil
+}
+
 // RemoveRoomMapping deactivates a room mapping
 func (d *Database) RemoveRoomMapping(roomID int, platform string) error {
 	_, err := d.db.Exec(`
@@ -342,61 +733,214 @@ func (d *Database) RemoveRoomMapping(roomID int, platform string) error {
 	return err
 }
 
-// CreateOrGetBridgeConfig creates or gets bridge configuration for a room
-func (d *Database) CreateOrGetBridgeConfig(roomID int) (*models.BridgeConfig, error) {
-	// First try to get existing config
+// bridgeConfigColumns lists the bridge_config columns in the order scanBridgeConfig expects
+const bridgeConfigColumns = `id, room_id, is_active, allowed_message_types, allow_edits, allow_deletes, filter_words, max_message_length,
+	bridge_chat_photo_changes, bridge_voice_events, normalize_emoji, name, bridge_reactions, send_downtime_notifications, silent_mode, silent_hours_start, silent_hours_end, embed_color, allowed_senders, bridge_voice_activity, voice_start_message, voice_end_message, max_retries, retry_backoff_multiplier, bridge_pins, bridge_message_components, bridge_forum_tags, source_format_headers, preserve_forward_metadata, rate_limit_per_minute, bridge_system_events, ack_with_reaction, compact_mode, compact_mode_announced, delivery_delay_seconds, bridge_locations, max_message_length_per_user, user_spam_window_seconds, bridge_sticker_info, sync_topics, sync_admin_roles, admin_role_id, created_at, updated_at`
+
+// rowScanner is implemented by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanBridgeConfig scans a bridge_config row selected with bridgeConfigColumns
+func scanBridgeConfig(row rowScanner) (*models.BridgeConfig, error) {
 	var config models.BridgeConfig
-	err := d.db.QueryRow(`
-		SELECT id, room_id, is_active, allow_media, allow_edits, allow_deletes, filter_words, max_message_length, created_at, updated_at 
+	err := row.Scan(&config.ID, &config.RoomID, &config.IsActive, &config.AllowedMessageTypes, &config.AllowEdits,
+		&config.AllowDeletes, &config.FilterWords, &config.MaxMessageLength,
+		&config.BridgeChatPhotoChanges, &config.BridgeVoiceEvents, &config.NormalizeEmoji, &config.Name, &config.BridgeReactions, &config.SendDowntimeNotifications, &config.SilentMode, &config.SilentHoursStart, &config.SilentHoursEnd, &config.EmbedColor, &config.AllowedSenders, &config.BridgeVoiceActivity, &config.VoiceStartMessage, &config.VoiceEndMessage, &config.MaxRetries, &config.RetryBackoffMultiplier, &config.BridgePins, &config.BridgeMessageComponents, &config.BridgeForumTags, &config.SourceFormatHeaders, &config.PreserveForwardMetadata, &config.RateLimitPerMinute, &config.BridgeSystemEvents, &config.AckWithReaction, &config.CompactMode, &config.CompactModeAnnounced, &config.DeliveryDelaySeconds, &config.BridgeLocations, &config.MaxMessageLengthPerUser, &config.UserSpamWindowSeconds, &config.BridgeStickerInfo, &config.SyncTopics, &config.SyncAdminRoles, &config.AdminRoleID, &config.CreatedAt, &config.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// bridgeConfigValues returns a config's values in bridgeConfigColumns order
+func bridgeConfigValues(config *models.BridgeConfig) []interface{} {
+	return []interface{}{config.ID, config.RoomID, config.IsActive, config.AllowedMessageTypes, config.AllowEdits,
+		config.AllowDeletes, config.FilterWords, config.MaxMessageLength,
+		config.BridgeChatPhotoChanges, config.BridgeVoiceEvents, config.NormalizeEmoji, config.Name, config.BridgeReactions, config.SendDowntimeNotifications, config.SilentMode, config.SilentHoursStart, config.SilentHoursEnd, config.EmbedColor, config.AllowedSenders, config.BridgeVoiceActivity, config.VoiceStartMessage, config.VoiceEndMessage, config.MaxRetries, config.RetryBackoffMultiplier, config.BridgePins, config.BridgeMessageComponents, config.BridgeForumTags, config.SourceFormatHeaders, config.PreserveForwardMetadata, config.RateLimitPerMinute, config.BridgeSystemEvents, config.AckWithReaction, config.CompactMode, config.CompactModeAnnounced, config.DeliveryDelaySeconds, config.BridgeLocations, config.MaxMessageLengthPerUser, config.UserSpamWindowSeconds, config.BridgeStickerInfo, config.SyncTopics, config.SyncAdminRoles, config.AdminRoleID, config.CreatedAt, config.UpdatedAt}
+}
+
+// GetBridgeConfig returns the bridge configuration for a room
+func (d *Database) GetBridgeConfig(roomID int) (*models.BridgeConfig, error) {
+	return scanBridgeConfig(d.db.QueryRow(`
+		SELECT `+bridgeConfigColumns+` 
 		FROM bridge_config 
 		WHERE room_id = ?`,
-		roomID).
-		Scan(&config.ID, &config.RoomID, &config.IsActive, &config.AllowMedia, &config.AllowEdits,
-			&config.AllowDeletes, &config.FilterWords, &config.MaxMessageLength, &config.CreatedAt, &config.UpdatedAt)
-	
+		roomID))
+}
+
+// CreateOrGetBridgeConfig creates or gets bridge configuration for a room
+func (d *Database) CreateOrGetBridgeConfig(roomID int) (*models.BridgeConfig, error) {
+	// First try to get existing config
+	config, err := d.GetBridgeConfig(roomID)
 	if err == nil {
-		return &config, nil
+		return config, nil
 	}
 	
 	if err != sql.ErrNoRows {
 		return nil, fmt.Errorf("failed to query bridge config: %v", err)
 	}
 
-	// Create new config with defaults
-	result, err := d.db.Exec(`
-		INSERT INTO bridge_config (room_id, is_active, allow_media, allow_edits, allow_deletes, filter_words, max_message_length, created_at, updated_at) 
-		VALUES (?, 1, 1, 1, 1, '[]', 4000, ?, ?)`,
+	// Create new config, column defaults provide the initial settings
+	_, err = d.db.Exec(`
+		INSERT INTO bridge_config (room_id, created_at, updated_at) 
+		VALUES (?, ?, ?)`,
 		roomID, time.Now(), time.Now())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create bridge config: %v", err)
 	}
 
-	id, err := result.LastInsertId()
+	config, err = d.GetBridgeConfig(roomID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get bridge config ID: %v", err)
+		return nil, fmt.Errorf("failed to load created bridge config: %v", err)
 	}
 
-	config = models.BridgeConfig{
-		ID:               int(id),
-		RoomID:           roomID,
-		IsActive:         true,
-		AllowMedia:       true,
-		AllowEdits:       true,
-		AllowDeletes:     true,
-		FilterWords:      "[]",
-		MaxMessageLength: 4000,
-		CreatedAt:        time.Now(),
-		UpdatedAt:        time.Now(),
+	return config, nil
+}
+
+// UpdateBridgeEmbedColor sets the Discord embed color of a room's bridge configuration
+func (d *Database) UpdateBridgeEmbedColor(roomID, color int) error {
+	result, err := d.db.Exec(`
+		UPDATE bridge_config 
+		SET embed_color = ?, updated_at = ? 
+		WHERE room_id = ?`,
+		color, time.Now(), roomID)
+	if err != nil {
+		return fmt.Errorf("failed to update embed color: %v", err)
 	}
 
-	return &config, nil
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check updated bridge config: %v", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("bridge config for room %d not found", roomID)
+	}
+	return nil
+}
+
+// UpdateFilterRules replaces the content filter rules for a room's bridge configuration
+func (d *Database) UpdateFilterRules(roomID int, rules []models.FilterRule) error {
+	if rules == nil {
+		rules = []models.FilterRule{}
+	}
+
+	data, err := json.Marshal(rules)
+	if err != nil {
+		return fmt.Errorf("failed to marshal filter rules: %v", err)
+	}
+
+	result, err := d.db.Exec(`
+		UPDATE bridge_config 
+		SET filter_words = ?, updated_at = ? 
+		WHERE room_id = ?`,
+		string(data), time.Now(), roomID)
+	if err != nil {
+		return fmt.Errorf("failed to update filter rules: %v", err)
+	}
+
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return fmt.Errorf("bridge config not found for room %d", roomID)
+	}
+
+	return nil
+}
+
+// UpdateBridgeConfig writes all settings of a bridge configuration back to the database
+func (d *Database) UpdateBridgeConfig(config *models.BridgeConfig) error {
+	columns := strings.Split(bridgeConfigColumns, ",")
+	values := bridgeConfigValues(config)
+
+	var assignments []string
+	var args []interface{}
+	for index, column := range columns {
+		switch column = strings.TrimSpace(column); column {
+		case "id", "room_id", "created_at":
+			continue
+		case "updated_at":
+			args = append(args, time.Now())
+		default:
+			args = append(args, values[index])
+		}
+		assignments = append(assignments, column+" = ?")
+	}
+	args = append(args, config.ID)
+
+	result, err := d.db.Exec("UPDATE bridge_config SET "+strings.Join(assignments, ", ")+" WHERE id = ?", args...)
+	if err != nil {
+		return fmt.Errorf("failed to update bridge config: %v", err)
+	}
+
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return fmt.Errorf("bridge config %d not found", config.ID)
+	}
+
+	return nil
+}
+
+// SetBridgeActive pauses or resumes a room's bridge
+func (d *Database) SetBridgeActive(roomID int, active bool) error {
+	result, err := d.db.Exec(`
+		UPDATE bridge_config 
+		SET is_active = ?, updated_at = ? 
+		WHERE room_id = ?`,
+		active, time.Now(), roomID)
+	if err != nil {
+		return fmt.Errorf("failed to update bridge state: %v", err)
+	}
+
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return fmt.Errorf("bridge config not found for room %d", roomID)
+	}
+
+	return nil
+}
+
+// SetBridgeName sets the name of the bridge between two channels, stored on the room mapping both of them.
+// An empty name clears it.
+func (d *Database) SetBridgeName(sourcePlatform, sourceChannelID, targetPlatform, targetChannelID, name string) error {
+	var roomID int
+	err := d.db.QueryRow(`
+		SELECT source.room_id
+		FROM room_mappings source
+		INNER JOIN room_mappings target ON target.room_id = source.room_id
+		WHERE source.platform = ? AND source.platform_room_id = ? AND source.is_active = 1
+			AND target.platform = ? AND target.platform_room_id = ? AND target.is_active = 1`,
+		sourcePlatform, sourceChannelID, targetPlatform, targetChannelID).Scan(&roomID)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("no bridge between %s channel %s and %s channel %s", sourcePlatform, sourceChannelID, targetPlatform, targetChannelID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find bridge room: %v", err)
+	}
+
+	var value interface{}
+	if name != "" {
+		value = name
+	}
+
+	result, err := d.db.Exec(`
+		UPDATE bridge_config 
+		SET name = ?, updated_at = ? 
+		WHERE room_id = ?`,
+		value, time.Now(), roomID)
+	if err != nil {
+		return fmt.Errorf("failed to update bridge name: %v", err)
+	}
+
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return fmt.Errorf("bridge config not found for room %d", roomID)
+	}
+
+	return nil
 }
 
 // GetAllActiveBridges returns all active bridge configurations with room mappings
 func (d *Database) GetAllActiveBridges() (map[string][]*models.RoomMapping, error) {
 	rows, err := d.db.Query(`
 		SELECT rm.platform, rm.platform_room_id, rm.room_id, rm.room_name, rm.room_type,
-			   rm.created_at, rm.updated_at
+			   rm.guild_id, rm.priority, rm.created_at, rm.updated_at
 		FROM room_mappings rm
 		INNER JOIN bridge_config bc ON rm.room_id = bc.room_id
 		WHERE rm.is_active = 1 AND bc.is_active = 1
@@ -411,7 +955,7 @@ func (d *Database) GetAllActiveBridges() (map[string][]*models.RoomMapping, erro
 	for rows.Next() {
 		var mapping models.RoomMapping
 		err := rows.Scan(&mapping.Platform, &mapping.PlatformRoomID, &mapping.RoomID,
-			&mapping.RoomName, &mapping.RoomType, &mapping.CreatedAt, &mapping.UpdatedAt)
+			&mapping.RoomName, &mapping.RoomType, &mapping.GuildID, &mapping.Priority, &mapping.CreatedAt, &mapping.UpdatedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan bridge mapping: %v", err)
 		}
@@ -422,3 +966,516 @@ func (d *Database) GetAllActiveBridges() (map[string][]*models.RoomMapping, erro
 
 	return bridges, nil
 }
+
+// SaveMessageMapping records that a source message was delivered to another platform
+func (d *Database) SaveMessageMapping(message *models.Message, mapping *models.MessageMapping) error {
+	now := time.Now()
+	_, err := d.db.Exec(`
+		INSERT OR IGNORE INTO messages (original_id, source_platform, source_room_id, source_user_id, content, message_type, created_at, updated_at) 
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		message.OriginalID, message.SourcePlatform, message.SourceRoomID, message.SourceUserID,
+		message.Content, message.MessageType, now, now)
+	if err != nil {
+		return fmt.Errorf("failed to save message: %v", err)
+	}
+
+	err = d.db.QueryRow("SELECT id FROM messages WHERE source_platform = ? AND original_id = ?",
+		message.SourcePlatform, message.OriginalID).Scan(&message.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get message ID: %v", err)
+	}
+
+	mapping.MessageID = message.ID
+	_, err = d.db.Exec(`
+		INSERT OR REPLACE INTO message_mappings (message_id, platform, platform_msg_id, platform_room_id, status, created_at, updated_at) 
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		mapping.MessageID, mapping.Platform, mapping.PlatformMsgID, mapping.PlatformRoomID, mapping.Status, now, now)
+	if err != nil {
+		return fmt.Errorf("failed to save message mapping: %v", err)
+	}
+
+	return nil
+}
+
+// GetMessageMapping returns a source message and where it was delivered on the target platform
+func (d *Database) GetMessageMapping(sourcePlatform, originalID, targetPlatform string) (*models.Message, *models.MessageMapping, error) {
+	var message models.Message
+	var mapping models.MessageMapping
+	err := d.db.QueryRow(`
+		SELECT m.id, m.original_id, m.source_platform, m.source_room_id, m.source_user_id, m.content, m.message_type,
+			mm.id, mm.message_id, mm.platform, mm.platform_msg_id, mm.platform_room_id, mm.status
+		FROM messages m
+		JOIN message_mappings mm ON mm.message_id = m.id
+		WHERE m.source_platform = ? AND m.original_id = ? AND mm.platform = ?`,
+		sourcePlatform, originalID, targetPlatform).
+		Scan(&message.ID, &message.OriginalID, &message.SourcePlatform, &message.SourceRoomID, &message.SourceUserID,
+			&message.Content, &message.MessageType,
+			&mapping.ID, &mapping.MessageID, &mapping.Platform, &mapping.PlatformMsgID, &mapping.PlatformRoomID, &mapping.Status)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &message, &mapping, nil
+}
+
+// IsMessageDelivered reports whether a source message has a sent copy in a channel of the target platform
+func (d *Database) IsMessageDelivered(sourcePlatform, originalID, platform, platformRoomID string) (bool, error) {
+	var count int
+	err := d.db.QueryRow(`
+		SELECT COUNT(*)
+		FROM messages m
+		JOIN message_mappings mm ON mm.message_id = m.id
+		WHERE m.source_platform = ? AND m.original_id = ? AND mm.platform = ? AND mm.platform_room_id = ? AND mm.status = 'sent'`,
+		sourcePlatform, originalID, platform, platformRoomID).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check message delivery: %v", err)
+	}
+	return count > 0, nil
+}
+
+// UpdateMessageContent replaces the stored content of a source message
+func (d *Database) UpdateMessageContent(sourcePlatform, originalID, content string) error {
+	_, err := d.db.Exec(`
+		UPDATE messages 
+		SET content = ?, updated_at = ? 
+		WHERE source_platform = ? AND original_id = ?`,
+		content, time.Now(), sourcePlatform, originalID)
+	if err != nil {
+		return fmt.Errorf("failed to update message content: %v", err)
+	}
+	return nil
+}
+
+// GetMessageByMapping returns the source message of a delivered copy, or nil if the copy is unknown
+func (d *Database) GetMessageByMapping(platform, platformMsgID, platformRoomID string) (*models.Message, error) {
+	var message models.Message
+	err := d.db.QueryRow(`
+		SELECT m.id, m.original_id, m.source_platform, m.source_room_id, m.source_user_id, m.content, m.message_type
+		FROM messages m
+		JOIN message_mappings mm ON mm.message_id = m.id
+		WHERE mm.platform = ? AND mm.platform_msg_id = ? AND mm.platform_room_id = ?`,
+		platform, platformMsgID, platformRoomID).
+		Scan(&message.ID, &message.OriginalID, &message.SourcePlatform, &message.SourceRoomID, &message.SourceUserID,
+			&message.Content, &message.MessageType)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message by mapping: %v", err)
+	}
+
+	return &message, nil
+}
+
+// GetReaction returns the tracked reactions of a Discord message, or nil if none are tracked
+func (d *Database) GetReaction(discordMsgID string) (*models.Reaction, error) {
+	var reaction models.Reaction
+	err := d.db.QueryRow(`
+		SELECT discord_msg_id, reactions_json, telegram_msg_id, telegram_chat_id 
+		FROM reactions 
+		WHERE discord_msg_id = ?`, discordMsgID).
+		Scan(&reaction.DiscordMsgID, &reaction.ReactionsJSON, &reaction.TelegramMsgID, &reaction.TelegramChatID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reactions: %v", err)
+	}
+
+	return &reaction, nil
+}
+
+// SaveReaction creates or replaces the tracked reactions of a Discord message
+func (d *Database) SaveReaction(reaction *models.Reaction) error {
+	_, err := d.db.Exec(`
+		INSERT OR REPLACE INTO reactions (discord_msg_id, reactions_json, telegram_msg_id, telegram_chat_id) 
+		VALUES (?, ?, ?, ?)`,
+		reaction.DiscordMsgID, reaction.ReactionsJSON, reaction.TelegramMsgID, reaction.TelegramChatID)
+	if err != nil {
+		return fmt.Errorf("failed to save reactions: %v", err)
+	}
+	return nil
+}
+
+// AddAuditLog records an administrative action
+func (d *Database) AddAuditLog(entry *models.AuditLog) error {
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+
+	result, err := d.db.Exec(`
+		INSERT INTO audit_log (action, actor_platform, actor_id, target, details, created_at) 
+		VALUES (?, ?, ?, ?, ?, ?)`,
+		entry.Action, entry.ActorPlatform, entry.ActorID, entry.Target, entry.Details, entry.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to add audit log entry: %v", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get audit log ID: %v", err)
+	}
+	entry.ID = int(id)
+	return nil
+}
+
+// GetAuditLog returns audit log entries, newest first, optionally filtered by actor platform and action
+func (d *Database) GetAuditLog(limit, offset int, platform, action string) ([]*models.AuditLog, error) {
+	query := `
+		SELECT id, action, actor_platform, actor_id, target, details, created_at 
+		FROM audit_log 
+		WHERE 1 = 1`
+	var args []interface{}
+	if platform != "" {
+		query += " AND actor_platform = ?"
+		args = append(args, platform)
+	}
+	if action != "" {
+		query += " AND action = ?"
+		args = append(args, action)
+	}
+	query += " ORDER BY created_at DESC, id DESC LIMIT ? OFFSET ?"
+	args = append(args, limit, offset)
+
+	rows, err := d.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit log: %v", err)
+	}
+	defer rows.Close()
+
+	var entries []*models.AuditLog
+	for rows.Next() {
+		var entry models.AuditLog
+		if err := rows.Scan(&entry.ID, &entry.Action, &entry.ActorPlatform, &entry.ActorID, &entry.Target, &entry.Details, &entry.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan audit log entry: %v", err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// IncrementUserActivity counts one more message by a user on a bridge
+func (d *Database) IncrementUserActivity(platform, userID, bridgeID string) error {
+	_, err := d.db.Exec(`
+		INSERT INTO user_activity (platform, user_id, bridge_id, message_count, last_active) 
+		VALUES (?, ?, ?, 1, ?) 
+		ON CONFLICT(platform, user_id, bridge_id) DO UPDATE SET 
+			message_count = message_count + 1, last_active = excluded.last_active`,
+		platform, userID, bridgeID, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to increment user activity: %v", err)
+	}
+	return nil
+}
+
+// GetTopUsers returns the most active users of a bridge
+func (d *Database) GetTopUsers(bridgeID string, limit int) ([]*models.UserActivity, error) {
+	rows, err := d.db.Query(`
+		SELECT platform, user_id, bridge_id, message_count, last_active 
+		FROM user_activity 
+		WHERE bridge_id = ? 
+		ORDER BY message_count DESC, last_active DESC 
+		LIMIT ?`,
+		bridgeID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query top users: %v", err)
+	}
+	defer rows.Close()
+
+	var activities []*models.UserActivity
+	for rows.Next() {
+		var activity models.UserActivity
+		if err := rows.Scan(&activity.Platform, &activity.UserID, &activity.BridgeID,
+			&activity.MessageCount, &activity.LastActive); err != nil {
+			return nil, fmt.Errorf("failed to scan user activity: %v", err)
+		}
+		activities = append(activities, &activity)
+	}
+
+	return activities, rows.Err()
+}
+
+// ResetBridgeStats zeroes the message counts of a bridge and deletes its delivery events older than an hour.
+// User activity is counted under the canonical bridge ID while events are recorded per connection, so the
+// connection IDs whose events should be cleared are passed separately. It returns the number of rows affected.
+func (d *Database) ResetBridgeStats(bridgeID string, connectionIDs ...string) (int64, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec("UPDATE user_activity SET message_count = 0 WHERE bridge_id = ? AND message_count > 0", bridgeID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reset user activity: %v", err)
+	}
+	affected, _ := result.RowsAffected()
+
+	cutoff := time.Now().Add(-time.Hour).UTC()
+	for _, connectionID := range connectionIDs {
+		result, err := tx.Exec("DELETE FROM bridge_events WHERE bridge_id = ? AND created_at < ?", connectionID, cutoff)
+		if err != nil {
+			return 0, fmt.Errorf("failed to delete bridge events: %v", err)
+		}
+		deleted, _ := result.RowsAffected()
+		affected += deleted
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit stats reset: %v", err)
+	}
+	return affected, nil
+}
+
+// StorePoll records a poll created on Telegram from a Discord channel
+func (d *Database) StorePoll(poll *models.Poll) error {
+	if poll.CreatedAt.IsZero() {
+		poll.CreatedAt = time.Now()
+	}
+
+	result, err := d.db.Exec(`
+		INSERT INTO polls (discord_channel_id, telegram_chat_id, telegram_msg_id, telegram_poll_id, question, options_json, results_json, total_voters, is_anonymous, created_at) 
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		poll.DiscordChannelID, poll.TelegramChatID, poll.TelegramMsgID, poll.TelegramPollID, poll.Question,
+		poll.OptionsJSON, poll.ResultsJSON, poll.TotalVoters, poll.IsAnonymous, poll.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to store poll: %v", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get poll ID: %v", err)
+	}
+	poll.ID = int(id)
+	return nil
+}
+
+// GetPoll returns a stored poll, or nil if it does not exist
+func (d *Database) GetPoll(id int) (*models.Poll, error) {
+	var poll models.Poll
+	err := d.db.QueryRow(`
+		SELECT id, discord_channel_id, telegram_chat_id, telegram_msg_id, telegram_poll_id, question, options_json, results_json, total_voters, is_anonymous, created_at 
+		FROM polls 
+		WHERE id = ?`, id).
+		Scan(&poll.ID, &poll.DiscordChannelID, &poll.TelegramChatID, &poll.TelegramMsgID, &poll.TelegramPollID, &poll.Question,
+			&poll.OptionsJSON, &poll.ResultsJSON, &poll.TotalVoters, &poll.IsAnonymous, &poll.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get poll: %v", err)
+	}
+
+	return &poll, nil
+}
+
+// UpdatePollResults stores the latest vote counts of a Telegram poll
+func (d *Database) UpdatePollResults(telegramPollID, resultsJSON string, totalVoters int) error {
+	_, err := d.db.Exec(`
+		UPDATE polls 
+		SET results_json = ?, total_voters = ? 
+		WHERE telegram_poll_id = ?`,
+		resultsJSON, totalVoters, telegramPollID)
+	if err != nil {
+		return fmt.Errorf("failed to update poll results: %v", err)
+	}
+	return nil
+}
+
+// GetChannelWebhooks returns the pool of bot webhooks of a Discord channel, oldest first
+func (d *Database) GetChannelWebhooks(channelID string) ([]*models.Webhook, error) {
+	rows, err := d.db.Query(`
+		SELECT channel_id, webhook_id, token, created_at 
+		FROM webhooks 
+		WHERE channel_id = ?
+		ORDER BY created_at`, channelID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks: %v", err)
+	}
+	defer rows.Close()
+
+	var webhooks []*models.Webhook
+	for rows.Next() {
+		var webhook models.Webhook
+		if err := rows.Scan(&webhook.ChannelID, &webhook.WebhookID, &webhook.Token, &webhook.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook: %v", err)
+		}
+		webhooks = append(webhooks, &webhook)
+	}
+
+	return webhooks, rows.Err()
+}
+
+// SaveWebhook stores a bot webhook of a Discord channel, replacing the stored token if it already exists
+func (d *Database) SaveWebhook(webhook *models.Webhook) error {
+	if webhook.CreatedAt.IsZero() {
+		webhook.CreatedAt = time.Now()
+	}
+
+	_, err := d.db.Exec(`
+		INSERT INTO webhooks (channel_id, webhook_id, token, created_at) 
+		VALUES (?, ?, ?, ?) 
+		ON CONFLICT(webhook_id) DO UPDATE SET 
+			channel_id = excluded.channel_id, token = excluded.token`,
+		webhook.ChannelID, webhook.WebhookID, webhook.Token, webhook.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save webhook: %v", err)
+	}
+	return nil
+}
+
+// GetUserMapping returns the stored names of a platform user, or nil if none are stored
+func (d *Database) GetUserMapping(platform, platformUserID string) (*models.UserMapping, error) {
+	var mapping models.UserMapping
+	err := d.db.QueryRow(`
+		SELECT id, user_id, platform, platform_user_id, username, display_name, avatar_url, is_active, created_at, updated_at 
+		FROM user_mappings 
+		WHERE platform = ? AND platform_user_id = ?`,
+		platform, platformUserID).
+		Scan(&mapping.ID, &mapping.UserID, &mapping.Platform, &mapping.PlatformUserID, &mapping.Username,
+			&mapping.DisplayName, &mapping.AvatarURL, &mapping.IsActive, &mapping.CreatedAt, &mapping.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user mapping: %v", err)
+	}
+
+	return &mapping, nil
+}
+
+// GetLinkedUserMapping returns the mapping on another platform of the same user as a platform user, or nil if their accounts are not linked
+func (d *Database) GetLinkedUserMapping(platform, platformUserID, linkedPlatform string) (*models.UserMapping, error) {
+	var mapping models.UserMapping
+	err := d.db.QueryRow(`
+		SELECT id, user_id, platform, platform_user_id, username, display_name, avatar_url, is_active, created_at, updated_at 
+		FROM user_mappings 
+		WHERE platform = ? AND is_active = 1 AND user_id = (
+			SELECT user_id FROM user_mappings WHERE platform = ? AND platform_user_id = ? AND is_active = 1
+		)`,
+		linkedPlatform, platform, platformUserID).
+		Scan(&mapping.ID, &mapping.UserID, &mapping.Platform, &mapping.PlatformUserID, &mapping.Username,
+			&mapping.DisplayName, &mapping.AvatarURL, &mapping.IsActive, &mapping.CreatedAt, &mapping.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get linked user mapping: %v", err)
+	}
+
+	return &mapping, nil
+}
+
+// DeleteUserMapping removes the stored names of a platform user
+func (d *Database) DeleteUserMapping(platform, platformUserID string) error {
+	_, err := d.db.Exec(`DELETE FROM user_mappings WHERE platform = ? AND platform_user_id = ?`, platform, platformUserID)
+	if err != nil {
+		return fmt.Errorf("failed to delete user mapping: %v", err)
+	}
+	return nil
+}
+
+// GetThreadMapping returns the Discord thread of a Telegram forum topic in a channel, or nil if none is stored
+func (d *Database) GetThreadMapping(telegramChatID string, topicID int, discordChannelID string) (*models.ThreadMapping, error) {
+	var mapping models.ThreadMapping
+	err := d.db.QueryRow(`
+		SELECT id, telegram_chat_id, topic_id, discord_channel_id, discord_thread_id, topic_name, created_at 
+		FROM thread_mappings 
+		WHERE telegram_chat_id = ? AND topic_id = ? AND discord_channel_id = ?`,
+		telegramChatID, topicID, discordChannelID).
+		Scan(&mapping.ID, &mapping.TelegramChatID, &mapping.TopicID, &mapping.DiscordChannelID,
+			&mapping.DiscordThreadID, &mapping.TopicName, &mapping.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get thread mapping: %v", err)
+	}
+
+	return &mapping, nil
+}
+
+// GetThreadMappingByDiscordThread returns the Telegram topic a Discord thread is bridged with in a chat, or nil if none is stored
+func (d *Database) GetThreadMappingByDiscordThread(discordThreadID, telegramChatID string) (*models.ThreadMapping, error) {
+	var mapping models.ThreadMapping
+	err := d.db.QueryRow(`
+		SELECT id, telegram_chat_id, topic_id, discord_channel_id, discord_thread_id, topic_name, created_at 
+		FROM thread_mappings 
+		WHERE discord_thread_id = ? AND telegram_chat_id = ?`,
+		discordThreadID, telegramChatID).
+		Scan(&mapping.ID, &mapping.TelegramChatID, &mapping.TopicID, &mapping.DiscordChannelID,
+			&mapping.DiscordThreadID, &mapping.TopicName, &mapping.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get thread mapping: %v", err)
+	}
+
+	return &mapping, nil
+}
+
+// SaveThreadMapping stores the Discord thread of a Telegram forum topic, replacing any previous one
+func (d *Database) SaveThreadMapping(mapping *models.ThreadMapping) error {
+	if mapping.CreatedAt.IsZero() {
+		mapping.CreatedAt = time.Now()
+	}
+
+	_, err := d.db.Exec(`
+		INSERT INTO thread_mappings (telegram_chat_id, topic_id, discord_channel_id, discord_thread_id, topic_name, created_at) 
+		VALUES (?, ?, ?, ?, ?, ?) 
+		ON CONFLICT(telegram_chat_id, topic_id, discord_channel_id) DO UPDATE SET 
+			discord_thread_id = excluded.discord_thread_id, topic_name = excluded.topic_name, created_at = excluded.created_at`,
+		mapping.TelegramChatID, mapping.TopicID, mapping.DiscordChannelID, mapping.DiscordThreadID, mapping.TopicName, mapping.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save thread mapping: %v", err)
+	}
+	return nil
+}
+
+// RecordBotSentMessage remembers a message sent by the bot so it is never bridged back
+func (d *Database) RecordBotSentMessage(platform, messageID string) error {
+	_, err := d.db.Exec(`
+		INSERT OR REPLACE INTO bot_sent_messages (platform, message_id, sent_at) 
+		VALUES (?, ?, ?)`,
+		platform, messageID, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to record bot sent message: %v", err)
+	}
+	return nil
+}
+
+// IsBotSentMessage reports whether a message was sent by the bot
+func (d *Database) IsBotSentMessage(platform, messageID string) (bool, error) {
+	var exists bool
+	err := d.db.QueryRow(`
+		SELECT EXISTS(SELECT 1 FROM bot_sent_messages WHERE platform = ? AND message_id = ?)`,
+		platform, messageID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check bot sent message: %v", err)
+	}
+	return exists, nil
+}
+
+// GetWebhooks returns all stored bot webhooks
+func (d *Database) GetWebhooks() ([]*models.Webhook, error) {
+	rows, err := d.db.Query(`
+		SELECT channel_id, webhook_id, token, created_at 
+		FROM webhooks`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks: %v", err)
+	}
+	defer rows.Close()
+
+	var webhooks []*models.Webhook
+	for rows.Next() {
+		var webhook models.Webhook
+		if err := rows.Scan(&webhook.ChannelID, &webhook.WebhookID, &webhook.Token, &webhook.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook: %v", err)
+		}
+		webhooks = append(webhooks, &webhook)
+	}
+
+	return webhooks, rows.Err()
+}
@@ -0,0 +1,180 @@
+package health
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"dcbot/internal/metrics"
+	"dcbot/internal/types"
+)
+
+// probeTimeout is how long a probe may take to be delivered to both sides of a bridge
+const probeTimeout = 60 * time.Second
+
+// BridgeSource is the part of the bridge core the tester needs
+type BridgeSource interface {
+	GetAllBridges() map[string][]*types.BridgeConnection
+	SendSystemMessage(platform, channelID, content string) error
+}
+
+// BridgeTester sends a daily probe through every active bridge and reports bridges that fail
+type BridgeTester struct {
+	bridges BridgeSource
+	runAt   string               // Daily run time as "HH:MM" in local time, empty for on-demand only
+	notify  func(message string) // Called with a description of each failure, e.g. to DM admins
+	mu      sync.Mutex           // Serializes test runs
+}
+
+// NewBridgeTester creates a bridge tester that runs daily at runAt ("HH:MM"), or only on demand if runAt is empty
+func NewBridgeTester(bridges BridgeSource, runAt string, notify func(message string)) (*BridgeTester, error) {
+	if runAt == "" {
+		return &BridgeTester{bridges: bridges, notify: notify}, nil
+	}
+	if _, err := time.Parse("15:04", runAt); err != nil {
+		return nil, fmt.Errorf("invalid bridge test time %q, expected HH:MM: %v", runAt, err)
+	}
+	return &BridgeTester{
+		bridges: bridges,
+		runAt:   runAt,
+		notify:  notify,
+	}, nil
+}
+
+// Start runs the daily bridge tests until ctx is cancelled
+func (t *BridgeTester) Start(ctx context.Context) {
+	if t.runAt == "" {
+		return
+	}
+
+	go func() {
+		for {
+			next := nextRunTime(time.Now(), t.runAt)
+			log.Printf("🔍 Next bridge health check at %s", next.Format("2006-01-02 15:04"))
+
+			timer := time.NewTimer(time.Until(next))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+				t.RunAll()
+			}
+		}
+	}()
+}
+
+// RunAll tests every active bridge once
+func (t *BridgeTester) RunAll() {
+	tested := make(map[string]bool)
+	for _, connections := range t.bridges.GetAllBridges() {
+		for _, conn := range connections {
+			key := bridgeKey(conn)
+			if !conn.IsActive || tested[key] {
+				continue
+			}
+			tested[key] = true
+			t.test(conn)
+		}
+	}
+	log.Printf("🔍 Bridge health check finished: %d bridge(s) tested", len(tested))
+}
+
+// RunNow tests a single bridge by connection ID
+func (t *BridgeTester) RunNow(bridgeID string) error {
+	for _, connections := range t.bridges.GetAllBridges() {
+		for _, conn := range connections {
+			if conn.ID == bridgeID {
+				return t.test(conn)
+			}
+		}
+	}
+	return fmt.Errorf("bridge %s not found", bridgeID)
+}
+
+// test sends a probe with a unique nonce to both sides of a bridge and records the result
+func (t *BridgeTester) test(conn *types.BridgeConnection) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	nonce := newNonce()
+	probe := fmt.Sprintf("🔍 Bridge health check [%s]", nonce)
+
+	err := sendWithTimeout(func() error {
+		if err := t.bridges.SendSystemMessage(conn.SourcePlatform, conn.SourceChannelID, probe); err != nil {
+			return fmt.Errorf("%s channel %s: %v", conn.SourcePlatform, conn.SourceChannelID, err)
+		}
+		if err := t.bridges.SendSystemMessage(conn.TargetPlatform, conn.TargetChannelID, probe); err != nil {
+			return fmt.Errorf("%s channel %s: %v", conn.TargetPlatform, conn.TargetChannelID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		metrics.IncBridgeTestFailures(conn.ID)
+		log.Printf("❌ Bridge health check failed for %s [%s]: %v", conn.ID, nonce, err)
+		if t.notify != nil {
+			t.notify(fmt.Sprintf("❌ Bridge health check failed for `%s` [%s]: %v", bridgeLabel(conn), nonce, err))
+		}
+		return err
+	}
+
+	log.Printf("✅ Bridge health check passed for %s [%s]", conn.ID, nonce)
+	return nil
+}
+
+// sendWithTimeout runs send, failing if it does not finish within probeTimeout
+func sendWithTimeout(send func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- send()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(probeTimeout):
+		return fmt.Errorf("probe not delivered within %s", probeTimeout)
+	}
+}
+
+// nextRunTime returns the next time after now matching the daily "HH:MM" run time
+func nextRunTime(now time.Time, runAt string) time.Time {
+	clock, _ := time.Parse("15:04", runAt)
+	next := time.Date(now.Year(), now.Month(), now.Day(), clock.Hour(), clock.Minute(), 0, 0, now.Location())
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// bridgeKey identifies a bridge regardless of direction
+func bridgeKey(conn *types.BridgeConnection) string {
+	a := conn.SourcePlatform + ":" + conn.SourceChannelID
+	b := conn.TargetPlatform + ":" + conn.TargetChannelID
+	if a > b {
+		a, b = b, a
+	}
+	return a + "|" + b
+}
+
+// bridgeLabel returns a bridge's name, or its connection ID if it has none
+func bridgeLabel(conn *types.BridgeConnection) string {
+	if strings.TrimSpace(conn.Name) != "" {
+		return conn.Name
+	}
+	return conn.ID
+}
+
+// newNonce returns a short random hex string identifying a probe
+func newNonce() string {
+	var b [4]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return fmt.Sprintf("%08x", time.Now().UnixNano()&0xffffffff)
+	}
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,262 @@
+package i18n
+
+// german holds the German ("de") translations
+var german = &catalog{
+	names: map[string]string{
+		"bridge":      "brücke",
+		"config":      "einstellungen",
+		"help":        "hilfe",
+		"status":      "status",
+		"create":      "erstellen",
+		"leaderboard": "bestenliste",
+		"setcolor":    "farbe",
+		"stats":       "statistik",
+		"simulate":    "simulieren",
+		"test":        "testen",
+		"poll":        "umfrage",
+		"results":     "ergebnisse",
+		"undo":        "rückgängig",
+		"import":      "importieren",
+		"rename":      "umbenennen",
+		"setpriority": "priorität",
+		"remove":      "entfernen",
+		"permissions": "berechtigungen",
+		"events":      "ereignisse",
+		"removeall":   "alle_entfernen",
+		"platforms":   "plattformen",
+		"channels":    "kanäle",
+		"audit":       "protokoll",
+		"webhooks":    "webhooks",
+		"cleanup":     "aufräumen",
+		"templates":   "vorlagen",
+		"list":        "liste",
+		"db":          "datenbank",
+		"prune":       "bereinigen",
+		"silent":      "stumm",
+		"compact":     "kompakt",
+		"enable":      "aktivieren",
+		"disable":     "deaktivieren",
+		"hours":       "zeiten",
+		"whitelist":   "whitelist",
+		"add":         "hinzufügen",
+		"filter":      "filter",
+		"platform":    "plattform",
+		"room":        "raum",
+		"name":        "name",
+		"template":    "vorlage",
+		"bridge_id":   "brücken_id",
+		"color":       "farbe",
+		"content":     "inhalt",
+		"poll_id":     "umfrage_id",
+		"priority":    "priorität",
+		"question":    "frage",
+		"anonymous":   "anonym",
+		"user_id":     "benutzer_id",
+		"pattern":     "muster",
+		"mode":        "modus",
+		"action":      "aktion",
+		"replacement": "ersetzung",
+		"start":       "beginn",
+		"end":         "ende",
+
+		// /config rate-test
+		"rate-test":           "ratentest",
+		"messages_per_minute": "nachrichten_pro_minute",
+		"limit":               "limit",
+
+		// /config allowtypes
+		"allowtypes": "erlaubte_typen",
+		"set":        "festlegen",
+		"show":       "anzeigen",
+		"types":      "typen",
+
+		// /config delay
+		"delay":   "verzögerung",
+		"seconds": "sekunden",
+		"clear":   "aufheben",
+
+		// /bridge testconnection
+		"testconnection": "verbindungstest",
+
+		// /config db history
+		"history": "verlauf",
+
+		// /bridge resetstats
+		"resetstats": "statistik_zurücksetzen",
+
+		// /bridge migrate
+		"migrate":        "umziehen",
+		"old_channel_id": "alte_kanal_id",
+		"new_channel_id": "neue_kanal_id",
+
+		// /config bulkupdate
+		"bulkupdate": "massenänderung",
+		"field":      "feld",
+		"value":      "wert",
+
+		// /config adminrole
+		"adminrole": "adminrolle",
+		"role":      "rolle",
+
+		// /link
+		"link": "verknüpfen",
+		"code": "code",
+	},
+	messages: map[string]string{
+		// Slash command descriptions
+		"Manage bridge connections":                           "Brückenverbindungen verwalten",
+		"Show bridge status":                                  "Brückenstatus anzeigen",
+		"Create a new bridge":                                 "Eine neue Brücke erstellen",
+		"Target platform (telegram)":                          "Zielplattform (telegram)",
+		"Target chat ID or Telegram invite code (see /getid)": "Ziel-Chat-ID oder Telegram-Einladungscode (siehe /getid)",
+		"Human-readable bridge name":                          "Lesbarer Name der Brücke",
+		"Bridge template to configure the bridge with (see /config templates list)": "Vorlage für die Konfiguration der Brücke (siehe /config templates list)",
+		"Show the most active users of a bridge":                                    "Die aktivsten Benutzer einer Brücke anzeigen",
+		"Bridge to show (defaults to this channel's bridge)":                        "Anzuzeigende Brücke (Standard: Brücke dieses Kanals)",
+		"Set the embed color of this channel's bridge":                              "Embed-Farbe der Brücke dieses Kanals festlegen",
+		"Hex color, e.g. #FF5500":                                                   "Hex-Farbe, z. B. #FF5500",
+		"Show bridge statistics and dropped message counts":                         "Brückenstatistiken und verworfene Nachrichten anzeigen",
+		"Show how a message from this channel would be bridged, without sending it": "Zeigen, wie eine Nachricht aus diesem Kanal übertragen würde, ohne sie zu senden",
+		"Message content to test":                                                   "Zu testender Nachrichteninhalt",
+		"Check the bot's permissions in every bridged channel of this server":       "Die Berechtigungen des Bots in allen verbundenen Kanälen dieses Servers prüfen",
+		"Check that the API credentials of every platform are still valid":          "Prüfen, ob die API-Zugangsdaten aller Plattformen noch gültig sind",
+		"Show the last delivery attempts of a bridge":                               "Die letzten Zustellversuche einer Brücke anzeigen",
+		"Reset the message counts and delivery events of a bridge":                  "Nachrichtenzähler und Zustellereignisse einer Brücke zurücksetzen",
+		"Bridge to reset (defaults to this channel's bridge)":                       "Zurückzusetzende Brücke (Standard: Brücke dieses Kanals)",
+		"Move all bridges of a channel to another channel":                          "Alle Brücken eines Kanals in einen anderen Kanal verschieben",
+		"Channel whose bridges are moved":                                           "Kanal, dessen Brücken verschoben werden",
+		"Channel the bridges are moved to":                                          "Kanal, in den die Brücken verschoben werden",
+		"Change a setting of every bridge in this server":                           "Eine Einstellung aller Brücken dieses Servers ändern",
+		"Setting to change, e.g. max_message_length":                                "Zu ändernde Einstellung, z. B. max_message_length",
+		"New value of the setting":                                                  "Neuer Wert der Einstellung",
+		"Sync Telegram group admins with a Discord role":                            "Telegram-Gruppenadmins mit einer Discord-Rolle abgleichen",
+		"Give this role to Telegram group admins and promote its members on Telegram": "Telegram-Gruppenadmins diese Rolle geben und ihre Mitglieder auf Telegram befördern",
+		"Discord role matching Telegram group admin":                                "Discord-Rolle, die Telegram-Gruppenadmins entspricht",
+		"Stop syncing admins of this channel's bridge":                              "Admin-Abgleich für die Brücke dieses Kanals beenden",
+		"Send a health check probe through a bridge":                                "Eine Zustandsprüfung durch eine Brücke senden",
+		"Bridge to test (defaults to this channel's bridge)":                        "Zu testende Brücke (Standard: Brücke dieses Kanals)",
+		"Create and track polls in the bridged Telegram chat":                       "Umfragen im verbundenen Telegram-Chat erstellen und verfolgen",
+		"Create a poll in this channel's Telegram chat":                             "Eine Umfrage im Telegram-Chat dieses Kanals erstellen",
+		"Show the current results of a poll":                                        "Aktuelle Ergebnisse einer Umfrage anzeigen",
+		"Poll ID returned when the poll was created":                                "Beim Erstellen der Umfrage zurückgegebene ID",
+		"Poll question": "Umfragefrage",
+		"Hide who voted for what (default: true)":               "Verbergen, wer wofür gestimmt hat (Standard: true)",
+		"Undo your most recent bridge removal or config change": "Letzte Brückenentfernung oder Konfigurationsänderung rückgängig machen",
+		"Create several bridges from a JSON payload":            "Mehrere Brücken aus JSON-Daten erstellen",
+		"Rename a bridge":  "Eine Brücke umbenennen",
+		"Bridge to rename": "Umzubenennende Brücke",
+		"New bridge name":  "Neuer Brückenname",
+		"Set the delivery priority of a bridge's target channel": "Zustellpriorität des Zielkanals einer Brücke festlegen",
+		"Bridge to prioritize":                                           "Zu priorisierende Brücke",
+		"Priority from 0 to 10, higher is delivered first":               "Priorität von 0 bis 10, höhere wird zuerst zugestellt",
+		"Remove a bridge":                                                "Eine Brücke entfernen",
+		"Platform to remove bridge from (omit to pick from a list)":      "Plattform, von der die Brücke entfernt wird (leer lassen für Auswahlliste)",
+		"Remove all bridges of this server":                              "Alle Brücken dieses Servers entfernen",
+		"Bot configuration commands":                                     "Befehle zur Bot-Konfiguration",
+		"Show enabled platforms":                                         "Aktivierte Plattformen anzeigen",
+		"List available channels":                                        "Verfügbare Kanäle auflisten",
+		"Show recent bridge configuration changes":                       "Letzte Änderungen an der Brückenkonfiguration anzeigen",
+		"Only show changes made from this platform":                      "Nur Änderungen von dieser Plattform anzeigen",
+		"Only show this kind of change":                                  "Nur diese Art von Änderung anzeigen",
+		"Manage the webhooks used for bridged messages":                  "Webhooks für übertragene Nachrichten verwalten",
+		"Delete bridge webhooks in this server that are no longer used":  "Nicht mehr verwendete Brücken-Webhooks dieses Servers löschen",
+		"Predefined bridge configurations":                               "Vordefinierte Brückenkonfigurationen",
+		"List the bridge templates":                                      "Brückenvorlagen auflisten",
+		"Maintain the bridge database":                                   "Brückendatenbank warten",
+		"Delete the oldest stored messages beyond the configured limits": "Älteste gespeicherte Nachrichten über den konfigurierten Grenzen löschen",
+		"Show the row count of each table and the WAL size":              "Zeilenanzahl jeder Tabelle und die WAL-Größe anzeigen",
+		"Show the trend of the stored message count over the last week":  "Den Verlauf der gespeicherten Nachrichten der letzten Woche anzeigen",
+		"Send bridged Telegram messages without notification":            "Übertragene Telegram-Nachrichten ohne Benachrichtigung senden",
+		"Always send bridged messages silently":                          "Übertragene Nachrichten immer stumm senden",
+		"Turn off silent mode and silent hours":                          "Stummmodus und Ruhezeiten ausschalten",
+		"Show only the sender's name on bridged messages":                "Bei übertragenen Nachrichten nur den Namen des Absenders anzeigen",
+		"Leave out the platform prefix of bridged messages":              "Das Plattform-Präfix übertragener Nachrichten weglassen",
+		"Show the platform prefix of bridged messages again":             "Das Plattform-Präfix übertragener Nachrichten wieder anzeigen",
+		"Hold bridged messages back before delivering them":              "Übertragene Nachrichten vor der Zustellung zurückhalten",
+		"Deliver bridged messages after a delay":                         "Übertragene Nachrichten verzögert zustellen",
+		"Seconds to wait before delivering each message":                 "Sekunden, die vor der Zustellung jeder Nachricht gewartet werden",
+		"Deliver bridged messages immediately again":                     "Übertragene Nachrichten wieder sofort zustellen",
+		"Send bridged messages silently during these hours only":         "Übertragene Nachrichten nur in diesen Stunden stumm senden",
+		"Hour silent hours begin (0-23, server time)":                    "Stunde, in der die Ruhezeit beginnt (0-23, Serverzeit)",
+		"Hour silent hours end (0-23, server time)":                      "Stunde, in der die Ruhezeit endet (0-23, Serverzeit)",
+		"Restrict who can send through this channel's bridge":            "Einschränken, wer über die Brücke dieses Kanals senden darf",
+		"Allow a user to send through the bridge":                        "Einem Benutzer das Senden über die Brücke erlauben",
+		"Remove a user from the whitelist":                               "Einen Benutzer von der Whitelist entfernen",
+		"Show the users allowed to send through the bridge":              "Benutzer anzeigen, die über die Brücke senden dürfen",
+		"Choose which message types this channel's bridge carries":       "Auswählen, welche Nachrichtentypen die Brücke dieses Kanals überträgt",
+		"Only bridge these message types":                                "Nur diese Nachrichtentypen übertragen",
+		"Message types separated by commas, e.g. text, image, or all":    "Nachrichtentypen durch Kommas getrennt, z. B. text, image oder all",
+		"Show the message types the bridge carries":                      "Die Nachrichtentypen anzeigen, die die Brücke überträgt",
+		"Platform of the user":                                           "Plattform des Benutzers",
+		"User ID on that platform":                                       "Benutzer-ID auf dieser Plattform",
+		"Manage content filters for this channel's bridge":               "Inhaltsfilter für die Brücke dieses Kanals verwalten",
+		"Add a content filter rule":                                      "Eine Inhaltsfilterregel hinzufügen",
+		"Word, glob or regex pattern to match":                           "Wort-, Glob- oder Regex-Muster",
+		"How the pattern is matched":                                     "Wie das Muster abgeglichen wird",
+		"What to do with matching messages":                              "Was mit passenden Nachrichten passiert",
+		"Replacement text (for replace action)":                          "Ersatztext (für die Ersetzen-Aktion)",
+		"List content filter rules":                                      "Inhaltsfilterregeln auflisten",
+		"Simulate a burst of messages against this channel's rate limit": "Eine Nachrichtenflut gegen das Ratenlimit dieses Kanals simulieren",
+		"Messages arriving at once":                                      "Gleichzeitig eintreffende Nachrichten",
+		"Try this per-minute limit instead of the configured one":        "Dieses Limit pro Minute statt des konfigurierten testen",
+		"Show bot help information":                                      "Bot-Hilfe anzeigen",
+		"Link your Discord account to your Telegram account": "Dein Discord-Konto mit deinem Telegram-Konto verknüpfen",
+		"Code sent by the Telegram bot for /link": "Vom Telegram-Bot für /link gesendeter Code",
+
+		// Option choices
+		"Exact":   "Exakt",
+		"Block":   "Blockieren",
+		"Replace": "Ersetzen",
+
+		// Help
+		"🌉 Bridge Bot Help":                         "🌉 Bridge-Bot-Hilfe",
+		"Commands to manage cross-platform bridges": "Befehle zur Verwaltung plattformübergreifender Brücken",
+		"🔗 Bridge Commands":                         "🔗 Brückenbefehle",
+		"⚙️ Config Commands":                        "⚙️ Konfigurationsbefehle",
+		"ℹ️ General":                                "ℹ️ Allgemein",
+		"Bridge Bot - Discord Control Center":       "Bridge-Bot - Discord-Kontrollzentrum",
+		"Create new bridge":                         "Neue Brücke erstellen",
+		"Set a bridge's delivery priority":          "Zustellpriorität einer Brücke festlegen",
+		"Create bridges from JSON":                  "Brücken aus JSON erstellen",
+		"Remove bridge":                             "Brücke entfernen",
+		"Undo your last change":                     "Letzte Änderung rückgängig machen",
+		"Show the most active users":                "Die aktivsten Benutzer anzeigen",
+		"Show bridge statistics":                    "Brückenstatistiken anzeigen",
+		"Dry-run a message through the bridge":      "Eine Nachricht probeweise durch die Brücke schicken",
+		"Set the bridge embed color":                "Embed-Farbe der Brücke festlegen",
+		"Check the bot's channel permissions":       "Kanalberechtigungen des Bots prüfen",
+		"Check the platform API credentials":        "API-Zugangsdaten der Plattformen prüfen",
+		"Show recent delivery attempts":             "Letzte Zustellversuche anzeigen",
+		"Send a health check probe":                 "Eine Zustandsprüfung senden",
+		"Create a poll on Telegram":                 "Eine Umfrage auf Telegram erstellen",
+		"Show poll results":                         "Umfrageergebnisse anzeigen",
+		"Add a content filter":                      "Einen Inhaltsfilter hinzufügen",
+		"List content filters":                      "Inhaltsfilter auflisten",
+		"Mute Telegram notifications":               "Telegram-Benachrichtigungen stummschalten",
+		"Hide platform prefixes":                    "Plattform-Präfixe ausblenden",
+		"Delay bridged messages":                    "Übertragene Nachrichten verzögern",
+		"Restrict who can send through the bridge":  "Einschränken, wer über die Brücke senden darf",
+		"Choose the bridged message types":          "Übertragene Nachrichtentypen auswählen",
+		"Show recent configuration changes":         "Letzte Konfigurationsänderungen anzeigen",
+		"Delete old bridged messages":               "Alte übertragene Nachrichten löschen",
+		"Show database table sizes":                 "Größe der Datenbanktabellen anzeigen",
+		"Show the database growth of the last week": "Das Wachstum der Datenbank in der letzten Woche anzeigen",
+		"Delete unused webhooks":                    "Unbenutzte Webhooks löschen",
+		"List bridge templates":                     "Brückenvorlagen auflisten",
+		"Simulate a message burst":                  "Eine Nachrichtenflut simulieren",
+		"Show this help message":                    "Diese Hilfenachricht anzeigen",
+
+		// Responses
+		"❌ You don't have permission to use this command.":      "❌ Du hast keine Berechtigung, diesen Befehl zu verwenden.",
+		"❓ Unknown command":                                     "❓ Unbekannter Befehl",
+		"❌ No subcommand specified":                             "❌ Kein Unterbefehl angegeben",
+		"❓ Unknown bridge subcommand":                           "❓ Unbekannter Brücken-Unterbefehl",
+		"❓ Unknown config subcommand":                           "❓ Unbekannter Konfigurations-Unterbefehl",
+		"❌ Bridge core is not available":                        "❌ Der Brückenkern ist nicht verfügbar",
+		"❌ Missing required parameters":                         "❌ Erforderliche Parameter fehlen",
+		"❌ No bridges configured for this channel":              "❌ Für diesen Kanal sind keine Brücken konfiguriert",
+		"❌ Bridge not found. It may have been removed already.": "❌ Brücke nicht gefunden. Sie wurde möglicherweise bereits entfernt.",
+		"⌛ This confirmation has expired.":                      "⌛ Diese Bestätigung ist abgelaufen.",
+		"❌ Only the admin who ran this command can confirm it.": "❌ Nur der Admin, der diesen Befehl ausgeführt hat, kann ihn bestätigen.",
+	},
+}
@@ -0,0 +1,86 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Supported locales besides English, using Discord's locale codes
+const (
+	LocaleTurkish = "tr"
+	LocaleGerman  = "de"
+)
+
+// catalog holds the translations of one locale, keyed by the English text
+type catalog struct {
+	names    map[string]string // Slash command, subcommand and option names
+	messages map[string]string // Descriptions, choice names and response texts
+}
+
+// catalogs maps each supported locale to its translations
+var catalogs = map[string]*catalog{
+	LocaleTurkish: turkish,
+	LocaleGerman:  german,
+}
+
+// SupportedLocales returns the locales that have translations
+func SupportedLocales() []string {
+	return []string{LocaleTurkish, LocaleGerman}
+}
+
+// baseLocale reduces a locale such as "de-AT" to its language, for locales without a catalog of their own
+func baseLocale(locale string) string {
+	if _, ok := catalogs[locale]; ok {
+		return locale
+	}
+	if index := strings.IndexAny(locale, "-_"); index > 0 {
+		return locale[:index]
+	}
+	return locale
+}
+
+// CommandName returns the translation of a slash command, subcommand or option name
+func CommandName(locale, name string) (string, bool) {
+	c := catalogs[baseLocale(locale)]
+	if c == nil {
+		return "", false
+	}
+	translated, ok := c.names[name]
+	return translated, ok
+}
+
+// Message returns the translation of an English text
+func Message(locale, message string) (string, bool) {
+	c := catalogs[baseLocale(locale)]
+	if c == nil {
+		return "", false
+	}
+	translated, ok := c.messages[message]
+	return translated, ok
+}
+
+// Localizer translates texts into a user's locale, falling back to English
+type Localizer struct {
+	locale string
+}
+
+// NewLocalizer creates a localizer for a locale, e.g. the locale of a Discord interaction
+func NewLocalizer(locale string) *Localizer {
+	return &Localizer{locale: baseLocale(locale)}
+}
+
+// Locale returns the locale the localizer translates into
+func (l *Localizer) Locale() string {
+	return l.locale
+}
+
+// T translates an English text, formatting it with args when given. Untranslated texts are returned in English.
+func (l *Localizer) T(message string, args ...interface{}) string {
+	if translated, ok := Message(l.locale, message); ok {
+		message = translated
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(message, args...)
+	}
+	return message
+}
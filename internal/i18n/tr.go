@@ -0,0 +1,262 @@
+package i18n
+
+// turkish holds the Turkish ("tr") translations
+var turkish = &catalog{
+	names: map[string]string{
+		"bridge":      "köprü",
+		"config":      "ayarlar",
+		"help":        "yardım",
+		"status":      "durum",
+		"create":      "oluştur",
+		"leaderboard": "sıralama",
+		"setcolor":    "renk",
+		"stats":       "istatistik",
+		"simulate":    "simüle",
+		"test":        "test",
+		"poll":        "anket",
+		"results":     "sonuçlar",
+		"undo":        "geri_al",
+		"import":      "içe_aktar",
+		"rename":      "yeniden_adlandır",
+		"setpriority": "öncelik",
+		"remove":      "kaldır",
+		"permissions": "izinler",
+		"events":      "olaylar",
+		"removeall":   "tümünü_kaldır",
+		"platforms":   "platformlar",
+		"channels":    "kanallar",
+		"audit":       "denetim",
+		"webhooks":    "webhooklar",
+		"cleanup":     "temizle",
+		"templates":   "şablonlar",
+		"list":        "liste",
+		"db":          "veritabanı",
+		"prune":       "buda",
+		"silent":      "sessiz",
+		"compact":     "kompakt",
+		"enable":      "etkinleştir",
+		"disable":     "devre_dışı",
+		"hours":       "saatler",
+		"whitelist":   "beyaz_liste",
+		"add":         "ekle",
+		"filter":      "filtre",
+		"platform":    "platform",
+		"room":        "oda",
+		"name":        "ad",
+		"template":    "şablon",
+		"bridge_id":   "köprü_id",
+		"color":       "renk",
+		"content":     "içerik",
+		"poll_id":     "anket_id",
+		"priority":    "öncelik",
+		"question":    "soru",
+		"anonymous":   "anonim",
+		"user_id":     "kullanıcı_id",
+		"pattern":     "desen",
+		"mode":        "mod",
+		"action":      "eylem",
+		"replacement": "yerine",
+		"start":       "başlangıç",
+		"end":         "bitiş",
+
+		// /config rate-test
+		"rate-test":           "hız_testi",
+		"messages_per_minute": "dakikalık_mesaj",
+		"limit":               "sınır",
+
+		// /config allowtypes
+		"allowtypes": "izinli_türler",
+		"set":        "ayarla",
+		"show":       "göster",
+		"types":      "türler",
+
+		// /config delay
+		"delay":   "gecikme",
+		"seconds": "saniye",
+		"clear":   "temizle",
+
+		// /bridge testconnection
+		"testconnection": "bağlantı_testi",
+
+		// /config db history
+		"history": "geçmiş",
+
+		// /bridge resetstats
+		"resetstats": "istatistik_sıfırla",
+
+		// /bridge migrate
+		"migrate":        "taşı",
+		"old_channel_id": "eski_kanal_id",
+		"new_channel_id": "yeni_kanal_id",
+
+		// /config bulkupdate
+		"bulkupdate": "toplu_güncelle",
+		"field":      "alan",
+		"value":      "değer",
+
+		// /config adminrole
+		"adminrole": "yönetici_rolü",
+		"role":      "rol",
+
+		// /link
+		"link": "bağla",
+		"code": "kod",
+	},
+	messages: map[string]string{
+		// Slash command descriptions
+		"Manage bridge connections":                           "Köprü bağlantılarını yönet",
+		"Show bridge status":                                  "Köprü durumunu göster",
+		"Create a new bridge":                                 "Yeni bir köprü oluştur",
+		"Target platform (telegram)":                          "Hedef platform (telegram)",
+		"Target chat ID or Telegram invite code (see /getid)": "Hedef sohbet kimliği veya Telegram davet kodu (bkz. /getid)",
+		"Human-readable bridge name":                          "Okunabilir köprü adı",
+		"Bridge template to configure the bridge with (see /config templates list)": "Köprüyü yapılandırmak için şablon (bkz. /config templates list)",
+		"Show the most active users of a bridge":                                    "Bir köprünün en aktif kullanıcılarını göster",
+		"Bridge to show (defaults to this channel's bridge)":                        "Gösterilecek köprü (varsayılan: bu kanalın köprüsü)",
+		"Set the embed color of this channel's bridge":                              "Bu kanalın köprüsünün embed rengini ayarla",
+		"Hex color, e.g. #FF5500":                                                   "Hex renk, ör. #FF5500",
+		"Show bridge statistics and dropped message counts":                         "Köprü istatistiklerini ve düşen mesaj sayılarını göster",
+		"Show how a message from this channel would be bridged, without sending it": "Bu kanaldan bir mesajın nasıl aktarılacağını göndermeden göster",
+		"Message content to test":                                                   "Test edilecek mesaj içeriği",
+		"Check the bot's permissions in every bridged channel of this server":       "Botun bu sunucudaki tüm köprülü kanallardaki izinlerini kontrol et",
+		"Check that the API credentials of every platform are still valid":          "Tüm platformların API kimlik bilgilerinin hâlâ geçerli olduğunu kontrol et",
+		"Show the last delivery attempts of a bridge":                               "Bir köprünün son teslim denemelerini göster",
+		"Reset the message counts and delivery events of a bridge":                  "Bir köprünün mesaj sayılarını ve teslim olaylarını sıfırla",
+		"Bridge to reset (defaults to this channel's bridge)":                       "Sıfırlanacak köprü (varsayılan: bu kanalın köprüsü)",
+		"Move all bridges of a channel to another channel":                          "Bir kanalın tüm köprülerini başka bir kanala taşı",
+		"Channel whose bridges are moved":                                           "Köprüleri taşınacak kanal",
+		"Channel the bridges are moved to":                                          "Köprülerin taşınacağı kanal",
+		"Change a setting of every bridge in this server":                           "Bu sunucudaki tüm köprülerin bir ayarını değiştir",
+		"Setting to change, e.g. max_message_length":                                "Değiştirilecek ayar, ör. max_message_length",
+		"New value of the setting":                                                  "Ayarın yeni değeri",
+		"Sync Telegram group admins with a Discord role":                            "Telegram grup yöneticilerini bir Discord rolüyle eşitle",
+		"Give this role to Telegram group admins and promote its members on Telegram": "Bu rolü Telegram grup yöneticilerine ver ve rol üyelerini Telegram'da yönetici yap",
+		"Discord role matching Telegram group admin":                                "Telegram grup yöneticisine karşılık gelen Discord rolü",
+		"Stop syncing admins of this channel's bridge":                              "Bu kanalın köprüsünde yönetici eşitlemesini durdur",
+		"Send a health check probe through a bridge":                                "Bir köprü üzerinden sağlık kontrolü gönder",
+		"Bridge to test (defaults to this channel's bridge)":                        "Test edilecek köprü (varsayılan: bu kanalın köprüsü)",
+		"Create and track polls in the bridged Telegram chat":                       "Bağlı Telegram sohbetinde anket oluştur ve takip et",
+		"Create a poll in this channel's Telegram chat":                             "Bu kanalın Telegram sohbetinde anket oluştur",
+		"Show the current results of a poll":                                        "Bir anketin güncel sonuçlarını göster",
+		"Poll ID returned when the poll was created":                                "Anket oluşturulurken verilen anket kimliği",
+		"Poll question": "Anket sorusu",
+		"Hide who voted for what (default: true)":               "Kimin neye oy verdiğini gizle (varsayılan: true)",
+		"Undo your most recent bridge removal or config change": "Son köprü kaldırma veya ayar değişikliğini geri al",
+		"Create several bridges from a JSON payload":            "JSON verisinden birden çok köprü oluştur",
+		"Rename a bridge":  "Bir köprüyü yeniden adlandır",
+		"Bridge to rename": "Yeniden adlandırılacak köprü",
+		"New bridge name":  "Yeni köprü adı",
+		"Set the delivery priority of a bridge's target channel": "Bir köprünün hedef kanalının teslim önceliğini ayarla",
+		"Bridge to prioritize":                                           "Önceliklendirilecek köprü",
+		"Priority from 0 to 10, higher is delivered first":               "0 ile 10 arası öncelik, yüksek olan önce teslim edilir",
+		"Remove a bridge":                                                "Bir köprüyü kaldır",
+		"Platform to remove bridge from (omit to pick from a list)":      "Köprünün kaldırılacağı platform (listeden seçmek için boş bırakın)",
+		"Remove all bridges of this server":                              "Bu sunucunun tüm köprülerini kaldır",
+		"Bot configuration commands":                                     "Bot yapılandırma komutları",
+		"Show enabled platforms":                                         "Etkin platformları göster",
+		"List available channels":                                        "Kullanılabilir kanalları listele",
+		"Show recent bridge configuration changes":                       "Son köprü yapılandırma değişikliklerini göster",
+		"Only show changes made from this platform":                      "Yalnızca bu platformdan yapılan değişiklikleri göster",
+		"Only show this kind of change":                                  "Yalnızca bu tür değişiklikleri göster",
+		"Manage the webhooks used for bridged messages":                  "Aktarılan mesajlar için kullanılan webhookları yönet",
+		"Delete bridge webhooks in this server that are no longer used":  "Bu sunucuda artık kullanılmayan köprü webhooklarını sil",
+		"Predefined bridge configurations":                               "Önceden tanımlı köprü yapılandırmaları",
+		"List the bridge templates":                                      "Köprü şablonlarını listele",
+		"Maintain the bridge database":                                   "Köprü veritabanının bakımını yap",
+		"Delete the oldest stored messages beyond the configured limits": "Yapılandırılmış sınırları aşan en eski kayıtlı mesajları sil",
+		"Show the row count of each table and the WAL size":              "Her tablonun satır sayısını ve WAL boyutunu göster",
+		"Show the trend of the stored message count over the last week":  "Son bir haftada saklanan mesaj sayısının eğilimini göster",
+		"Send bridged Telegram messages without notification":            "Aktarılan Telegram mesajlarını bildirimsiz gönder",
+		"Always send bridged messages silently":                          "Aktarılan mesajları her zaman sessiz gönder",
+		"Turn off silent mode and silent hours":                          "Sessiz modu ve sessiz saatleri kapat",
+		"Show only the sender's name on bridged messages":                "Aktarılan mesajlarda yalnızca gönderenin adını göster",
+		"Leave out the platform prefix of bridged messages":              "Aktarılan mesajların platform önekini kaldır",
+		"Show the platform prefix of bridged messages again":             "Aktarılan mesajların platform önekini yeniden göster",
+		"Hold bridged messages back before delivering them":              "Aktarılan mesajları teslim etmeden önce beklet",
+		"Deliver bridged messages after a delay":                         "Aktarılan mesajları bir gecikmeyle teslim et",
+		"Seconds to wait before delivering each message":                 "Her mesajı teslim etmeden önce beklenecek saniye",
+		"Deliver bridged messages immediately again":                     "Aktarılan mesajları yeniden hemen teslim et",
+		"Send bridged messages silently during these hours only":         "Aktarılan mesajları yalnızca bu saatlerde sessiz gönder",
+		"Hour silent hours begin (0-23, server time)":                    "Sessiz saatlerin başladığı saat (0-23, sunucu saati)",
+		"Hour silent hours end (0-23, server time)":                      "Sessiz saatlerin bittiği saat (0-23, sunucu saati)",
+		"Restrict who can send through this channel's bridge":            "Bu kanalın köprüsünden kimin gönderebileceğini kısıtla",
+		"Allow a user to send through the bridge":                        "Bir kullanıcının köprüden göndermesine izin ver",
+		"Remove a user from the whitelist":                               "Bir kullanıcıyı beyaz listeden çıkar",
+		"Show the users allowed to send through the bridge":              "Köprüden göndermesine izin verilen kullanıcıları göster",
+		"Choose which message types this channel's bridge carries":       "Bu kanalın köprüsünün taşıyacağı mesaj türlerini seç",
+		"Only bridge these message types":                                "Yalnızca bu mesaj türlerini aktar",
+		"Message types separated by commas, e.g. text, image, or all":    "Virgülle ayrılmış mesaj türleri, ör. text, image veya all",
+		"Show the message types the bridge carries":                      "Köprünün taşıdığı mesaj türlerini göster",
+		"Platform of the user":                                           "Kullanıcının platformu",
+		"User ID on that platform":                                       "O platformdaki kullanıcı kimliği",
+		"Manage content filters for this channel's bridge":               "Bu kanalın köprüsü için içerik filtrelerini yönet",
+		"Add a content filter rule":                                      "İçerik filtresi kuralı ekle",
+		"Word, glob or regex pattern to match":                           "Eşleşecek kelime, glob veya regex deseni",
+		"How the pattern is matched":                                     "Desenin nasıl eşleştirileceği",
+		"What to do with matching messages":                              "Eşleşen mesajlarla ne yapılacağı",
+		"Replacement text (for replace action)":                          "Yerine konacak metin (değiştir eylemi için)",
+		"List content filter rules":                                      "İçerik filtresi kurallarını listele",
+		"Simulate a burst of messages against this channel's rate limit": "Bu kanalın hız sınırına karşı bir mesaj patlamasını simüle et",
+		"Messages arriving at once":                                      "Aynı anda gelen mesajlar",
+		"Try this per-minute limit instead of the configured one":        "Yapılandırılmış sınır yerine bu dakikalık sınırı dene",
+		"Show bot help information":                                      "Bot yardım bilgilerini göster",
+		"Link your Discord account to your Telegram account": "Discord hesabını Telegram hesabına bağla",
+		"Code sent by the Telegram bot for /link": "Telegram botunun /link için gönderdiği kod",
+
+		// Option choices
+		"Exact":   "Tam",
+		"Block":   "Engelle",
+		"Replace": "Değiştir",
+
+		// Help
+		"🌉 Bridge Bot Help":                         "🌉 Köprü Botu Yardımı",
+		"Commands to manage cross-platform bridges": "Platformlar arası köprüleri yönetme komutları",
+		"🔗 Bridge Commands":                         "🔗 Köprü Komutları",
+		"⚙️ Config Commands":                        "⚙️ Ayar Komutları",
+		"ℹ️ General":                                "ℹ️ Genel",
+		"Bridge Bot - Discord Control Center":       "Köprü Botu - Discord Kontrol Merkezi",
+		"Create new bridge":                         "Yeni köprü oluştur",
+		"Set a bridge's delivery priority":          "Bir köprünün teslim önceliğini ayarla",
+		"Create bridges from JSON":                  "JSON'dan köprüler oluştur",
+		"Remove bridge":                             "Köprüyü kaldır",
+		"Undo your last change":                     "Son değişikliğini geri al",
+		"Show the most active users":                "En aktif kullanıcıları göster",
+		"Show bridge statistics":                    "Köprü istatistiklerini göster",
+		"Dry-run a message through the bridge":      "Bir mesajı köprüde deneme olarak çalıştır",
+		"Set the bridge embed color":                "Köprü embed rengini ayarla",
+		"Check the bot's channel permissions":       "Botun kanal izinlerini kontrol et",
+		"Check the platform API credentials":        "Platform API kimlik bilgilerini kontrol et",
+		"Show recent delivery attempts":             "Son teslim denemelerini göster",
+		"Send a health check probe":                 "Sağlık kontrolü gönder",
+		"Create a poll on Telegram":                 "Telegram'da anket oluştur",
+		"Show poll results":                         "Anket sonuçlarını göster",
+		"Add a content filter":                      "İçerik filtresi ekle",
+		"List content filters":                      "İçerik filtrelerini listele",
+		"Mute Telegram notifications":               "Telegram bildirimlerini sessize al",
+		"Hide platform prefixes":                    "Platform öneklerini gizle",
+		"Delay bridged messages":                    "Aktarılan mesajları geciktir",
+		"Restrict who can send through the bridge":  "Köprüden kimin gönderebileceğini kısıtla",
+		"Choose the bridged message types":          "Aktarılan mesaj türlerini seç",
+		"Show recent configuration changes":         "Son yapılandırma değişikliklerini göster",
+		"Delete old bridged messages":               "Eski aktarılmış mesajları sil",
+		"Show database table sizes":                 "Veritabanı tablo boyutlarını göster",
+		"Show the database growth of the last week": "Veritabanının son bir haftadaki büyümesini göster",
+		"Delete unused webhooks":                    "Kullanılmayan webhookları sil",
+		"List bridge templates":                     "Köprü şablonlarını listele",
+		"Simulate a message burst":                  "Bir mesaj patlamasını simüle et",
+		"Show this help message":                    "Bu yardım mesajını göster",
+
+		// Responses
+		"❌ You don't have permission to use this command.":      "❌ Bu komutu kullanma izniniz yok.",
+		"❓ Unknown command":                                     "❓ Bilinmeyen komut",
+		"❌ No subcommand specified":                             "❌ Alt komut belirtilmedi",
+		"❓ Unknown bridge subcommand":                           "❓ Bilinmeyen köprü alt komutu",
+		"❓ Unknown config subcommand":                           "❓ Bilinmeyen ayar alt komutu",
+		"❌ Bridge core is not available":                        "❌ Köprü çekirdeği kullanılamıyor",
+		"❌ Missing required parameters":                         "❌ Gerekli parametreler eksik",
+		"❌ No bridges configured for this channel":              "❌ Bu kanal için yapılandırılmış köprü yok",
+		"❌ Bridge not found. It may have been removed already.": "❌ Köprü bulunamadı. Zaten kaldırılmış olabilir.",
+		"⌛ This confirmation has expired.":                      "⌛ Bu onayın süresi doldu.",
+		"❌ Only the admin who ran this command can confirm it.": "❌ Bunu yalnızca komutu çalıştıran yönetici onaylayabilir.",
+	},
+}
@@ -0,0 +1,49 @@
+package logger
+
+import (
+	"log"
+	"log/slog"
+	"strings"
+	"sync"
+)
+
+var (
+	defaultLevel   = slog.LevelInfo
+	defaultLevelMu sync.RWMutex
+)
+
+// SetDefaultLevel sets the level used for platforms without an explicit log level
+func SetDefaultLevel(level string) {
+	defaultLevelMu.Lock()
+	defaultLevel = ParseLevel(level, slog.LevelInfo)
+	defaultLevelMu.Unlock()
+}
+
+// NewPlatformLogger returns a logger for a platform that drops records below the platform's configured level
+func NewPlatformLogger(platform string, levels map[string]string) *slog.Logger {
+	defaultLevelMu.RLock()
+	level := defaultLevel
+	defaultLevelMu.RUnlock()
+
+	if configured, ok := levels[platform]; ok {
+		level = ParseLevel(configured, level)
+	}
+
+	handler := slog.NewTextHandler(log.Writer(), &slog.HandlerOptions{Level: level})
+	return slog.New(handler).With("platform", platform)
+}
+
+// ParseLevel converts a level name (debug, info, warn, error) to a slog level
+func ParseLevel(level string, fallback slog.Level) slog.Level {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "debug":
+		return slog.LevelDebug
+	case "info":
+		return slog.LevelInfo
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	}
+	return fallback
+}
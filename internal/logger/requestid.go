@@ -0,0 +1,34 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+)
+
+// requestIDKey is the context key holding the ID of the API request being served
+type requestIDKey struct{}
+
+// WithRequestID returns a context carrying a request ID
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request ID carried by a context, or "" if there is none
+func RequestID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// FromContext adds the context's request ID, if any, to a logger
+func FromContext(ctx context.Context, base *slog.Logger) *slog.Logger {
+	if base == nil {
+		base = slog.Default()
+	}
+	if id := RequestID(ctx); id != "" {
+		return base.With(slog.String("request_id", id))
+	}
+	return base
+}
@@ -0,0 +1,198 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// PlatformConnected reports whether each platform passed its last health ping
+var PlatformConnected = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "bridgebot_platform_connected",
+	Help: "Whether the platform passed its last health ping (1) or not (0)",
+}, []string{"platform"})
+
+// MediaBytesTotal counts the bytes of media delivered to each target platform
+var MediaBytesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bridgebot_media_bytes_total",
+	Help: "Total bytes of media bridged to each target platform",
+}, []string{"platform"})
+
+// DiscordDowntimeSeconds counts how long the Discord connection has been down
+var DiscordDowntimeSeconds = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "bridgebot_discord_downtime_seconds_total",
+	Help: "Total seconds the Discord gateway connection was lost",
+})
+
+// AddDiscordDowntime records a period of lost Discord connection
+func AddDiscordDowntime(seconds float64) {
+	DiscordDowntimeSeconds.Add(seconds)
+}
+
+// BridgeTestFailures counts failed bridge health checks
+var BridgeTestFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bridgebot_bridge_test_failures_total",
+	Help: "Total failed bridge health checks per bridge",
+}, []string{"bridge_id"})
+
+// IncBridgeTestFailures records a failed bridge health check
+func IncBridgeTestFailures(bridgeID string) {
+	BridgeTestFailures.WithLabelValues(bridgeID).Inc()
+}
+
+// WatchdogRestarts counts platform clients restarted by the watchdog
+var WatchdogRestarts = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bridgebot_watchdog_restarts_total",
+	Help: "Total platform client restarts triggered by the watchdog",
+}, []string{"platform"})
+
+// IncWatchdogRestarts records a watchdog restart of a platform client
+func IncWatchdogRestarts(platform string) {
+	WatchdogRestarts.WithLabelValues(platform).Inc()
+}
+
+// MessagesFiltered counts messages dropped instead of delivered, by reason
+var MessagesFiltered = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bridgebot_messages_filtered_total",
+	Help: "Total messages dropped instead of bridged, by source, target and reason",
+}, []string{"source_platform", "target_platform", "filter_type"})
+
+// IncMessagesFiltered records a dropped message
+func IncMessagesFiltered(sourcePlatform, targetPlatform, filterType string) {
+	MessagesFiltered.WithLabelValues(sourcePlatform, targetPlatform, filterType).Inc()
+}
+
+// DBPrunedRows counts database rows deleted by the cleanup job
+var DBPrunedRows = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bridgebot_db_pruned_rows_total",
+	Help: "Total database rows deleted to limit table growth",
+}, []string{"table"})
+
+// AddDBPrunedRows records rows deleted from a table by the cleanup job
+func AddDBPrunedRows(table string, rows int64) {
+	DBPrunedRows.WithLabelValues(table).Add(float64(rows))
+}
+
+// MessageDeliveryTimeouts counts messages a target platform did not accept within the delivery timeout
+var MessageDeliveryTimeouts = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bridgebot_message_delivery_timeouts_total",
+	Help: "Total message deliveries that timed out, by target platform",
+}, []string{"platform"})
+
+// IncMessageDeliveryTimeouts records a timed out message delivery
+func IncMessageDeliveryTimeouts(platform string) {
+	MessageDeliveryTimeouts.WithLabelValues(platform).Inc()
+}
+
+// AddMediaBytes records media bytes delivered to a platform
+func AddMediaBytes(platform string, bytes int) {
+	MediaBytesTotal.WithLabelValues(platform).Add(float64(bytes))
+}
+
+// SetPlatformConnected updates the platform connection gauge
+func SetPlatformConnected(platform string, connected bool) {
+	value := 0.0
+	if connected {
+		value = 1
+	}
+	PlatformConnected.WithLabelValues(platform).Set(value)
+}
+
+// APIRateLimited counts API requests rejected by the rate limit
+var APIRateLimited = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bridgebot_api_ratelimit_429_total",
+	Help: "Total API requests rejected with 429 Too Many Requests, by route",
+}, []string{"path"})
+
+// IncAPIRateLimited records an API request rejected by the rate limit
+func IncAPIRateLimited(path string) {
+	APIRateLimited.WithLabelValues(path).Inc()
+}
+
+// BridgeEvents counts events published on the bridge event bus
+var BridgeEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bridgebot_events_total",
+	Help: "Total bridge events published, by event type",
+}, []string{"type"})
+
+// IncBridgeEvents records a published bridge event
+func IncBridgeEvents(eventType string) {
+	BridgeEvents.WithLabelValues(eventType).Inc()
+}
+
+// WebhookFallbacks counts bridged messages sent as bot messages because a Discord channel had no usable webhook
+var WebhookFallbacks = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bridgebot_webhook_fallbacks_total",
+	Help: "Total bridged messages sent without webhook, by Discord channel",
+}, []string{"channel_id"})
+
+// IncWebhookFallbacks records a message sent without webhook
+func IncWebhookFallbacks(channelID string) {
+	WebhookFallbacks.WithLabelValues(channelID).Inc()
+}
+
+// ActiveWebhooks tracks the size of each Discord channel's webhook pool
+var ActiveWebhooks = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "bridgebot_active_webhooks",
+	Help: "Webhooks in the pool of a Discord channel",
+}, []string{"channel_id"})
+
+// SetActiveWebhooks records the webhook pool size of a channel
+func SetActiveWebhooks(channelID string, count int) {
+	ActiveWebhooks.WithLabelValues(channelID).Set(float64(count))
+}
+
+// TransformerDuration measures how long the transformer pipeline takes per message
+var TransformerDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+	Name:    "bridgebot_transformer_duration_seconds",
+	Help:    "Time spent running the transformer pipeline on a message",
+	Buckets: prometheus.DefBuckets,
+})
+
+// ObserveTransformerDuration records the transformer pipeline time of a message
+func ObserveTransformerDuration(duration time.Duration) {
+	TransformerDuration.Observe(duration.Seconds())
+}
+
+// DBWriteDuration measures the database writes made while bridging a message
+var DBWriteDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+	Name:    "bridgebot_db_write_duration_seconds",
+	Help:    "Time spent writing delivery records to the database while bridging a message",
+	Buckets: prometheus.DefBuckets,
+})
+
+// ObserveDBWriteDuration records a database write made while bridging a message
+func ObserveDBWriteDuration(duration time.Duration) {
+	DBWriteDuration.Observe(duration.Seconds())
+}
+
+// PlatformSendDuration measures how long delivering a message to a target platform takes
+var PlatformSendDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "bridgebot_platform_send_duration_seconds",
+	Help:    "Time spent delivering a message, by target platform",
+	Buckets: prometheus.DefBuckets,
+}, []string{"platform"})
+
+// ObservePlatformSendDuration records a delivery to a target platform
+func ObservePlatformSendDuration(platform string, duration time.Duration) {
+	PlatformSendDuration.WithLabelValues(platform).Observe(duration.Seconds())
+}
+
+// UserMessagesTruncated counts long messages shortened by a bridge's per-user length limit
+var UserMessagesTruncated = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bridgebot_user_messages_truncated_total",
+	Help: "Total messages truncated by the per-user length limit, by source platform and bridge",
+}, []string{"platform", "bridge_id"})
+
+// IncUserMessagesTruncated records a message truncated by the per-user length limit
+func IncUserMessagesTruncated(platform, bridgeID string) {
+	UserMessagesTruncated.WithLabelValues(platform, bridgeID).Inc()
+}
+
+// Handler returns the HTTP handler exposing all registered metrics
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -0,0 +1,109 @@
+package notifications
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// Severity levels of admin notifications
+const (
+	LevelError   = "error"
+	LevelWarning = "warning"
+)
+
+// Embed colors of each severity level
+const (
+	colorError   = 0xff0000
+	colorWarning = 0xffa500
+)
+
+// AdminNotifier sends direct messages to Discord admins about critical errors
+type AdminNotifier struct {
+	discordSession *discordgo.Session
+	adminUserIDs   []string
+}
+
+// NewAdminNotifier creates a notifier that DMs the given Discord users
+func NewAdminNotifier(discordSession *discordgo.Session, adminUserIDs []string) *AdminNotifier {
+	return &AdminNotifier{
+		discordSession: discordSession,
+		adminUserIDs:   adminUserIDs,
+	}
+}
+
+// Notify DMs every admin an embed colored by severity, returning the last delivery error
+func (n *AdminNotifier) Notify(level string, title, body string) error {
+	color := colorError
+	if level == LevelWarning {
+		color = colorWarning
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       title,
+		Description: body,
+		Color:       color,
+		Timestamp:   time.Now().Format(time.RFC3339),
+		Footer:      &discordgo.MessageEmbedFooter{Text: strings.ToUpper(level)},
+	}
+
+	var lastErr error
+	for _, adminID := range n.adminUserIDs {
+		channel, err := n.discordSession.UserChannelCreate(adminID)
+		if err != nil {
+			lastErr = fmt.Errorf("failed to open DM channel with admin %s: %v", adminID, err)
+			continue
+		}
+		if _, err := n.discordSession.ChannelMessageSendEmbed(channel.ID, embed); err != nil {
+			lastErr = fmt.Errorf("failed to notify admin %s: %v", adminID, err)
+		}
+	}
+	return lastErr
+}
+
+// HandleError notifies admins of an error reported by the bridge core.
+// The "level" and "title" context entries set the severity and title; other entries are listed below the error.
+func (n *AdminNotifier) HandleError(err error, context map[string]string) {
+	level := context["level"]
+	if level == "" {
+		level = LevelError
+	}
+	title := context["title"]
+	if title == "" {
+		title = "Bridge error"
+	}
+
+	keys := make([]string, 0, len(context))
+	for key := range context {
+		if key != "level" && key != "title" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	body := err.Error()
+	for _, key := range keys {
+		body += fmt.Sprintf("\n**%s**: %s", key, context[key])
+	}
+
+	if notifyErr := n.Notify(level, title, body); notifyErr != nil {
+		log.Printf("⚠️ Failed to notify admins of %q: %v", title, notifyErr)
+	}
+}
+
+// HandleEvent notifies admins of bridge events that need attention, such as a platform going down
+func (n *AdminNotifier) HandleEvent(event types.Event) {
+	if event.Type != types.EventPlatformDisconnected {
+		return
+	}
+
+	body := fmt.Sprintf("%s\n**platform**: %s", event.Data["error"], event.Data["platform"])
+	if err := n.Notify(LevelError, "Platform disconnected", body); err != nil {
+		log.Printf("⚠️ Failed to notify admins of platform disconnect: %v", err)
+	}
+}
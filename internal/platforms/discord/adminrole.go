@@ -0,0 +1,87 @@
+package discord
+
+import (
+	"fmt"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// handleConfigAdminRoleCommand turns admin role sync of the channel's bridge on or off
+func (h *MessageHandler) handleConfigAdminRoleCommand(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if len(options) == 0 {
+		h.respondToInteraction(s, i, "❌ No adminrole subcommand specified")
+		return
+	}
+
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+	if i.GuildID == "" {
+		h.respondToInteraction(s, i, "❌ This command can only be used in a server")
+		return
+	}
+
+	// Members of the role become Telegram group admins, so only server admins may choose it
+	if i.Member == nil || i.Member.Permissions&discordgo.PermissionAdministrator == 0 {
+		h.respondToInteraction(s, i, "❌ Only server administrators can change admin role sync")
+		return
+	}
+
+	previous, err := h.bridgeCore.GetBridgeConfig(types.PlatformDiscord, i.ChannelID)
+	if err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to update admin role sync: %v", err))
+		return
+	}
+
+	config := *previous
+	switch options[0].Name {
+	case "enable":
+		role := getOptionMap(options[0].Options)["role"].RoleValue(s, i.GuildID)
+		if role.ID == i.GuildID || role.Managed {
+			h.respondToInteraction(s, i, "❌ Choose a role that can be given to members, not @everyone or a bot role")
+			return
+		}
+		config.SyncAdminRoles = true
+		config.AdminRoleID = role.ID
+	case "disable":
+		config.SyncAdminRoles = false
+		config.AdminRoleID = ""
+	default:
+		h.respondToInteraction(s, i, "❓ Unknown adminrole subcommand")
+		return
+	}
+
+	if err := h.bridgeCore.UpdateBridgeConfig(types.PlatformDiscord, i.ChannelID, &config); err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to update admin role sync: %v", err))
+		return
+	}
+
+	h.recordAudit(i, AuditActionAdminRole, fmt.Sprintf("discord_%s", i.ChannelID), adminRoleDescription(&config))
+
+	h.pushUndo(interactionUserID(i), &UndoAction{
+		ActionType: UndoActionUpdateConfig,
+		Payload:    &configSnapshot{Platform: types.PlatformDiscord, ChannelID: i.ChannelID, Config: previous},
+	})
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "👮 Admin Role Sync Updated",
+		Description: adminRoleDescription(&config),
+		Color:       0x00ff00,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Applies to users who linked their Discord and Telegram accounts with /link",
+		},
+	}
+
+	h.respondToInteractionWithEmbed(s, i, embed)
+}
+
+// adminRoleDescription describes whether a bridge syncs Telegram group admins with a Discord role
+func adminRoleDescription(config *models.BridgeConfig) string {
+	if !config.SyncAdminRoles || config.AdminRoleID == "" {
+		return "Disabled"
+	}
+	return fmt.Sprintf("Enabled - Telegram group admins get <@&%s>, and its members are promoted on Telegram", config.AdminRoleID)
+}
@@ -0,0 +1,118 @@
+package discord
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// handleConfigAllowTypesCommand handles allowed message type subcommands
+func (h *MessageHandler) handleConfigAllowTypesCommand(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if len(options) == 0 {
+		h.respondToInteraction(s, i, "❌ No allowtypes subcommand specified")
+		return
+	}
+
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	previous, err := h.bridgeCore.GetBridgeConfig(types.PlatformDiscord, i.ChannelID)
+	if err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to get bridge config: %v", err))
+		return
+	}
+
+	switch options[0].Name {
+	case "show":
+		h.respondToInteractionWithEmbed(s, i, allowedTypesEmbed("📋 Allowed Message Types", previous.AllowedMessageTypes))
+		return
+	case "set":
+	default:
+		h.respondToInteraction(s, i, "❓ Unknown allowtypes subcommand")
+		return
+	}
+
+	optionMap := getOptionMap(options[0].Options)
+	allowed, err := parseMessageTypes(optionMap["types"].StringValue())
+	if err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Invalid message types: %v", err))
+		return
+	}
+
+	config := *previous
+	config.AllowedMessageTypes = allowed
+	if err := h.bridgeCore.UpdateBridgeConfig(types.PlatformDiscord, i.ChannelID, &config); err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to update allowed message types: %v", err))
+		return
+	}
+
+	h.recordAudit(i, AuditActionAllowTypes, fmt.Sprintf("discord_%s", i.ChannelID), "set "+describeMessageTypes(allowed))
+
+	h.pushUndo(interactionUserID(i), &UndoAction{
+		ActionType: UndoActionUpdateConfig,
+		Payload:    &configSnapshot{Platform: types.PlatformDiscord, ChannelID: i.ChannelID, Config: previous},
+	})
+
+	h.respondToInteractionWithEmbed(s, i, allowedTypesEmbed("✅ Allowed Message Types Updated", allowed))
+}
+
+// parseMessageTypes parses a comma or space separated list of message types; "all" allows every type
+func parseMessageTypes(value string) (models.StringList, error) {
+	fields := strings.FieldsFunc(strings.ToLower(value), func(r rune) bool { return r == ',' || r == ' ' })
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no message types given")
+	}
+
+	allowed := models.StringList{}
+	for _, field := range fields {
+		if field == "all" {
+			return models.StringList{}, nil
+		}
+		if !slices.Contains(types.AllowableMessageTypes, field) {
+			return nil, fmt.Errorf("unknown message type `%s`, valid types are %s", field, strings.Join(types.AllowableMessageTypes, ", "))
+		}
+		if !allowed.Contains(field) {
+			allowed = append(allowed, field)
+		}
+	}
+	return allowed, nil
+}
+
+// describeMessageTypes lists allowed message types, "all" if every type is allowed
+func describeMessageTypes(allowed models.StringList) string {
+	if len(allowed) == 0 {
+		return "all"
+	}
+	return strings.Join(allowed, ", ")
+}
+
+// allowedTypesEmbed shows which message types are bridged from a channel
+func allowedTypesEmbed(title string, allowed models.StringList) *discordgo.MessageEmbed {
+	description := "Every message type is bridged"
+	if len(allowed) > 0 {
+		lines := make([]string, 0, len(types.AllowableMessageTypes))
+		for _, messageType := range types.AllowableMessageTypes {
+			mark := "❌"
+			if allowed.Contains(messageType) {
+				mark = "✅"
+			}
+			lines = append(lines, fmt.Sprintf("%s `%s`", mark, messageType))
+		}
+		description = strings.Join(lines, "\n")
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       title,
+		Description: description,
+		Color:       0x00ff00,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Opt-in events such as pins and voice activity also need their own setting",
+		},
+	}
+}
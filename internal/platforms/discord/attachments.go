@@ -0,0 +1,88 @@
+package discord
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// SetMaxMediaSizeBytes sets the size above which image attachments are not bridged, 0 for no limit
+func (h *MessageHandler) SetMaxMediaSizeBytes(max int64) {
+	h.maxMediaSizeBytes = max
+}
+
+// attachImages downloads the image attachments of a Discord message into the bridge message
+func (h *MessageHandler) attachImages(message *types.BridgeMessage, attachments []*discordgo.MessageAttachment) {
+	var images []types.MediaFile
+	for _, attachment := range attachments {
+		if !strings.HasPrefix(attachment.ContentType, "image/") {
+			continue
+		}
+
+		if h.maxMediaSizeBytes > 0 && int64(attachment.Size) > h.maxMediaSizeBytes {
+			note := fmt.Sprintf("[File too large to bridge: %s (%s)]", attachment.Filename, formatFileSize(int64(attachment.Size)))
+			message.Content = strings.TrimSpace(message.Content + "\n" + note)
+			continue
+		}
+
+		data, err := downloadAttachment(attachment.URL, h.maxMediaSizeBytes)
+		if err != nil {
+			log.Printf("❌ Failed to download Discord attachment %s: %v", attachment.Filename, err)
+			continue
+		}
+		images = append(images, types.MediaFile{Bytes: data, MimeType: attachment.ContentType, FileName: attachment.Filename})
+	}
+	if len(images) == 0 {
+		return
+	}
+
+	message.MessageType = types.MessageTypeImage
+	message.MediaBytes = images[0].Bytes
+	message.MediaMimeType = images[0].MimeType
+	message.MediaFileName = images[0].FileName
+	if len(images) > 1 {
+		message.MediaGroup = images
+	}
+}
+
+// downloadAttachment downloads a Discord attachment, failing if it is larger than maxBytes (0 for no limit)
+func downloadAttachment(url string, maxBytes int64) ([]byte, error) {
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download file: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("file download failed with status: %d", resp.StatusCode)
+	}
+	if maxBytes <= 0 {
+		return io.ReadAll(resp.Body)
+	}
+
+	// Read one byte past the limit to tell a file of exactly maxBytes from a larger one
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %v", err)
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, fmt.Errorf("file exceeds %d bytes", maxBytes)
+	}
+	return data, nil
+}
+
+// formatFileSize formats a byte count as KB or MB
+func formatFileSize(size int64) string {
+	const mb = 1024 * 1024
+	if size >= mb {
+		return fmt.Sprintf("%dMB", (size+mb/2)/mb)
+	}
+	return fmt.Sprintf("%dKB", (size+512)/1024)
+}
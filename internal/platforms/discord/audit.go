@@ -0,0 +1,209 @@
+package discord
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// Audit log actions recorded by Discord commands
+const (
+	AuditActionBridgeCreate    = "bridge_create"
+	AuditActionBridgeRemove    = "bridge_remove"
+	AuditActionBridgeRemoveAll = "bridge_remove_all"
+	AuditActionBridgeRename    = "bridge_rename"
+	AuditActionBulkImport      = "bulk_import"
+	AuditActionFilterAdd       = "filter_add"
+	AuditActionSilentMode      = "silent_mode"
+	AuditActionCompactMode     = "compact_mode"
+	AuditActionDeliveryDelay   = "delivery_delay"
+	AuditActionSetColor        = "set_color"
+	AuditActionSetPriority     = "set_priority"
+	AuditActionWebhookClean    = "webhook_cleanup"
+	AuditActionWhitelist       = "whitelist"
+	AuditActionAllowTypes      = "allow_types"
+	AuditActionDBPrune         = "db_prune"
+	AuditActionStatsReset      = "stats_reset"
+	AuditActionBridgeMigrate   = "bridge_migrate"
+	AuditActionBulkUpdate      = "bulk_update"
+	AuditActionAdminRole       = "admin_role"
+	AuditActionAccountLink     = "account_link"
+	AuditActionUndo            = "undo"
+)
+
+// auditPageSize is the number of audit log entries shown per page
+const auditPageSize = 20
+
+// auditPageCustomIDPrefix identifies audit log pagination buttons, followed by "offset:platform:action"
+const auditPageCustomIDPrefix = "audit:"
+
+// auditActionChoices lists the actions /config audit can filter by
+func auditActionChoices() []*discordgo.ApplicationCommandOptionChoice {
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, action := range []string{AuditActionBridgeCreate, AuditActionBridgeRemove, AuditActionBridgeRemoveAll, AuditActionBridgeRename, AuditActionBulkImport, AuditActionFilterAdd, AuditActionSilentMode, AuditActionCompactMode, AuditActionDeliveryDelay, AuditActionSetColor, AuditActionSetPriority, AuditActionWebhookClean, AuditActionWhitelist, AuditActionAllowTypes, AuditActionDBPrune, AuditActionStatsReset, AuditActionBridgeMigrate, AuditActionBulkUpdate, AuditActionAdminRole, AuditActionAccountLink, AuditActionUndo} {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: action, Value: action})
+	}
+	return choices
+}
+
+// recordAudit writes an admin action taken through an interaction to the audit log
+func (h *MessageHandler) recordAudit(i *discordgo.InteractionCreate, action, target, details string) {
+	if h.bridgeCore == nil {
+		return
+	}
+
+	err := h.bridgeCore.RecordAudit(&models.AuditLog{
+		Action:        action,
+		ActorPlatform: types.PlatformDiscord,
+		ActorID:       interactionUserID(i),
+		Target:        target,
+		Details:       details,
+	})
+	if err != nil {
+		log.Printf("⚠️ Failed to write audit log for %s: %v", action, err)
+	}
+}
+
+// commandConfigAudit shows the most recent audit log entries to the invoking admin
+func (h *MessageHandler) commandConfigAudit(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	optionMap := getOptionMap(options)
+	platform, action := "", ""
+	if opt, ok := optionMap["platform"]; ok {
+		platform = opt.StringValue()
+	}
+	if opt, ok := optionMap["action"]; ok {
+		action = opt.StringValue()
+	}
+
+	h.respondWithAuditPage(s, i, discordgo.InteractionResponseChannelMessageWithSource, 0, platform, action)
+}
+
+// handleAuditPage shows another page of the audit log when a pagination button is clicked
+func (h *MessageHandler) handleAuditPage(s *discordgo.Session, i *discordgo.InteractionCreate, state string) {
+	parts := strings.SplitN(state, ":", 3)
+	if len(parts) != 3 || h.bridgeCore == nil {
+		return
+	}
+	offset, err := strconv.Atoi(parts[0])
+	if err != nil || offset < 0 {
+		return
+	}
+
+	h.respondWithAuditPage(s, i, discordgo.InteractionResponseUpdateMessage, offset, parts[1], parts[2])
+}
+
+// respondWithAuditPage renders one page of the audit log as an ephemeral embed with pagination buttons
+func (h *MessageHandler) respondWithAuditPage(s *discordgo.Session, i *discordgo.InteractionCreate, responseType discordgo.InteractionResponseType, offset int, platform, action string) {
+	// Fetch one extra entry to know whether there is a next page
+	entries, err := h.bridgeCore.GetAuditLog(auditPageSize+1, offset, platform, action)
+	if err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to load audit log: %v", err))
+		return
+	}
+
+	data := &discordgo.InteractionResponseData{
+		Flags:      discordgo.MessageFlagsEphemeral,
+		Embeds:     []*discordgo.MessageEmbed{},
+		Components: []discordgo.MessageComponent{},
+	}
+
+	if len(entries) == 0 {
+		data.Content = "No audit log entries found"
+	} else {
+		hasNext := len(entries) > auditPageSize
+		if hasNext {
+			entries = entries[:auditPageSize]
+		}
+
+		var lines []string
+		for _, entry := range entries {
+			lines = append(lines, formatAuditEntry(entry))
+		}
+
+		filters := "All changes"
+		if platform != "" || action != "" {
+			filters = strings.TrimSpace(fmt.Sprintf("%s %s", platform, action))
+		}
+
+		data.Content = ""
+		data.Embeds = []*discordgo.MessageEmbed{
+			{
+				Title:       "📜 Audit Log",
+				Description: strings.Join(lines, "\n"),
+				Color:       0x0099ff,
+				Footer: &discordgo.MessageEmbedFooter{
+					Text: fmt.Sprintf("%s • Entries %d-%d", filters, offset+1, offset+len(entries)),
+				},
+			},
+		}
+		data.Components = []discordgo.MessageComponent{
+			discordgo.ActionsRow{
+				Components: []discordgo.MessageComponent{
+					discordgo.Button{
+						Label:    "◀ Previous",
+						Style:    discordgo.SecondaryButton,
+						CustomID: auditPageCustomID(offset-auditPageSize, platform, action),
+						Disabled: offset == 0,
+					},
+					discordgo.Button{
+						Label:    "▶ Next",
+						Style:    discordgo.SecondaryButton,
+						CustomID: auditPageCustomID(offset+auditPageSize, platform, action),
+						Disabled: !hasNext,
+					},
+				},
+			},
+		}
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: responseType,
+		Data: data,
+	})
+	if err != nil {
+		log.Printf("❌ Failed to respond with audit log: %v", err)
+	}
+}
+
+// auditPageCustomID builds the custom ID of a pagination button
+func auditPageCustomID(offset int, platform, action string) string {
+	if offset < 0 {
+		offset = 0
+	}
+	return fmt.Sprintf("%s%d:%s:%s", auditPageCustomIDPrefix, offset, platform, action)
+}
+
+// formatAuditEntry renders an audit log entry as a single line
+func formatAuditEntry(entry *models.AuditLog) string {
+	actor := entry.ActorID
+	if entry.ActorPlatform == types.PlatformDiscord && actor != "" {
+		actor = fmt.Sprintf("<@%s>", actor)
+	} else if actor != "" {
+		actor = fmt.Sprintf("%s `%s`", strings.Title(entry.ActorPlatform), actor)
+	} else {
+		actor = "system"
+	}
+
+	line := fmt.Sprintf("<t:%d:f> %s **%s**", entry.CreatedAt.Unix(), actor, entry.Action)
+	if entry.Target != "" {
+		line += fmt.Sprintf(" `%s`", entry.Target)
+	}
+	if entry.Details != "" {
+		details := []rune(entry.Details)
+		if len(details) > 60 {
+			details = append(details[:57], []rune("...")...)
+		}
+		line += " - " + string(details)
+	}
+	return line
+}
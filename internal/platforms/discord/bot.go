@@ -2,26 +2,49 @@ package discord
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
 )
 
+// DefaultGatewayIntents are the gateway events the bot subscribes to when no intents are configured
+const DefaultGatewayIntents = discordgo.IntentsGuilds | discordgo.IntentsGuildMessages | discordgo.IntentsGuildMessageReactions |
+	discordgo.IntentsGuildVoiceStates | discordgo.IntentsGuildMembers
+
+// Application flags telling that the privileged GUILD_MEMBERS intent is enabled for the bot
+const (
+	applicationFlagGatewayGuildMembers        = 1 << 14
+	applicationFlagGatewayGuildMembersLimited = 1 << 15
+)
+
 // Client represents a Discord bot client
 type Client struct {
-	session     *discordgo.Session
-	token       string
-	guildID     string
-	isConnected bool
-	webhooks    map[string]string // channelID -> webhookURL mapping
+	session          *discordgo.Session
+	token            string
+	isConnected      bool
+	webhooks         map[string][]*pooledWebhook // channelID -> webhook pool
+	maxWebhooks      int                         // Webhooks a channel's pool may grow to
+	webhooksMu       sync.Mutex
+	webhookStore     WebhookStore // Optional, persists webhooks across restarts
+	registeredGuilds map[string]bool   // guilds that already have slash commands
+	registeredMu     sync.Mutex
+	logger           *slog.Logger
+	sentStore        SentMessageStore // Optional, records sent messages for loop prevention
 }
 
-// NewClient creates a new Discord client
-func NewClient(token, guildID string) (*Client, error) {
+// NewClient creates a new Discord client subscribed to the given gateway intents, DefaultGatewayIntents if 0
+func NewClient(token string, intents uint, logger *slog.Logger) (*Client, error) {
 	if token == "" {
 		return nil, fmt.Errorf("Discord bot token is required")
 	}
@@ -32,12 +55,22 @@ func NewClient(token, guildID string) (*Client, error) {
 		return nil, fmt.Errorf("error creating Discord session: %v", err)
 	}
 
+	session.Identify.Intents = DefaultGatewayIntents
+	if intents != 0 {
+		session.Identify.Intents = discordgo.Intent(intents)
+	}
+
 	client := &Client{
-		session:     session,
-		token:       token,
-		guildID:     guildID,
-		isConnected: false,
-		webhooks:    make(map[string]string),
+		session:          session,
+		token:            token,
+		isConnected:      false,
+		webhooks:         make(map[string][]*pooledWebhook),
+		maxWebhooks:      defaultMaxWebhooksPerChannel,
+		registeredGuilds: make(map[string]bool),
+		logger:           logger,
+	}
+	if client.logger == nil {
+		client.logger = slog.Default()
 	}
 
 	return client, nil
@@ -78,21 +111,86 @@ func (c *Client) Disconnect() error {
 	return nil
 }
 
+// Reconnect closes the gateway connection and opens a new one
+func (c *Client) Reconnect() error {
+	if err := c.Disconnect(); err != nil {
+		// The session may already be broken; force the state so Connect reopens it
+		c.isConnected = false
+	}
+	return c.Connect()
+}
+
+// hasIntent reports whether the client requested a gateway intent
+func (c *Client) hasIntent(intent discordgo.Intent) bool {
+	return c.session.Identify.Intents&intent == intent
+}
+
 // IsConnected returns whether the client is connected
 func (c *Client) IsConnected() bool {
 	return c.isConnected
 }
 
+// Ping verifies the gateway connection is alive using the last heartbeat latency
+func (c *Client) Ping() error {
+	if !c.isConnected {
+		return fmt.Errorf("Discord client is not connected")
+	}
+
+	if c.session.HeartbeatLatency() == 0 {
+		return fmt.Errorf("no heartbeat latency reported by Discord gateway")
+	}
+
+	return nil
+}
+
+// TestCredentials verifies the bot token with an API call and returns the bot's username
+func (c *Client) TestCredentials(ctx context.Context) (string, error) {
+	user, err := c.session.User("@me", discordgo.WithContext(ctx))
+	if err != nil {
+		var restErr *discordgo.RESTError
+		if errors.As(err, &restErr) && restErr.Response != nil && restErr.Response.StatusCode == http.StatusUnauthorized {
+			return "", fmt.Errorf("401 Unauthorized - token may be invalid")
+		}
+		return "", err
+	}
+	return user.Username, nil
+}
+
 // SendMessage sends a message to a Discord channel
 func (c *Client) SendMessage(channelID, message string) error {
+	return c.SendMessageContext(context.Background(), channelID, message)
+}
+
+// SendMessageContext sends a message to a Discord channel, giving up when ctx is done
+func (c *Client) SendMessageContext(ctx context.Context, channelID, message string) error {
 	if !c.isConnected {
 		return fmt.Errorf("Discord client is not connected")
 	}
 
-	_, err := c.session.ChannelMessageSend(channelID, message)
+	sent, err := c.session.ChannelMessageSend(channelID, message, discordgo.WithContext(ctx))
 	if err != nil {
 		return fmt.Errorf("error sending message to Discord: %v", err)
 	}
+	c.recordSent(sent)
+
+	return nil
+}
+
+// SendDirectMessage sends a private message to a Discord user
+func (c *Client) SendDirectMessage(userID, message string) error {
+	if !c.isConnected {
+		return fmt.Errorf("Discord client is not connected")
+	}
+
+	channel, err := c.session.UserChannelCreate(userID)
+	if err != nil {
+		return fmt.Errorf("error opening DM channel: %v", err)
+	}
+
+	_, err = c.session.ChannelMessageSend(channel.ID, message)
+	if err != nil {
+		return fmt.Errorf("error sending DM to Discord user: %v", err)
+	}
 
 	return nil
 }
@@ -103,25 +201,61 @@ func (c *Client) SendEmbed(channelID string, embed *discordgo.MessageEmbed) erro
 		return fmt.Errorf("Discord client is not connected")
 	}
 
-	_, err := c.session.ChannelMessageSendEmbed(channelID, embed)
+	sent, err := c.session.ChannelMessageSendEmbed(channelID, embed)
 	if err != nil {
 		return fmt.Errorf("error sending embed to Discord: %v", err)
 	}
+	c.recordSent(sent)
+
+	return nil
+}
+
+// SendEmbedWithFile sends an embed together with a file attachment, which the embed can reference as attachment://filename
+func (c *Client) SendEmbedWithFile(channelID string, embed *discordgo.MessageEmbed, filename string, data io.Reader) error {
+	if !c.isConnected {
+		return fmt.Errorf("Discord client is not connected")
+	}
+
+	sent, err := c.session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{embed},
+		Files: []*discordgo.File{
+			{Name: filename, Reader: data},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error sending embed with file to Discord: %v", err)
+	}
+	c.recordSent(sent)
+
+	return nil
+}
+
+// SendFileMessage sends a message with a file attachment to a Discord channel
+func (c *Client) SendFileMessage(channelID, content, filename string, data io.Reader) error {
+	if !c.isConnected {
+		return fmt.Errorf("Discord client is not connected")
+	}
+
+	sent, err := c.session.ChannelFileSendWithMessage(channelID, content, filename, data)
+	if err != nil {
+		return fmt.Errorf("error sending file to Discord: %v", err)
+	}
+	c.recordSent(sent)
 
 	return nil
 }
 
-// GetGuildChannels returns all channels in the configured guild
-func (c *Client) GetGuildChannels() ([]*discordgo.Channel, error) {
+// GetGuildChannels returns all channels in a guild
+func (c *Client) GetGuildChannels(guildID string) ([]*discordgo.Channel, error) {
 	if !c.isConnected {
 		return nil, fmt.Errorf("Discord client is not connected")
 	}
 
-	if c.guildID == "" {
-		return nil, fmt.Errorf("guild ID not configured")
+	if guildID == "" {
+		return nil, fmt.Errorf("guild ID is required")
 	}
 
-	channels, err := c.session.GuildChannels(c.guildID)
+	channels, err := c.session.GuildChannels(guildID)
 	if err != nil {
 		return nil, fmt.Errorf("error getting guild channels: %v", err)
 	}
@@ -129,6 +263,142 @@ func (c *Client) GetGuildChannels() ([]*discordgo.Channel, error) {
 	return channels, nil
 }
 
+// GetMessage returns a message from a channel
+func (c *Client) GetMessage(channelID, messageID string) (*discordgo.Message, error) {
+	if !c.isConnected {
+		return nil, fmt.Errorf("Discord client is not connected")
+	}
+
+	message, err := c.session.ChannelMessage(channelID, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting message: %v", err)
+	}
+
+	return message, nil
+}
+
+// CreateThread starts a public thread in a channel and returns its ID
+func (c *Client) CreateThread(parentChannelID, topicName string) (string, error) {
+	if !c.isConnected {
+		return "", fmt.Errorf("Discord client is not connected")
+	}
+
+	thread, err := c.session.ThreadStartComplex(parentChannelID, &discordgo.ThreadStart{
+		Name:                topicName,
+		Type:                discordgo.ChannelTypeGuildPublicThread,
+		AutoArchiveDuration: 10080,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create thread: %v", err)
+	}
+
+	log.Printf("🧵 Created Discord thread %q in channel %s", topicName, parentChannelID)
+	return thread.ID, nil
+}
+
+// PinMessage pins a message, failing if the bot lacks the Manage Messages permission in the channel
+func (c *Client) PinMessage(channelID, messageID string) error {
+	if !c.isConnected {
+		return fmt.Errorf("Discord client is not connected")
+	}
+
+	permissions, err := c.session.State.UserChannelPermissions(c.session.State.User.ID, channelID)
+	if err != nil {
+		return fmt.Errorf("error getting channel permissions: %v", err)
+	}
+	if permissions&discordgo.PermissionManageMessages == 0 {
+		return fmt.Errorf("missing Manage Messages permission in channel %s", channelID)
+	}
+
+	if err := c.session.ChannelMessagePin(channelID, messageID); err != nil {
+		return fmt.Errorf("error pinning message: %v", err)
+	}
+	return nil
+}
+
+// SetChannelTopic replaces the topic of a channel, failing if the bot lacks the Manage Channels permission
+func (c *Client) SetChannelTopic(channelID, topic string) error {
+	if !c.isConnected {
+		return fmt.Errorf("Discord client is not connected")
+	}
+
+	permissions, err := c.session.State.UserChannelPermissions(c.session.State.User.ID, channelID)
+	if err != nil {
+		return fmt.Errorf("error getting channel permissions: %v", err)
+	}
+	if permissions&discordgo.PermissionManageChannels == 0 {
+		return fmt.Errorf("missing Manage Channels permission in channel %s", channelID)
+	}
+
+	if _, err := c.session.ChannelEdit(channelID, &discordgo.ChannelEdit{Topic: topic}); err != nil {
+		return fmt.Errorf("error setting channel topic: %v", err)
+	}
+	return nil
+}
+
+// SetMemberRole gives a role to a server member or takes it away, which needs the Manage Roles permission
+func (c *Client) SetMemberRole(guildID, userID, roleID string, granted bool) error {
+	if !c.isConnected {
+		return fmt.Errorf("Discord client is not connected")
+	}
+
+	if granted {
+		if err := c.session.GuildMemberRoleAdd(guildID, userID, roleID); err != nil {
+			return fmt.Errorf("error adding member role: %v", err)
+		}
+		return nil
+	}
+	if err := c.session.GuildMemberRoleRemove(guildID, userID, roleID); err != nil {
+		return fmt.Errorf("error removing member role: %v", err)
+	}
+	return nil
+}
+
+// AddTemporaryReaction reacts to a message and removes the reaction after duration, failing if the bot lacks the Add Reactions permission
+func (c *Client) AddTemporaryReaction(channelID, messageID, emoji string, duration time.Duration) error {
+	if !c.isConnected {
+		return fmt.Errorf("Discord client is not connected")
+	}
+
+	permissions, err := c.session.State.UserChannelPermissions(c.session.State.User.ID, channelID)
+	if err != nil {
+		return fmt.Errorf("error getting channel permissions: %v", err)
+	}
+	if permissions&discordgo.PermissionAddReactions == 0 {
+		return fmt.Errorf("missing Add Reactions permission in channel %s", channelID)
+	}
+
+	if err := c.session.MessageReactionAdd(channelID, messageID, emoji); err != nil {
+		return fmt.Errorf("error adding reaction: %v", err)
+	}
+
+	time.AfterFunc(duration, func() {
+		if err := c.session.MessageReactionRemove(channelID, messageID, emoji, "@me"); err != nil {
+			log.Printf("⚠️ Failed to remove reaction %s from Discord message %s: %v", emoji, messageID, err)
+		}
+	})
+	return nil
+}
+
+// Session returns the underlying Discord session
+func (c *Client) Session() *discordgo.Session {
+	return c.session
+}
+
+// GetGuildWithCounts returns a Discord server along with its approximate member count
+func (c *Client) GetGuildWithCounts(guildID string) (*discordgo.Guild, error) {
+	if !c.isConnected {
+		return nil, fmt.Errorf("Discord client is not connected")
+	}
+
+	guild, err := c.session.GuildWithCounts(guildID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting guild info: %v", err)
+	}
+
+	return guild, nil
+}
+
 // GetChannel returns information about a specific channel
 func (c *Client) GetChannel(channelID string) (*discordgo.Channel, error) {
 	if !c.isConnected {
@@ -143,8 +413,37 @@ func (c *Client) GetChannel(channelID string) (*discordgo.Channel, error) {
 	return channel, nil
 }
 
-// RegisterCommands registers slash commands for the bot
-func (c *Client) RegisterCommands() error {
+// RegisterCommands registers slash commands for the bot in a guild, once per guild, or globally if guildID is empty
+func (c *Client) RegisterCommands(guildID string) error {
+	if guildID == "" {
+		return c.RegisterCommandsBulk("")
+	}
+
+	c.registeredMu.Lock()
+	defer c.registeredMu.Unlock()
+	if c.registeredGuilds[guildID] {
+		return nil
+	}
+
+	commands := slashCommands()
+	for _, command := range commands {
+		_, err := c.session.ApplicationCommandCreate(c.session.State.User.ID, guildID, command)
+		if isCommandCreateLimited(err) {
+			log.Printf("⚠️ Creating Discord commands in guild %s is rate limited, overwriting them in one request", guildID)
+			return c.overwriteCommands(guildID, commands)
+		}
+		if err != nil {
+			return c.commandRegistrationError(command.Name, err)
+		}
+	}
+
+	c.registeredGuilds[guildID] = true
+	log.Printf("✅ Discord slash commands registered for guild %s", guildID)
+	return nil
+}
+
+// slashCommands returns the localized slash commands of the bot
+func slashCommands() []*discordgo.ApplicationCommand {
 	commands := []*discordgo.ApplicationCommand{
 		{
 			Name:        "bridge",
@@ -170,16 +469,229 @@ func (c *Client) RegisterCommands() error {
 									Name:  "Telegram",
 									Value: "telegram",
 								},
+								{
+									Name:  "Discord",
+									Value: "discord",
+								},
 							},
 						},
 						{
 							Type:        discordgo.ApplicationCommandOptionString,
 							Name:        "room",
-							Description: "Target room/chat ID",
+							Description: "Target chat ID or Telegram invite code (see /getid)",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "Human-readable bridge name",
+							Required:    false,
+							MaxLength:   50,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "template",
+							Description: "Bridge template to configure the bridge with (see /config templates list)",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "leaderboard",
+					Description: "Show the most active users of a bridge",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "bridge_id",
+							Description:  "Bridge to show (defaults to this channel's bridge)",
+							Required:     false,
+							Autocomplete: true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "setcolor",
+					Description: "Set the embed color of this channel's bridge",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "color",
+							Description: "Hex color, e.g. #FF5500",
+							Required:    true,
+							MaxLength:   7,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "stats",
+					Description: "Show bridge statistics and dropped message counts",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "permissions",
+					Description: "Check the bot's permissions in every bridged channel of this server",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "testconnection",
+					Description: "Check that the API credentials of every platform are still valid",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "simulate",
+					Description: "Show how a message from this channel would be bridged, without sending it",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "content",
+							Description: "Message content to test",
 							Required:    true,
 						},
 					},
 				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "test",
+					Description: "Send a health check probe through a bridge",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "bridge_id",
+							Description:  "Bridge to test (defaults to this channel's bridge)",
+							Required:     false,
+							Autocomplete: true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "events",
+					Description: "Show the last delivery attempts of a bridge",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "bridge_id",
+							Description:  "Bridge to show (defaults to this channel's bridge)",
+							Required:     false,
+							Autocomplete: true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "resetstats",
+					Description: "Reset the message counts and delivery events of a bridge",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "bridge_id",
+							Description:  "Bridge to reset (defaults to this channel's bridge)",
+							Required:     false,
+							Autocomplete: true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "migrate",
+					Description: "Move all bridges of a channel to another channel",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "old_channel_id",
+							Description: "Channel whose bridges are moved",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "new_channel_id",
+							Description: "Channel the bridges are moved to",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "poll",
+					Description: "Create and track polls in the bridged Telegram chat",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "create",
+							Description: "Create a poll in this channel's Telegram chat",
+							Options:     pollCreateOptions(),
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "results",
+							Description: "Show the current results of a poll",
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionInteger,
+									Name:        "poll_id",
+									Description: "Poll ID returned when the poll was created",
+									Required:    true,
+								},
+							},
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "undo",
+					Description: "Undo your most recent bridge removal or config change",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "import",
+					Description: "Create several bridges from a JSON payload",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "rename",
+					Description: "Rename a bridge",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "bridge_id",
+							Description:  "Bridge to rename",
+							Required:     true,
+							Autocomplete: true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "New bridge name",
+							Required:    true,
+							MaxLength:   50,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "setpriority",
+					Description: "Set the delivery priority of a bridge's target channel",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "bridge_id",
+							Description:  "Bridge to prioritize",
+							Required:     true,
+							Autocomplete: true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "priority",
+							Description: "Priority from 0 to 10, higher is delivered first",
+							Required:    true,
+							MinValue:    &minPriority,
+							MaxValue:    maxPriority,
+						},
+					},
+				},
 				{
 					Type:        discordgo.ApplicationCommandOptionSubCommand,
 					Name:        "remove",
@@ -188,17 +700,26 @@ func (c *Client) RegisterCommands() error {
 						{
 							Type:        discordgo.ApplicationCommandOptionString,
 							Name:        "platform",
-							Description: "Platform to remove bridge from",
-							Required:    true,
+							Description: "Platform to remove bridge from (omit to pick from a list)",
+							Required:    false,
 							Choices: []*discordgo.ApplicationCommandOptionChoice{
 								{
 									Name:  "Telegram",
 									Value: "telegram",
 								},
+								{
+									Name:  "Discord",
+									Value: "discord",
+								},
 							},
 						},
 					},
 				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "removeall",
+					Description: "Remove all bridges of this server",
+				},
 			},
 		},
 		{
@@ -215,6 +736,361 @@ func (c *Client) RegisterCommands() error {
 					Name:        "channels",
 					Description: "List available channels",
 				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "audit",
+					Description: "Show recent bridge configuration changes",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "platform",
+							Description: "Only show changes made from this platform",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{
+									Name:  "Discord",
+									Value: "discord",
+								},
+								{
+									Name:  "Telegram",
+									Value: "telegram",
+								},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "action",
+							Description: "Only show this kind of change",
+							Required:    false,
+							Choices:     auditActionChoices(),
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "webhooks",
+					Description: "Manage the webhooks used for bridged messages",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "cleanup",
+							Description: "Delete bridge webhooks in this server that are no longer used",
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "templates",
+					Description: "Predefined bridge configurations",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "list",
+							Description: "List the bridge templates",
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "db",
+					Description: "Maintain the bridge database",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "prune",
+							Description: "Delete the oldest stored messages beyond the configured limits",
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "stats",
+							Description: "Show the row count of each table and the WAL size",
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "history",
+							Description: "Show the trend of the stored message count over the last week",
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "rate-test",
+					Description: "Simulate a burst of messages against this channel's rate limit",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "messages_per_minute",
+							Description: "Messages arriving at once",
+							Required:    true,
+							MinValue:    &minRateTestMessages,
+							MaxValue:    10000,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "limit",
+							Description: "Try this per-minute limit instead of the configured one",
+							Required:    false,
+							MinValue:    &minRateTestMessages,
+							MaxValue:    10000,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "bulkupdate",
+					Description: "Change a setting of every bridge in this server",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "field",
+							Description: "Setting to change, e.g. max_message_length",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "value",
+							Description: "New value of the setting",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "adminrole",
+					Description: "Sync Telegram group admins with a Discord role",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "enable",
+							Description: "Give this role to Telegram group admins and promote its members on Telegram",
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionRole,
+									Name:        "role",
+									Description: "Discord role matching Telegram group admin",
+									Required:    true,
+								},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "disable",
+							Description: "Stop syncing admins of this channel's bridge",
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "delay",
+					Description: "Hold bridged messages back before delivering them",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "set",
+							Description: "Deliver bridged messages after a delay",
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionInteger,
+									Name:        "seconds",
+									Description: "Seconds to wait before delivering each message",
+									Required:    true,
+									MinValue:    &minDeliveryDelay,
+									MaxValue:    maxDeliveryDelaySeconds,
+								},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "clear",
+							Description: "Deliver bridged messages immediately again",
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "compact",
+					Description: "Show only the sender's name on bridged messages",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "enable",
+							Description: "Leave out the platform prefix of bridged messages",
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "disable",
+							Description: "Show the platform prefix of bridged messages again",
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "silent",
+					Description: "Send bridged Telegram messages without notification",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "enable",
+							Description: "Always send bridged messages silently",
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "disable",
+							Description: "Turn off silent mode and silent hours",
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "hours",
+							Description: "Send bridged messages silently during these hours only",
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionInteger,
+									Name:        "start",
+									Description: "Hour silent hours begin (0-23, server time)",
+									Required:    true,
+									MinValue:    &minHour,
+									MaxValue:    23,
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionInteger,
+									Name:        "end",
+									Description: "Hour silent hours end (0-23, server time)",
+									Required:    true,
+									MinValue:    &minHour,
+									MaxValue:    23,
+								},
+							},
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "whitelist",
+					Description: "Restrict who can send through this channel's bridge",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "add",
+							Description: "Allow a user to send through the bridge",
+							Options:     whitelistUserOptions(),
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "remove",
+							Description: "Remove a user from the whitelist",
+							Options:     whitelistUserOptions(),
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "list",
+							Description: "Show the users allowed to send through the bridge",
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "allowtypes",
+					Description: "Choose which message types this channel's bridge carries",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "set",
+							Description: "Only bridge these message types",
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "types",
+									Description: "Message types separated by commas, e.g. text, image, or all",
+									Required:    true,
+								},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "show",
+							Description: "Show the message types the bridge carries",
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "filter",
+					Description: "Manage content filters for this channel's bridge",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "add",
+							Description: "Add a content filter rule",
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "pattern",
+									Description: "Word, glob or regex pattern to match",
+									Required:    true,
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "mode",
+									Description: "How the pattern is matched",
+									Required:    false,
+									Choices: []*discordgo.ApplicationCommandOptionChoice{
+										{
+											Name:  "Exact",
+											Value: "exact",
+										},
+										{
+											Name:  "Glob",
+											Value: "glob",
+										},
+										{
+											Name:  "Regex",
+											Value: "regex",
+										},
+									},
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "action",
+									Description: "What to do with matching messages",
+									Required:    false,
+									Choices: []*discordgo.ApplicationCommandOptionChoice{
+										{
+											Name:  "Block",
+											Value: "block",
+										},
+										{
+											Name:  "Replace",
+											Value: "replace",
+										},
+									},
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "replacement",
+									Description: "Replacement text (for replace action)",
+									Required:    false,
+								},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "list",
+							Description: "List content filter rules",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "link",
+			Description: "Link your Discord account to your Telegram account",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "code",
+					Description: "Code sent by the Telegram bot for /link",
+					Required:    true,
+				},
 			},
 		},
 		{
@@ -223,21 +1099,8 @@ func (c *Client) RegisterCommands() error {
 		},
 	}
 
-	guildID := c.guildID
-	if guildID == "" {
-		// Register global commands if no guild specified
-		guildID = ""
-	}
-
-	for _, command := range commands {
-		_, err := c.session.ApplicationCommandCreate(c.session.State.User.ID, guildID, command)
-		if err != nil {
-			return fmt.Errorf("cannot create command %s: %v", command.Name, err)
-		}
-	}
-
-	log.Printf("✅ Discord slash commands registered successfully")
-	return nil
+	localizeCommands(commands)
+	return commands
 }
 
 // SetMessageHandler sets the message create handler
@@ -255,6 +1118,66 @@ func (c *Client) SetReadyHandler(handler func(*discordgo.Session, *discordgo.Rea
 	c.session.AddHandler(handler)
 }
 
+// SetGuildCreateHandler sets the guild create event handler
+func (c *Client) SetGuildCreateHandler(handler func(*discordgo.Session, *discordgo.GuildCreate)) {
+	c.session.AddHandler(handler)
+}
+
+// SetGuildUpdateHandler sets the guild update event handler
+func (c *Client) SetGuildUpdateHandler(handler func(*discordgo.Session, *discordgo.GuildUpdate)) {
+	c.session.AddHandler(handler)
+}
+
+// SetMessageReactionAddHandler sets the reaction add handler
+func (c *Client) SetMessageReactionAddHandler(handler func(*discordgo.Session, *discordgo.MessageReactionAdd)) {
+	c.session.AddHandler(handler)
+}
+
+// SetMessageReactionRemoveHandler sets the reaction remove handler
+func (c *Client) SetMessageReactionRemoveHandler(handler func(*discordgo.Session, *discordgo.MessageReactionRemove)) {
+	c.session.AddHandler(handler)
+}
+
+// SetConnectHandler sets the gateway connect event handler
+func (c *Client) SetConnectHandler(handler func(*discordgo.Session, *discordgo.Connect)) {
+	c.session.AddHandler(handler)
+}
+
+// SetDisconnectHandler sets the gateway disconnect event handler
+func (c *Client) SetDisconnectHandler(handler func(*discordgo.Session, *discordgo.Disconnect)) {
+	c.session.AddHandler(handler)
+}
+
+// SetThreadCreateHandler sets the thread create handler
+func (c *Client) SetThreadCreateHandler(handler func(*discordgo.Session, *discordgo.ThreadCreate)) {
+	c.session.AddHandler(handler)
+}
+
+// SetThreadUpdateHandler sets the thread update handler
+func (c *Client) SetThreadUpdateHandler(handler func(*discordgo.Session, *discordgo.ThreadUpdate)) {
+	c.session.AddHandler(handler)
+}
+
+// SetChannelUpdateHandler sets the channel update handler
+func (c *Client) SetChannelUpdateHandler(handler func(*discordgo.Session, *discordgo.ChannelUpdate)) {
+	c.session.AddHandler(handler)
+}
+
+// SetGuildMemberUpdateHandler sets the guild member update handler
+func (c *Client) SetGuildMemberUpdateHandler(handler func(*discordgo.Session, *discordgo.GuildMemberUpdate)) {
+	c.session.AddHandler(handler)
+}
+
+// SetChannelPinsUpdateHandler sets the channel pins update handler
+func (c *Client) SetChannelPinsUpdateHandler(handler func(*discordgo.Session, *discordgo.ChannelPinsUpdate)) {
+	c.session.AddHandler(handler)
+}
+
+// SetVoiceStateUpdateHandler sets the voice state update handler
+func (c *Client) SetVoiceStateUpdateHandler(handler func(*discordgo.Session, *discordgo.VoiceStateUpdate)) {
+	c.session.AddHandler(handler)
+}
+
 // GetBotUser returns the bot user information
 func (c *Client) GetBotUser() *discordgo.User {
 	if c.session.State != nil {
@@ -270,31 +1193,38 @@ type WebhookPayload struct {
 	AvatarURL string `json:"avatar_url,omitempty"`
 }
 
-// GetOrCreateWebhook gets or creates a webhook for a channel
+// GetOrCreateWebhook returns the least recently used webhook of a channel, creating the first one if needed
 func (c *Client) GetOrCreateWebhook(channelID string) (string, error) {
-	// Check if we already have a webhook for this channel
-	if webhookURL, exists := c.webhooks[channelID]; exists {
-		return webhookURL, nil
-	}
+	c.webhooksMu.Lock()
+	defer c.webhooksMu.Unlock()
 
-	// Create a new webhook
-	webhook, err := c.session.WebhookCreate(channelID, "Bridge Bot", "")
-	if err != nil {
-		return "", fmt.Errorf("failed to create webhook: %v", err)
+	pool := c.webhookPool(channelID)
+	if len(pool) == 0 {
+		webhook, err := c.addWebhook(channelID)
+		if err != nil {
+			return "", err
+		}
+		return webhook.url, nil
 	}
 
-	webhookURL := fmt.Sprintf("https://discord.com/api/webhooks/%s/%s", webhook.ID, webhook.Token)
-	c.webhooks[channelID] = webhookURL
-
-	log.Printf("✅ Created Discord webhook for channel %s", channelID)
-	return webhookURL, nil
+	// A rate limited webhook still shows the channel has one
+	if webhook := leastRecentlyUsed(pool, time.Now()); webhook != nil {
+		return webhook.url, nil
+	}
+	return pool[0].url, nil
 }
 
 // SendWebhookMessage sends a message via webhook with custom username and avatar
-func (c *Client) SendWebhookMessage(channelID, content, username, avatarURL string) error {
-	webhookURL, err := c.GetOrCreateWebhook(channelID)
-	if err != nil {
-		return fmt.Errorf("failed to get webhook: %v", err)
+func (c *Client) SendWebhookMessage(ctx context.Context, channelID, content, username, avatarURL string) error {
+	return c.SendWebhookThreadMessage(ctx, channelID, "", content, username, avatarURL)
+}
+
+// SendWebhookThreadMessage sends a message via the webhook of a channel into one of its threads, or the channel itself if threadID is empty
+func (c *Client) SendWebhookThreadMessage(ctx context.Context, channelID, threadID, content, username, avatarURL string) error {
+	// wait=true makes Discord return the created message, so its ID can be recorded
+	query := url.Values{"wait": {"true"}}
+	if threadID != "" {
+		query.Set("thread_id", threadID)
 	}
 
 	// Create webhook payload
@@ -310,17 +1240,52 @@ func (c *Client) SendWebhookMessage(channelID, content, username, avatarURL stri
 		return fmt.Errorf("failed to marshal webhook payload: %v", err)
 	}
 
+	// A rate limited webhook is skipped for the next one in the channel's pool
+	for {
+		webhook, err := c.acquireWebhook(channelID)
+		if err != nil {
+			return fmt.Errorf("failed to get webhook: %v", err)
+		}
+
+		err = c.executeWebhook(ctx, channelID, webhook.url+"?"+query.Encode(), jsonData)
+		var rateLimited *webhookRateLimitError
+		if !errors.As(err, &rateLimited) {
+			return err
+		}
+		log.Printf("🚦 Webhook of Discord channel %s rate limited for %s, trying the next one", channelID, rateLimited.retryAfter)
+		c.markWebhookRateLimited(webhook, rateLimited.retryAfter)
+	}
+}
+
+// executeWebhook posts a message payload to a webhook URL
+func (c *Client) executeWebhook(ctx context.Context, channelID, webhookURL string, jsonData []byte) error {
 	// Send HTTP POST request to webhook URL
-	resp, err := http.Post(webhookURL, "application/json", bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to send webhook message: %v", err)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return &webhookRateLimitError{retryAfter: retryAfter(resp)}
+	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		return fmt.Errorf("webhook request failed with status: %d", resp.StatusCode)
 	}
 
+	var sent discordgo.Message
+	if err := json.NewDecoder(resp.Body).Decode(&sent); err != nil {
+		log.Printf("⚠️ Failed to decode webhook message for channel %s: %v", channelID, err)
+	} else {
+		c.recordSent(&sent)
+	}
+
 	log.Printf("✅ Webhook message sent to Discord channel %s", channelID)
 	return nil
 }
@@ -356,3 +1321,19 @@ func (c *Client) GetUserAvatar(platform, userID, username string) string {
 		return c.GetPlatformAvatar("unknown")
 	}
 }
+
+// DownloadFile downloads a file from a URL (e.g. guild icons or attachments)
+func DownloadFile(url string) ([]byte, error) {
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download file: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("file download failed with status: %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
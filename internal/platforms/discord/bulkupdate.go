@@ -0,0 +1,45 @@
+package discord
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// commandConfigBulkUpdate sets one config field of every bridge in the server
+func (h *MessageHandler) commandConfigBulkUpdate(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+	if i.GuildID == "" {
+		h.respondToInteraction(s, i, "❌ This command can only be used in a server")
+		return
+	}
+
+	// Changing every bridge at once affects channels the admin may not manage otherwise
+	if i.Member == nil || i.Member.Permissions&discordgo.PermissionAdministrator == 0 {
+		h.respondToInteraction(s, i, "❌ Only server administrators can update all bridges at once")
+		return
+	}
+
+	optionMap := getOptionMap(options)
+	fieldOption, hasField := optionMap["field"]
+	valueOption, hasValue := optionMap["value"]
+	if !hasField || !hasValue {
+		h.respondToInteraction(s, i, "❌ Missing required parameters")
+		return
+	}
+
+	field := strings.ToLower(strings.TrimSpace(fieldOption.StringValue()))
+	value := strings.TrimSpace(valueOption.StringValue())
+	updated, err := h.bridgeCore.BulkUpdateBridgeConfig(i.GuildID, field, value)
+	if err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to update bridges: %v", err))
+		return
+	}
+
+	h.recordAudit(i, AuditActionBulkUpdate, fmt.Sprintf("guild_%s", i.GuildID), fmt.Sprintf("%s = %s on %d bridges", field, value, updated))
+	h.respondToInteraction(s, i, fmt.Sprintf("✅ Set `%s` to `%s` on **%d** bridges", field, value, updated))
+}
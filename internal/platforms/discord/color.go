@@ -0,0 +1,72 @@
+package discord
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// parseHexColor parses a color like "#FF5500" or "ff5500" into an integer
+func parseHexColor(value string) (int, error) {
+	hex := strings.TrimPrefix(strings.TrimSpace(value), "#")
+	if len(hex) != 6 {
+		return 0, fmt.Errorf("color must have 6 hex digits, e.g. #FF5500")
+	}
+
+	color, err := strconv.ParseInt(hex, 16, 32)
+	if err != nil || color < 0 || color > 0xFFFFFF {
+		return 0, fmt.Errorf("invalid hex color %q", value)
+	}
+	return int(color), nil
+}
+
+// bridgeEmbedColor returns the embed color configured for a channel's bridge, or fallback
+func (h *MessageHandler) bridgeEmbedColor(channelID string, fallback int) int {
+	if h.bridgeCore == nil {
+		return fallback
+	}
+
+	config, err := h.bridgeCore.GetBridgeConfig(types.PlatformDiscord, channelID)
+	if err != nil {
+		return fallback
+	}
+	return config.EmbedColor
+}
+
+// commandBridgeSetColor sets the embed color of the current channel's bridge
+func (h *MessageHandler) commandBridgeSetColor(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	optionMap := getOptionMap(options)
+	colorOption, ok := optionMap["color"]
+	if !ok {
+		h.respondToInteraction(s, i, "❌ Missing color parameter")
+		return
+	}
+
+	color, err := parseHexColor(colorOption.StringValue())
+	if err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ %v", err))
+		return
+	}
+
+	if err := h.bridgeCore.SetBridgeEmbedColor(types.PlatformDiscord, i.ChannelID, color); err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to set color: %v", err))
+		return
+	}
+	h.recordAudit(i, AuditActionSetColor, fmt.Sprintf("discord_%s", i.ChannelID), fmt.Sprintf("#%06X", color))
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "🎨 Bridge Color Updated",
+		Description: fmt.Sprintf("Embeds about the bridge of <#%s> now use `#%06X`", i.ChannelID, color),
+		Color:       color,
+	}
+
+	h.respondToInteractionWithEmbed(s, i, embed)
+}
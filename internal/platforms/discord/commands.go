@@ -0,0 +1,71 @@
+package discord
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// errCodeDailyCommandCreatesReached is returned once a guild had 200 application commands created in a day
+const errCodeDailyCommandCreatesReached = 30034
+
+// RegisterCommandsBulk replaces the slash commands of a guild, or the global commands if guildID is empty, in a single request
+func (c *Client) RegisterCommandsBulk(guildID string) error {
+	c.registeredMu.Lock()
+	defer c.registeredMu.Unlock()
+	if c.registeredGuilds[guildID] {
+		return nil
+	}
+	return c.overwriteCommands(guildID, slashCommands())
+}
+
+// overwriteCommands replaces the registered commands of a guild with commands; registeredMu must be held
+func (c *Client) overwriteCommands(guildID string, commands []*discordgo.ApplicationCommand) error {
+	if _, err := c.session.ApplicationCommandBulkOverwrite(c.session.State.User.ID, guildID, commands); err != nil {
+		return c.commandRegistrationError("", err)
+	}
+
+	c.registeredGuilds[guildID] = true
+	if guildID == "" {
+		log.Printf("⚠️ Discord slash commands registered globally, they can take up to an hour to appear in every server")
+		return nil
+	}
+	log.Printf("✅ Discord slash commands registered for guild %s", guildID)
+	return nil
+}
+
+// commandRegistrationError describes a failed command registration, with the invite link to use if the bot lacks the applications.commands scope
+func (c *Client) commandRegistrationError(commandName string, err error) error {
+	what := "commands"
+	if commandName != "" {
+		what = "command " + commandName
+	}
+
+	var restErr *discordgo.RESTError
+	if errors.As(err, &restErr) && restErr.Message != nil && restErr.Message.Code == discordgo.ErrCodeMissingAccess {
+		return fmt.Errorf("cannot create %s, the bot was invited without the applications.commands scope. "+
+			"Re-invite it with https://discord.com/oauth2/authorize?client_id=%s&scope=bot+applications.commands: %v",
+			what, c.session.State.User.ID, err)
+	}
+	return fmt.Errorf("cannot create %s: %v", what, err)
+}
+
+// isCommandCreateLimited reports whether a command create failed because too many commands were created recently
+func isCommandCreateLimited(err error) bool {
+	var rateLimitErr *discordgo.RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return true
+	}
+
+	var restErr *discordgo.RESTError
+	if !errors.As(err, &restErr) {
+		return false
+	}
+	if restErr.Response != nil && restErr.Response.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return restErr.Message != nil && restErr.Message.Code == errCodeDailyCommandCreatesReached
+}
@@ -0,0 +1,89 @@
+package discord
+
+import (
+	"fmt"
+	"log"
+
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// compactModeNotice is sent to both sides of a bridge the first time compact mode is enabled
+const compactModeNotice = "💡 Bridge format changed to compact mode"
+
+// handleConfigCompactCommand handles compact mode subcommands
+func (h *MessageHandler) handleConfigCompactCommand(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if len(options) == 0 {
+		h.respondToInteraction(s, i, "❌ No compact subcommand specified")
+		return
+	}
+
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	previous, err := h.bridgeCore.GetBridgeConfig(types.PlatformDiscord, i.ChannelID)
+	if err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to update compact mode: %v", err))
+		return
+	}
+
+	config := *previous
+	switch options[0].Name {
+	case "enable":
+		config.CompactMode = true
+	case "disable":
+		config.CompactMode = false
+	default:
+		h.respondToInteraction(s, i, "❓ Unknown compact subcommand")
+		return
+	}
+
+	announce := config.CompactMode && !config.CompactModeAnnounced
+	if announce {
+		config.CompactModeAnnounced = true
+	}
+
+	if err := h.bridgeCore.UpdateBridgeConfig(types.PlatformDiscord, i.ChannelID, &config); err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to update compact mode: %v", err))
+		return
+	}
+
+	description := "Disabled - Bridged messages show the platform prefix"
+	if config.CompactMode {
+		description = "Enabled - Bridged messages show only the sender's name"
+	}
+
+	h.recordAudit(i, AuditActionCompactMode, fmt.Sprintf("discord_%s", i.ChannelID), description)
+
+	h.pushUndo(interactionUserID(i), &UndoAction{
+		ActionType: UndoActionUpdateConfig,
+		Payload:    &configSnapshot{Platform: types.PlatformDiscord, ChannelID: i.ChannelID, Config: previous},
+	})
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "💬 Compact Mode Updated",
+		Description: description,
+		Color:       0x00ff00,
+	}
+
+	h.respondToInteractionWithEmbed(s, i, embed)
+
+	if announce {
+		h.announceCompactMode(i.ChannelID)
+	}
+}
+
+// announceCompactMode tells both sides of a channel's bridges that the message format changed
+func (h *MessageHandler) announceCompactMode(channelID string) {
+	if err := h.bridgeCore.SendSystemMessage(types.PlatformDiscord, channelID, compactModeNotice); err != nil {
+		log.Printf("⚠️ Failed to send compact mode notice to Discord channel %s: %v", channelID, err)
+	}
+
+	for _, bridge := range h.bridgeCore.GetBridges(channelID) {
+		if err := h.bridgeCore.SendSystemMessage(bridge.TargetPlatform, bridge.TargetChannelID, compactModeNotice); err != nil {
+			log.Printf("⚠️ Failed to send compact mode notice to %s channel %s: %v", bridge.TargetPlatform, bridge.TargetChannelID, err)
+		}
+	}
+}
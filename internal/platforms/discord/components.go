@@ -0,0 +1,63 @@
+package discord
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// maxComponentURLLength is the longest link button URL shown; longer ones are only marked as links
+const maxComponentURLLength = 80
+
+// describeComponents lists the buttons and select menus of a message, e.g. "[Buttons: Accept | Learn More (link)]"
+func describeComponents(components []discordgo.MessageComponent) string {
+	var buttons, menus []string
+	collectComponents(components, &buttons, &menus)
+
+	var parts []string
+	if len(buttons) > 0 {
+		parts = append(parts, fmt.Sprintf("[Buttons: %s]", strings.Join(buttons, " | ")))
+	}
+	if len(menus) > 0 {
+		parts = append(parts, fmt.Sprintf("[Menus: %s]", strings.Join(menus, " | ")))
+	}
+	return strings.Join(parts, "\n")
+}
+
+// collectComponents walks a component tree, adding a description of every button and select menu
+func collectComponents(components []discordgo.MessageComponent, buttons, menus *[]string) {
+	for _, component := range components {
+		switch c := component.(type) {
+		case *discordgo.ActionsRow:
+			collectComponents(c.Components, buttons, menus)
+		case *discordgo.Button:
+			*buttons = append(*buttons, describeButton(c))
+		case *discordgo.SelectMenu:
+			placeholder := c.Placeholder
+			if placeholder == "" {
+				placeholder = "Select an option"
+			}
+			*menus = append(*menus, placeholder)
+		}
+	}
+}
+
+// describeButton returns a button's label, with its URL for short link buttons
+func describeButton(button *discordgo.Button) string {
+	label := button.Label
+	if label == "" && button.Emoji != nil {
+		label = button.Emoji.Name
+	}
+	if label == "" {
+		label = "Button"
+	}
+
+	if button.Style != discordgo.LinkButton || button.URL == "" {
+		return label
+	}
+	if len(button.URL) < maxComponentURLLength {
+		return fmt.Sprintf("%s (%s)", label, button.URL)
+	}
+	return label + " (link)"
+}
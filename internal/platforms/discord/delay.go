@@ -0,0 +1,77 @@
+package discord
+
+import (
+	"fmt"
+	"time"
+
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// maxDeliveryDelaySeconds is the longest delivery delay a bridge can be given
+const maxDeliveryDelaySeconds = 3600
+
+// minDeliveryDelay is the lower bound of the delay option; MinValue needs an addressable value
+var minDeliveryDelay = 1.0
+
+// handleConfigDelayCommand handles delivery delay subcommands
+func (h *MessageHandler) handleConfigDelayCommand(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if len(options) == 0 {
+		h.respondToInteraction(s, i, "❌ No delay subcommand specified")
+		return
+	}
+
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	previous, err := h.bridgeCore.GetBridgeConfig(types.PlatformDiscord, i.ChannelID)
+	if err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to update delivery delay: %v", err))
+		return
+	}
+
+	config := *previous
+	switch options[0].Name {
+	case "set":
+		optionMap := getOptionMap(options[0].Options)
+		config.DeliveryDelaySeconds = int(optionMap["seconds"].IntValue())
+	case "clear":
+		config.DeliveryDelaySeconds = 0
+	default:
+		h.respondToInteraction(s, i, "❓ Unknown delay subcommand")
+		return
+	}
+
+	if err := h.bridgeCore.UpdateBridgeConfig(types.PlatformDiscord, i.ChannelID, &config); err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to update delivery delay: %v", err))
+		return
+	}
+
+	h.recordAudit(i, AuditActionDeliveryDelay, fmt.Sprintf("discord_%s", i.ChannelID), deliveryDelayDescription(config.DeliveryDelaySeconds))
+
+	h.pushUndo(interactionUserID(i), &UndoAction{
+		ActionType: UndoActionUpdateConfig,
+		Payload:    &configSnapshot{Platform: types.PlatformDiscord, ChannelID: i.ChannelID, Config: previous},
+	})
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "⏳ Delivery Delay Updated",
+		Description: deliveryDelayDescription(config.DeliveryDelaySeconds),
+		Color:       0x00ff00,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Applies to messages bridged in both directions",
+		},
+	}
+
+	h.respondToInteractionWithEmbed(s, i, embed)
+}
+
+// deliveryDelayDescription describes how long bridged messages wait before delivery
+func deliveryDelayDescription(seconds int) string {
+	if seconds <= 0 {
+		return "None - Messages are delivered immediately"
+	}
+	return fmt.Sprintf("Messages are delivered %s after they were sent", time.Duration(seconds)*time.Second)
+}
@@ -0,0 +1,94 @@
+package discord
+
+import (
+	"fmt"
+	"strings"
+
+	"dcbot/internal/database/models"
+	"github.com/bwmarrin/discordgo"
+)
+
+// bridgeEventsShown is the number of delivery events listed by /bridge events
+const bridgeEventsShown = 10
+
+// maxBridgeEventErrorLength truncates delivery errors in /bridge events
+const maxBridgeEventErrorLength = 80
+
+// commandBridgeEvents shows the most recent delivery attempts of a bridge
+func (h *MessageHandler) commandBridgeEvents(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	bridge := h.selectedBridge(s, i, options)
+	if bridge == nil {
+		h.respondToInteraction(s, i, "❌ Bridge not found. Pick a bridge or run this in a bridged channel.")
+		return
+	}
+
+	events, err := h.bridgeCore.GetBridgeEvents(bridge.ID, bridgeEventsShown)
+	if err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to load bridge events: %v", err))
+		return
+	}
+
+	description := "No delivery events recorded in the last 7 days"
+	if len(events) > 0 {
+		var lines []string
+		for _, event := range events {
+			lines = append(lines, formatBridgeEvent(event))
+		}
+		description = strings.Join(lines, "\n")
+	}
+
+	footer := bridge.ID
+	if bridge.Name != "" {
+		footer = fmt.Sprintf("%s • %s", bridge.Name, bridge.ID)
+	}
+
+	h.respondToInteractionWithEmbed(s, i, &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📋 Delivery Events → %s", strings.Title(bridge.TargetPlatform)),
+		Description: description,
+		Color:       0x0099ff,
+		Footer:      &discordgo.MessageEmbedFooter{Text: footer},
+	})
+}
+
+// formatBridgeEvent renders a delivery event as a single line with a status icon
+func formatBridgeEvent(event *models.BridgeEvent) string {
+	line := fmt.Sprintf("%s <t:%d:T> **%s**", deliveryStatusIcon(event.Status), event.CreatedAt.Unix(), event.Status)
+	if event.EventType != "delivery" {
+		line += fmt.Sprintf(" (%s)", event.EventType)
+	}
+	if event.SourceMsgID != "" {
+		line += fmt.Sprintf(" `%s`", event.SourceMsgID)
+	}
+	line += fmt.Sprintf(" %dms", event.ProcessingTimeMs)
+	if event.ErrorMsg != "" {
+		errorMsg := []rune(event.ErrorMsg)
+		if len(errorMsg) > maxBridgeEventErrorLength {
+			errorMsg = append(errorMsg[:maxBridgeEventErrorLength-3], []rune("...")...)
+		}
+		line += " - " + string(errorMsg)
+	}
+	return line
+}
+
+// deliveryStatusIcon returns the emoji shown for a delivery status
+func deliveryStatusIcon(status string) string {
+	switch status {
+	case "delivered":
+		return "✅"
+	case "failed":
+		return "❌"
+	case "timeout":
+		return "⏱️"
+	case "skipped":
+		return "⏭️"
+	case "dropped":
+		return "🗑️"
+	default:
+		return "❔"
+	}
+}
@@ -0,0 +1,98 @@
+package discord
+
+import (
+	"log"
+	"slices"
+	"strings"
+	"unicode"
+
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// forumPostHashtags returns the applied tags of a forum post as Telegram hashtags, or "" if the bridge does not bridge tags
+func (h *MessageHandler) forumPostHashtags(s *discordgo.Session, forum, thread *discordgo.Channel) string {
+	if len(thread.AppliedTags) == 0 {
+		return ""
+	}
+
+	config, err := h.bridgeCore.GetBridgeConfig(types.PlatformDiscord, forum.ID)
+	if err != nil || !config.BridgeForumTags {
+		return ""
+	}
+
+	var hashtags []string
+	for _, tagID := range thread.AppliedTags {
+		if hashtag := formatHashtag(h.forumTagName(s, forum, tagID)); hashtag != "" {
+			hashtags = append(hashtags, hashtag)
+		}
+	}
+	return strings.Join(hashtags, " ")
+}
+
+// forumTagName returns the name of a forum tag, refreshing the cached tags of the forum when the tag is unknown
+func (h *MessageHandler) forumTagName(s *discordgo.Session, forum *discordgo.Channel, tagID string) string {
+	h.forumTagsMu.Lock()
+	defer h.forumTagsMu.Unlock()
+
+	if name, ok := h.forumTags[forum.ID][tagID]; ok {
+		return name
+	}
+
+	// Tags may have been added since the forum was cached
+	if h.forumTags[forum.ID] != nil {
+		fresh, err := s.Channel(forum.ID)
+		if err != nil {
+			log.Printf("⚠️ Failed to fetch tags of Discord forum %s: %v", forum.ID, err)
+			return ""
+		}
+		forum = fresh
+	}
+
+	tags := make(map[string]string, len(forum.AvailableTags))
+	for _, tag := range forum.AvailableTags {
+		tags[tag.ID] = tag.Name
+	}
+	h.forumTags[forum.ID] = tags
+	return tags[tagID]
+}
+
+// formatHashtag turns a tag name into a Telegram hashtag, dropping characters hashtags cannot contain
+func formatHashtag(name string) string {
+	tag := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, name)
+	if tag == "" {
+		return ""
+	}
+	return "#" + tag
+}
+
+// onThreadUpdate edits the Telegram copy of a forum post when its tags change
+func (h *MessageHandler) onThreadUpdate(s *discordgo.Session, t *discordgo.ThreadUpdate) {
+	if h.bridgeCore == nil || len(h.bridgeCore.GetBridges(t.ParentID)) == 0 {
+		return
+	}
+	if t.BeforeUpdate != nil && slices.Equal(t.BeforeUpdate.AppliedTags, t.AppliedTags) {
+		return
+	}
+
+	forum, _ := h.forumPost(s, t.ID)
+	if forum == nil {
+		return
+	}
+
+	config, err := h.bridgeCore.GetBridgeConfig(types.PlatformDiscord, forum.ID)
+	if err != nil || !config.BridgeForumTags {
+		return
+	}
+
+	// Tags of the updated thread, the cached one may be stale
+	hashtags := h.forumPostHashtags(s, forum, t.Channel)
+	if err := h.bridgeCore.UpdateForumPostTags(t.ID, hashtags); err != nil {
+		log.Printf("❌ Failed to update tags of Discord forum post %s on Telegram: %v", t.ID, err)
+	}
+}
@@ -3,13 +3,39 @@ package discord
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"dcbot/internal/config"
+	"dcbot/internal/database/models"
+	"dcbot/internal/i18n"
 	"dcbot/internal/types"
 	"github.com/bwmarrin/discordgo"
 )
 
+// confirmationTimeout is how long confirmation buttons stay active
+const confirmationTimeout = 30 * time.Second
+
+// Custom ID prefixes for confirmation buttons, followed by the pending confirmation key
+const (
+	confirmCustomIDPrefix = "confirm:"
+	cancelCustomIDPrefix  = "cancel:"
+)
+
+// removeBridgeSelectCustomID identifies the select menu of /bridge remove
+const removeBridgeSelectCustomID = "select:remove_bridge"
+
+// maxSelectMenuOptions is the number of options Discord allows in a select menu
+const maxSelectMenuOptions = 25
+
+// pendingConfirmation is a destructive operation waiting for the user to confirm it
+type pendingConfirmation struct {
+	interaction *discordgo.Interaction // Interaction that sent the confirmation prompt
+	onConfirm   func()
+}
+
 // MessageHandler handles Discord events and admin commands
 type MessageHandler struct {
 	client             *Client
@@ -18,16 +44,68 @@ type MessageHandler struct {
 	adminRoles         []string                                               // Discord role IDs that have admin permissions
 	bridgedChannels    map[string]map[string]string                          // channelID -> platform -> targetID
 	bridgeCore         types.BridgeCore                                      // Bridge core interface
+	guildIcons         map[string]string                                     // guildID -> icon hash, to detect icon changes
+	guildIconsMu       sync.Mutex
+	voiceStates        map[string]string                                     // guildID:userID -> voice channelID, to skip duplicate updates
+	voiceStatesMu      sync.Mutex
+	voiceMembers       map[string]map[string]bool                            // voice channelID -> userID -> present, to detect voice chats starting and ending
+	confirmations      map[string]*pendingConfirmation                       // confirmation key -> pending operation
+	confirmationsMu    sync.Mutex
+	undoStacks         map[string][]*UndoAction                              // admin user ID -> recent reversible actions
+	undoMu             sync.Mutex
+	bridgeTester       BridgeTester                                          // Optional, used by /bridge test
+	databasePruner     DatabasePruner                                        // Optional, used by /config db prune and stats
+	templates          *config.TemplateLibrary                               // Optional, used by /bridge create template:<name>
+	permissionAudits   map[string]*permissionAudit                           // guildID -> cached /bridge permissions result
+	permissionAuditsMu sync.Mutex
+	forumTags          map[string]map[string]string                          // forum channelID -> tag ID -> tag name
+	forumTagsMu        sync.Mutex
+	channelNames       map[string]string                                     // channelID -> name, to detect renames
+	channelNamesMu     sync.Mutex
+	channelTopics      map[string]string                                     // channelID -> topic, to detect topic changes
+	channelTopicsMu    sync.Mutex
+	lastPins           map[string]string                                     // channelID -> ID of the last pinned message bridged, to skip duplicate events
+	lastPinsMu         sync.Mutex
+	maxMediaSizeBytes  int64                                                 // Larger image attachments are not bridged, 0 for no limit
+}
+
+// BridgeTester runs an on-demand health check of a bridge
+type BridgeTester interface {
+	RunNow(bridgeID string) error
 }
 
 // NewMessageHandler creates a new Discord message handler
 func NewMessageHandler(client *Client, bridgeFunc func(string, string, string, string, string) error) *MessageHandler {
 	return &MessageHandler{
-		client:          client,
-		bridgeFunc:      bridgeFunc,
-		adminUsers:      []string{},
-		adminRoles:      []string{},
-		bridgedChannels: make(map[string]map[string]string),
+		client:           client,
+		bridgeFunc:       bridgeFunc,
+		adminUsers:       []string{},
+		adminRoles:       []string{},
+		bridgedChannels:  make(map[string]map[string]string),
+		guildIcons:       make(map[string]string),
+		voiceStates:      make(map[string]string),
+		voiceMembers:     make(map[string]map[string]bool),
+		confirmations:    make(map[string]*pendingConfirmation),
+		undoStacks:       make(map[string][]*UndoAction),
+		permissionAudits: make(map[string]*permissionAudit),
+		forumTags:        make(map[string]map[string]string),
+		channelNames:     make(map[string]string),
+		channelTopics:    make(map[string]string),
+		lastPins:         make(map[string]string),
+	}
+}
+
+// SetBridgeTester sets the bridge tester used by /bridge test
+func (h *MessageHandler) SetBridgeTester(tester BridgeTester) {
+	h.bridgeTester = tester
+}
+
+// NotifyAdmins sends a direct message to every admin user
+func (h *MessageHandler) NotifyAdmins(message string) {
+	for _, adminID := range h.adminUsers {
+		if err := h.client.SendDirectMessage(adminID, message); err != nil {
+			log.Printf("❌ Failed to notify Discord admin %s: %v", adminID, err)
+		}
 	}
 }
 
@@ -41,16 +119,55 @@ func (h *MessageHandler) SetupHandlers() {
 	h.client.SetReadyHandler(h.onReady)
 	h.client.SetMessageHandler(h.onMessageCreate)
 	h.client.SetInteractionHandler(h.onInteractionCreate)
+	h.client.SetGuildCreateHandler(h.onGuildCreate)
+	h.client.SetGuildUpdateHandler(h.onGuildUpdate)
+	h.client.SetVoiceStateUpdateHandler(h.onVoiceStateUpdate)
+	h.client.SetMessageReactionAddHandler(h.onMessageReactionAdd)
+	h.client.SetMessageReactionRemoveHandler(h.onMessageReactionRemove)
+	h.client.SetThreadCreateHandler(h.onThreadCreate)
+	h.client.SetThreadUpdateHandler(h.onThreadUpdate)
+	h.client.SetChannelUpdateHandler(h.onChannelUpdate)
+	h.client.SetGuildMemberUpdateHandler(h.onGuildMemberUpdate)
+	h.client.SetChannelPinsUpdateHandler(h.onChannelPinsUpdate)
+	h.client.SetConnectHandler(h.onConnect)
+	h.client.SetDisconnectHandler(h.onDisconnect)
+}
+
+// onConnect tells the bridge core that the Discord gateway connection is up
+func (h *MessageHandler) onConnect(s *discordgo.Session, event *discordgo.Connect) {
+	if h.bridgeCore != nil {
+		h.bridgeCore.DiscordConnected()
+	}
+}
+
+// onDisconnect tells the bridge core that the Discord gateway connection was lost
+func (h *MessageHandler) onDisconnect(s *discordgo.Session, event *discordgo.Disconnect) {
+	log.Printf("⚠️ Discord gateway connection lost")
+	if h.bridgeCore != nil {
+		h.bridgeCore.DiscordDisconnected()
+	}
 }
 
 // onReady handles the ready event
 func (h *MessageHandler) onReady(s *discordgo.Session, event *discordgo.Ready) {
 	log.Printf("🤖 Discord bot logged in as: %v#%v", s.State.User.Username, s.State.User.Discriminator)
-	
-	// Register slash commands
-	err := h.client.RegisterCommands()
-	if err != nil {
-		log.Printf("❌ Failed to register Discord commands: %v", err)
+	log.Printf("🌐 Discord bot is present in %d guild(s)", len(event.Guilds))
+
+	h.checkPrivilegedIntents(event)
+
+	// Discord clears the activity on every new gateway session
+	h.setStatus(s)
+}
+
+// checkPrivilegedIntents warns when the bot requested the GUILD_MEMBERS intent but its application does not have it enabled
+func (h *MessageHandler) checkPrivilegedIntents(event *discordgo.Ready) {
+	if !h.client.hasIntent(discordgo.IntentsGuildMembers) || event.Application == nil {
+		return
+	}
+
+	if event.Application.Flags&(applicationFlagGatewayGuildMembers|applicationFlagGatewayGuildMembersLimited) == 0 {
+		log.Printf("❌ The GUILD_MEMBERS intent is requested but not enabled for this bot, member events will not arrive. "+
+			"Enable \"Server Members Intent\" at https://discord.com/developers/applications/%s/bot", event.Application.ID)
 	}
 }
 
@@ -61,9 +178,15 @@ func (h *MessageHandler) onMessageCreate(s *discordgo.Session, m *discordgo.Mess
 		return
 	}
 
+	// Ignore messages the bridge sent, whichever way they were sent
+	if h.client.isBotSent(m.ID) {
+		h.client.logger.Debug("⏭️ Ignoring message sent by the bridge", "message_id", m.ID, "channel_id", m.ChannelID)
+		return
+	}
+
 	// Ignore webhook messages to prevent infinite loops
 	if m.WebhookID != "" {
-		log.Printf("⏭️ Ignoring webhook message from %s in %s", m.Author.Username, m.ChannelID)
+		h.client.logger.Debug("⏭️ Ignoring webhook message", "author", m.Author.Username, "channel_id", m.ChannelID)
 		return
 	}
 
@@ -74,26 +197,68 @@ func (h *MessageHandler) onMessageCreate(s *discordgo.Session, m *discordgo.Mess
 	}
 
 	// Log the message
-	log.Printf("🔄 Processing Discord message from %s in %s: %s", m.Author.Username, m.ChannelID, m.Content)
+	h.client.logger.Debug("🔄 Processing Discord message", "author", m.Author.Username, "channel_id", m.ChannelID, "content", m.Content)
 
 	// Set user mapping in bridge core for username display
+	username := m.Author.Username
+	if username == "" {
+		username = m.Author.GlobalName
+	}
+	if username == "" {
+		username = "User" + m.Author.ID
+	}
 	if h.bridgeCore != nil {
-		username := m.Author.Username
-		if username == "" {
-			username = m.Author.GlobalName
-		}
-		if username == "" {
-			username = "User" + m.Author.ID
-		}
 		h.bridgeCore.SetUserMapping("discord", m.Author.ID, username)
 	}
 
 	// Check if channel is bridged using bridge core first
 	if h.bridgeCore != nil {
+		// Posts in a bridged forum channel are bridged through the forum
+		sourceChannelID, threadID, threadName, hashtags := m.ChannelID, "", "", ""
 		bridges := h.bridgeCore.GetBridges(m.ChannelID)
+		if len(bridges) == 0 {
+			if forum, thread := h.forumPost(s, m.ChannelID); forum != nil {
+				sourceChannelID, threadID, threadName = forum.ID, thread.ID, thread.Name
+				bridges = h.bridgeCore.GetBridges(forum.ID)
+
+				// The starter message of a post carries the post's tags
+				if m.ID == thread.ID {
+					hashtags = h.forumPostHashtags(s, forum, thread)
+				}
+			}
+		}
 		if len(bridges) > 0 {
-			// Bridge the message using bridge core
-			err := h.bridgeFunc("discord", m.ChannelID, m.Author.ID, "text", m.Content)
+			content := m.Content
+			if hashtags != "" {
+				content = strings.TrimSpace(content + "\n\n" + hashtags)
+			}
+
+			// Telegram users cannot press Discord buttons, but should know they are there
+			if len(m.Components) > 0 {
+				if config, err := h.bridgeCore.GetBridgeConfig(types.PlatformDiscord, sourceChannelID); err == nil && config.BridgeMessageComponents {
+					if description := describeComponents(m.Components); description != "" {
+						content = strings.TrimSpace(content + "\n" + description)
+					}
+				}
+			}
+
+			// Bridge the message using bridge core, keeping the Discord message ID for reactions
+			message := &types.BridgeMessage{
+				ID:              fmt.Sprintf("discord_%s_%s", m.ChannelID, m.ID),
+				SourcePlatform:  types.PlatformDiscord,
+				SourceChannelID: sourceChannelID,
+				SourceUserID:    m.Author.ID,
+				Username:        username,
+				Content:         content,
+				MessageType:     types.MessageTypeText,
+				Timestamp:       time.Now(),
+				SourceMessageID: m.ID,
+				SourceThreadID:  threadID,
+				TopicName:       threadName,
+			}
+			h.attachImages(message, m.Attachments)
+
+			err := h.bridgeCore.ProcessMessage(message)
 			if err != nil {
 				log.Printf("❌ Failed to bridge Discord message: %v", err)
 				h.sendErrorMessage(m.ChannelID, "Failed to bridge message to other platforms")
@@ -115,14 +280,445 @@ func (h *MessageHandler) onMessageCreate(s *discordgo.Session, m *discordgo.Mess
 	}
 }
 
+// onThreadCreate creates Telegram topics for new posts in bridged forum channels
+func (h *MessageHandler) onThreadCreate(s *discordgo.Session, t *discordgo.ThreadCreate) {
+	if !t.NewlyCreated || h.bridgeCore == nil || len(h.bridgeCore.GetBridges(t.ParentID)) == 0 {
+		return
+	}
+
+	if forum, _ := h.forumPost(s, t.ID); forum == nil {
+		return
+	}
+
+	log.Printf("🧵 New Discord forum post %q in %s", t.Name, t.ParentID)
+	h.bridgeCore.BridgeForumPost(t.ParentID, t.ID, t.Name)
+}
+
+// forumPost returns the forum channel and thread of a forum post, or nil if the channel is not one
+func (h *MessageHandler) forumPost(s *discordgo.Session, channelID string) (*discordgo.Channel, *discordgo.Channel) {
+	thread, err := s.State.Channel(channelID)
+	if err != nil {
+		if thread, err = s.Channel(channelID); err != nil {
+			return nil, nil
+		}
+	}
+	if !thread.IsThread() || thread.ParentID == "" {
+		return nil, nil
+	}
+
+	forum, err := s.State.Channel(thread.ParentID)
+	if err != nil {
+		if forum, err = s.Channel(thread.ParentID); err != nil {
+			return nil, nil
+		}
+	}
+	if forum.Type != discordgo.ChannelTypeGuildForum {
+		return nil, nil
+	}
+	return forum, thread
+}
+
+// onGuildCreate registers slash commands in the guild and remembers its icon so later icon changes can be detected
+func (h *MessageHandler) onGuildCreate(s *discordgo.Session, g *discordgo.GuildCreate) {
+	if g.Unavailable {
+		return
+	}
+
+	h.guildIconsMu.Lock()
+	h.guildIcons[g.ID] = g.Icon
+	h.guildIconsMu.Unlock()
+
+	h.channelNamesMu.Lock()
+	for _, channel := range g.Channels {
+		h.channelNames[channel.ID] = channel.Name
+	}
+	h.channelNamesMu.Unlock()
+
+	h.channelTopicsMu.Lock()
+	for _, channel := range g.Channels {
+		h.channelTopics[channel.ID] = channel.Topic
+	}
+	h.channelTopicsMu.Unlock()
+
+	// Seed voice channel members so chats already running end with a notification
+	h.voiceStatesMu.Lock()
+	for _, state := range g.VoiceStates {
+		if state.ChannelID == "" || state.UserID == s.State.User.ID {
+			continue
+		}
+		h.voiceStates[g.ID+":"+state.UserID] = state.ChannelID
+		if h.voiceMembers[state.ChannelID] == nil {
+			h.voiceMembers[state.ChannelID] = make(map[string]bool)
+		}
+		h.voiceMembers[state.ChannelID][state.UserID] = true
+	}
+	h.voiceStatesMu.Unlock()
+
+	if err := h.client.RegisterCommands(g.ID); err != nil {
+		log.Printf("❌ Failed to register Discord commands in guild %s: %v", g.Name, err)
+	}
+}
+
+// onGuildUpdate bridges guild icon changes to all bridged channels of the guild
+func (h *MessageHandler) onGuildUpdate(s *discordgo.Session, g *discordgo.GuildUpdate) {
+	h.guildIconsMu.Lock()
+	previousIcon, known := h.guildIcons[g.ID]
+	h.guildIcons[g.ID] = g.Icon
+	h.guildIconsMu.Unlock()
+
+	if !known || g.Icon == "" || g.Icon == previousIcon || h.bridgeCore == nil {
+		return
+	}
+
+	log.Printf("📸 Discord guild icon changed: %s", g.Name)
+
+	iconData, err := DownloadFile(g.IconURL("1024"))
+	if err != nil {
+		log.Printf("❌ Failed to download new guild icon: %v", err)
+		return
+	}
+
+	channels, err := s.GuildChannels(g.ID)
+	if err != nil {
+		log.Printf("❌ Failed to get guild channels: %v", err)
+		return
+	}
+
+	for _, channel := range channels {
+		if len(h.bridgeCore.GetBridges(channel.ID)) == 0 {
+			continue
+		}
+
+		message := &types.BridgeMessage{
+			ID:              fmt.Sprintf("discord_%s_icon_%d", channel.ID, time.Now().Unix()),
+			SourcePlatform:  types.PlatformDiscord,
+			SourceChannelID: channel.ID,
+			Username:        g.Name,
+			Content:         "📸 Server icon updated",
+			MessageType:     types.MessageTypeEvent,
+			Timestamp:       time.Now(),
+			MediaBytes:      iconData,
+		}
+		if err := h.bridgeCore.ProcessMessage(message); err != nil {
+			log.Printf("❌ Failed to bridge guild icon change for channel %s: %v", channel.ID, err)
+		}
+	}
+}
+
+// onChannelUpdate bridges channel renames and topic changes to the channel's bridges
+func (h *MessageHandler) onChannelUpdate(s *discordgo.Session, c *discordgo.ChannelUpdate) {
+	h.syncChannelTopic(c.Channel)
+
+	h.channelNamesMu.Lock()
+	previousName, known := h.channelNames[c.ID]
+	h.channelNames[c.ID] = c.Name
+	h.channelNamesMu.Unlock()
+
+	if !known || c.Name == previousName || h.bridgeCore == nil || len(h.bridgeCore.GetBridges(c.ID)) == 0 {
+		return
+	}
+
+	log.Printf("✏️ Discord channel %s renamed from #%s to #%s", c.ID, previousName, c.Name)
+
+	message := &types.BridgeMessage{
+		ID:              fmt.Sprintf("discord_%s_rename_%d", c.ID, time.Now().Unix()),
+		SourcePlatform:  types.PlatformDiscord,
+		SourceChannelID: c.ID,
+		Content:         "✏️ Channel renamed to: #" + c.Name,
+		MessageType:     types.MessageTypeSystem,
+		Timestamp:       time.Now(),
+	}
+	if err := h.bridgeCore.ProcessMessage(message); err != nil {
+		log.Printf("❌ Failed to bridge rename of Discord channel %s: %v", c.ID, err)
+	}
+}
+
+// onGuildMemberUpdate syncs roles a member gained or lost to the admin status of their linked Telegram account
+func (h *MessageHandler) onGuildMemberUpdate(s *discordgo.Session, m *discordgo.GuildMemberUpdate) {
+	// Without the cached member the role changes are unknown, and acting on the current roles alone could demote admins
+	if h.bridgeCore == nil || m.Member == nil || m.User == nil || m.BeforeUpdate == nil || m.User.Bot {
+		return
+	}
+
+	before := make(map[string]bool, len(m.BeforeUpdate.Roles))
+	for _, roleID := range m.BeforeUpdate.Roles {
+		before[roleID] = true
+	}
+	after := make(map[string]bool, len(m.Roles))
+	for _, roleID := range m.Roles {
+		after[roleID] = true
+		if !before[roleID] {
+			h.bridgeCore.SyncAdminRole(m.GuildID, m.User.ID, roleID, true)
+		}
+	}
+	for roleID := range before {
+		if !after[roleID] {
+			h.bridgeCore.SyncAdminRole(m.GuildID, m.User.ID, roleID, false)
+		}
+	}
+}
+
+// syncChannelTopic copies a changed channel topic to the channel's bridges
+func (h *MessageHandler) syncChannelTopic(channel *discordgo.Channel) {
+	h.channelTopicsMu.Lock()
+	previousTopic, known := h.channelTopics[channel.ID]
+	h.channelTopics[channel.ID] = channel.Topic
+	h.channelTopicsMu.Unlock()
+
+	if !known || channel.Topic == previousTopic || h.bridgeCore == nil || len(h.bridgeCore.GetBridges(channel.ID)) == 0 {
+		return
+	}
+
+	log.Printf("📝 Discord channel %s topic changed", channel.ID)
+	if err := h.bridgeCore.SyncChannelTopic(types.PlatformDiscord, channel.ID, channel.Topic); err != nil {
+		log.Printf("❌ Failed to sync topic of Discord channel %s: %v", channel.ID, err)
+	}
+}
+
+// onMessageReactionAdd bridges a reaction added to a bridged message
+func (h *MessageHandler) onMessageReactionAdd(s *discordgo.Session, r *discordgo.MessageReactionAdd) {
+	if h.bridgeCore == nil || r.UserID == s.State.User.ID {
+		return
+	}
+
+	if err := h.bridgeCore.BridgeReaction(r.ChannelID, r.MessageID, reactionEmoji(r.Emoji), true); err != nil {
+		log.Printf("❌ Failed to bridge reaction on message %s: %v", r.MessageID, err)
+	}
+}
+
+// onMessageReactionRemove bridges a reaction removed from a bridged message
+func (h *MessageHandler) onMessageReactionRemove(s *discordgo.Session, r *discordgo.MessageReactionRemove) {
+	if h.bridgeCore == nil || r.UserID == s.State.User.ID {
+		return
+	}
+
+	if err := h.bridgeCore.BridgeReaction(r.ChannelID, r.MessageID, reactionEmoji(r.Emoji), false); err != nil {
+		log.Printf("❌ Failed to bridge reaction removal on message %s: %v", r.MessageID, err)
+	}
+}
+
+// reactionEmoji returns the Unicode emoji, or :name: for custom emojis Telegram cannot display
+func reactionEmoji(emoji discordgo.Emoji) string {
+	if emoji.ID != "" {
+		return ":" + emoji.Name + ":"
+	}
+	return emoji.Name
+}
+
+// onVoiceStateUpdate bridges voice channel joins and leaves
+func (h *MessageHandler) onVoiceStateUpdate(s *discordgo.Session, v *discordgo.VoiceStateUpdate) {
+	if h.bridgeCore == nil || v.UserID == s.State.User.ID {
+		return
+	}
+
+	key := v.GuildID + ":" + v.UserID
+	h.voiceStatesMu.Lock()
+	previous, known := h.voiceStates[key]
+	if v.ChannelID == "" {
+		delete(h.voiceStates, key)
+	} else {
+		h.voiceStates[key] = v.ChannelID
+	}
+
+	if !known && v.BeforeUpdate != nil {
+		previous = v.BeforeUpdate.ChannelID
+	}
+
+	// Mute, deafen and other server-side updates keep the same channel
+	if previous == v.ChannelID {
+		h.voiceStatesMu.Unlock()
+		return
+	}
+
+	ended := previous != "" && h.removeVoiceMember(previous, v.UserID)
+	started, participants := false, 0
+	if v.ChannelID != "" {
+		started, participants = h.addVoiceMember(v.ChannelID, v.UserID)
+	}
+	h.voiceStatesMu.Unlock()
+
+	username := h.voiceStateUsername(s, v)
+	if previous != "" {
+		h.bridgeVoiceEvent(s, previous, v.UserID, username, "left")
+	}
+	if v.ChannelID != "" {
+		h.bridgeVoiceEvent(s, v.ChannelID, v.UserID, username, "joined")
+	}
+
+	if ended {
+		h.bridgeVoiceActivity(s, previous, false, 0)
+	}
+	if started {
+		h.bridgeVoiceActivity(s, v.ChannelID, true, participants)
+	}
+}
+
+// addVoiceMember records a user in a voice channel and reports whether the channel was empty before.
+// Callers must hold voiceStatesMu.
+func (h *MessageHandler) addVoiceMember(channelID, userID string) (bool, int) {
+	members := h.voiceMembers[channelID]
+	if members == nil {
+		members = make(map[string]bool)
+		h.voiceMembers[channelID] = members
+	}
+	members[userID] = true
+	return len(members) == 1, len(members)
+}
+
+// removeVoiceMember removes a user from a voice channel and reports whether the channel is now empty.
+// Callers must hold voiceStatesMu.
+func (h *MessageHandler) removeVoiceMember(channelID, userID string) bool {
+	members := h.voiceMembers[channelID]
+	if !members[userID] {
+		return false
+	}
+	delete(members, userID)
+	if len(members) > 0 {
+		return false
+	}
+	delete(h.voiceMembers, channelID)
+	return true
+}
+
+// bridgeVoiceActivity notifies the channels associated with a voice channel that a voice chat started or ended
+func (h *MessageHandler) bridgeVoiceActivity(s *discordgo.Session, voiceChannelID string, started bool, participants int) {
+	voiceChannel, err := s.State.Channel(voiceChannelID)
+	if err != nil {
+		voiceChannel, err = s.Channel(voiceChannelID)
+		if err != nil {
+			log.Printf("❌ Failed to get voice channel %s: %v", voiceChannelID, err)
+			return
+		}
+	}
+
+	for _, channelID := range h.voiceEventChannels(s, voiceChannel) {
+		config, err := h.bridgeCore.GetBridgeConfig(types.PlatformDiscord, channelID)
+		if err != nil {
+			log.Printf("❌ Failed to get bridge config for channel %s: %v", channelID, err)
+			continue
+		}
+		if !config.BridgeVoiceActivity {
+			continue
+		}
+
+		content := config.FormatVoiceEnd(voiceChannel.Name)
+		if started {
+			content = config.FormatVoiceStart(voiceChannel.Name, participants)
+		}
+
+		message := &types.BridgeMessage{
+			ID:              fmt.Sprintf("discord_%s_voice_activity_%d", channelID, time.Now().UnixNano()),
+			SourcePlatform:  types.PlatformDiscord,
+			SourceChannelID: channelID,
+			Content:         content,
+			MessageType:     types.MessageTypeVoiceActivity,
+			Timestamp:       time.Now(),
+		}
+		if err := h.bridgeCore.ProcessMessage(message); err != nil {
+			log.Printf("❌ Failed to bridge voice activity for channel %s: %v", channelID, err)
+		}
+	}
+}
+
+// bridgeVoiceEvent sends a voice join/leave notice to the text channels associated with a voice channel
+func (h *MessageHandler) bridgeVoiceEvent(s *discordgo.Session, voiceChannelID, userID, username, action string) {
+	voiceChannel, err := s.State.Channel(voiceChannelID)
+	if err != nil {
+		voiceChannel, err = s.Channel(voiceChannelID)
+		if err != nil {
+			log.Printf("❌ Failed to get voice channel %s: %v", voiceChannelID, err)
+			return
+		}
+	}
+
+	for _, channelID := range h.voiceEventChannels(s, voiceChannel) {
+		message := &types.BridgeMessage{
+			ID:              fmt.Sprintf("discord_%s_voice_%s_%d", channelID, userID, time.Now().UnixNano()),
+			SourcePlatform:  types.PlatformDiscord,
+			SourceChannelID: channelID,
+			SourceUserID:    userID,
+			Username:        username,
+			Content:         fmt.Sprintf("🔊 @%s %s #%s", username, action, voiceChannel.Name),
+			MessageType:     types.MessageTypeVoiceEvent,
+			Timestamp:       time.Now(),
+		}
+		if err := h.bridgeCore.ProcessMessage(message); err != nil {
+			log.Printf("❌ Failed to bridge voice event for channel %s: %v", channelID, err)
+		}
+	}
+}
+
+// voiceEventChannels returns the bridged channels that should receive events for a voice channel.
+// A voice channel that is bridged itself is used directly, otherwise bridged text channels in the same category are used.
+func (h *MessageHandler) voiceEventChannels(s *discordgo.Session, voiceChannel *discordgo.Channel) []string {
+	if len(h.bridgeCore.GetBridges(voiceChannel.ID)) > 0 {
+		return []string{voiceChannel.ID}
+	}
+	if voiceChannel.ParentID == "" {
+		return nil
+	}
+
+	channels, err := s.GuildChannels(voiceChannel.GuildID)
+	if err != nil {
+		log.Printf("❌ Failed to get guild channels: %v", err)
+		return nil
+	}
+
+	var channelIDs []string
+	for _, channel := range channels {
+		if channel.Type != discordgo.ChannelTypeGuildText || channel.ParentID != voiceChannel.ParentID {
+			continue
+		}
+		if len(h.bridgeCore.GetBridges(channel.ID)) > 0 {
+			channelIDs = append(channelIDs, channel.ID)
+		}
+	}
+	return channelIDs
+}
+
+// voiceStateUsername resolves the display username for a voice state update
+func (h *MessageHandler) voiceStateUsername(s *discordgo.Session, v *discordgo.VoiceStateUpdate) string {
+	if v.Member != nil && v.Member.User != nil {
+		return v.Member.User.Username
+	}
+	if member, err := s.State.Member(v.GuildID, v.UserID); err == nil && member.User != nil {
+		return member.User.Username
+	}
+	if user, err := s.User(v.UserID); err == nil {
+		return user.Username
+	}
+	return "User" + v.UserID
+}
+
 // onInteractionCreate handles slash command interactions
 func (h *MessageHandler) onInteractionCreate(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if i.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		h.handleAutocomplete(s, i)
+		return
+	}
+
+	// Every member may link their own accounts
+	if i.Type == discordgo.InteractionApplicationCommand && i.ApplicationCommandData().Name == "link" {
+		h.handleLinkCommand(s, i)
+		return
+	}
+
 	// Check if user has admin permissions
 	if !h.isAdmin(i.Member) {
 		h.respondToInteraction(s, i, "❌ You don't have permission to use this command.")
 		return
 	}
 
+	if i.Type == discordgo.InteractionMessageComponent {
+		h.handleComponentInteraction(s, i)
+		return
+	}
+
+	if i.Type == discordgo.InteractionModalSubmit {
+		h.handleModalSubmit(s, i)
+		return
+	}
+
 	data := i.ApplicationCommandData()
 	
 	switch data.Name {
@@ -155,6 +751,38 @@ func (h *MessageHandler) handleBridgeCommand(s *discordgo.Session, i *discordgo.
 		h.commandBridgeCreate(s, i, subcommand.Options)
 	case "remove":
 		h.commandBridgeRemove(s, i, subcommand.Options)
+	case "removeall":
+		h.commandBridgeRemoveAll(s, i)
+	case "rename":
+		h.commandBridgeRename(s, i, subcommand.Options)
+	case "setpriority":
+		h.commandBridgeSetPriority(s, i, subcommand.Options)
+	case "import":
+		h.commandBridgeImport(s, i)
+	case "undo":
+		h.commandBridgeUndo(s, i)
+	case "leaderboard":
+		h.commandBridgeLeaderboard(s, i, subcommand.Options)
+	case "test":
+		h.commandBridgeTest(s, i, subcommand.Options)
+	case "events":
+		h.commandBridgeEvents(s, i, subcommand.Options)
+	case "resetstats":
+		h.commandBridgeResetStats(s, i, subcommand.Options)
+	case "migrate":
+		h.commandBridgeMigrate(s, i, subcommand.Options)
+	case "stats":
+		h.commandBridgeStats(s, i)
+	case "permissions":
+		h.commandBridgePermissions(s, i)
+	case "testconnection":
+		h.commandBridgeTestConnection(s, i)
+	case "simulate":
+		h.commandBridgeSimulate(s, i, subcommand.Options)
+	case "setcolor":
+		h.commandBridgeSetColor(s, i, subcommand.Options)
+	case "poll":
+		h.handleBridgePollCommand(s, i, subcommand.Options)
 	default:
 		h.respondToInteraction(s, i, "❓ Unknown bridge subcommand")
 	}
@@ -176,36 +804,275 @@ func (h *MessageHandler) handleConfigCommand(s *discordgo.Session, i *discordgo.
 		h.commandConfigPlatforms(s, i)
 	case "channels":
 		h.commandConfigChannels(s, i)
+	case "filter":
+		h.handleConfigFilterCommand(s, i, subcommand.Options)
+	case "silent":
+		h.handleConfigSilentCommand(s, i, subcommand.Options)
+	case "compact":
+		h.handleConfigCompactCommand(s, i, subcommand.Options)
+	case "delay":
+		h.handleConfigDelayCommand(s, i, subcommand.Options)
+	case "whitelist":
+		h.handleConfigWhitelistCommand(s, i, subcommand.Options)
+	case "allowtypes":
+		h.handleConfigAllowTypesCommand(s, i, subcommand.Options)
+	case "audit":
+		h.commandConfigAudit(s, i, subcommand.Options)
+	case "webhooks":
+		h.commandConfigWebhooksCleanup(s, i)
+	case "db":
+		if len(subcommand.Options) > 0 {
+			switch subcommand.Options[0].Name {
+			case "stats":
+				h.commandConfigDBStats(s, i)
+				return
+			case "history":
+				h.commandConfigDBHistory(s, i)
+				return
+			}
+		}
+		h.commandConfigDBPrune(s, i)
+	case "templates":
+		h.commandConfigTemplatesList(s, i)
+	case "rate-test":
+		h.commandConfigRateTest(s, i, subcommand.Options)
+	case "bulkupdate":
+		h.commandConfigBulkUpdate(s, i, subcommand.Options)
+	case "adminrole":
+		h.handleConfigAdminRoleCommand(s, i, subcommand.Options)
 	default:
 		h.respondToInteraction(s, i, "❓ Unknown config subcommand")
 	}
 }
 
+// handleConfigFilterCommand handles content filter subcommands
+func (h *MessageHandler) handleConfigFilterCommand(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if len(options) == 0 {
+		h.respondToInteraction(s, i, "❌ No filter subcommand specified")
+		return
+	}
+
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	switch options[0].Name {
+	case "add":
+		h.commandConfigFilterAdd(s, i, options[0].Options)
+	case "list":
+		h.commandConfigFilterList(s, i)
+	default:
+		h.respondToInteraction(s, i, "❓ Unknown filter subcommand")
+	}
+}
+
+// commandConfigFilterAdd adds a content filter rule to the current channel's bridge
+func (h *MessageHandler) commandConfigFilterAdd(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	optionMap := getOptionMap(options)
+
+	rule := models.FilterRule{
+		Mode:   models.FilterModeExact,
+		Action: models.FilterActionBlock,
+	}
+	if opt, ok := optionMap["pattern"]; ok {
+		rule.Pattern = opt.StringValue()
+	}
+	if opt, ok := optionMap["mode"]; ok {
+		rule.Mode = opt.StringValue()
+	}
+	if opt, ok := optionMap["action"]; ok {
+		rule.Action = opt.StringValue()
+	}
+	if opt, ok := optionMap["replacement"]; ok {
+		rule.Replacement = opt.StringValue()
+	}
+
+	if rule.Action == models.FilterActionReplace && rule.Replacement == "" {
+		h.respondToInteraction(s, i, "❌ A replacement text is required for the replace action")
+		return
+	}
+
+	previous, err := h.bridgeCore.GetBridgeConfig(types.PlatformDiscord, i.ChannelID)
+	if err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to add filter: %v", err))
+		return
+	}
+
+	if err := h.bridgeCore.AddFilterRule(types.PlatformDiscord, i.ChannelID, rule); err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to add filter: %v", err))
+		return
+	}
+	h.recordAudit(i, AuditActionFilterAdd, fmt.Sprintf("discord_%s", i.ChannelID), fmt.Sprintf("%s %s: %s", rule.Mode, rule.Action, rule.Pattern))
+
+	h.pushUndo(interactionUserID(i), &UndoAction{
+		ActionType: UndoActionUpdateConfig,
+		Payload:    &configSnapshot{Platform: types.PlatformDiscord, ChannelID: i.ChannelID, Config: previous},
+	})
+
+	fields := []*discordgo.MessageEmbedField{
+		{
+			Name:   "Pattern",
+			Value:  fmt.Sprintf("`%s`", rule.Pattern),
+			Inline: true,
+		},
+		{
+			Name:   "Mode",
+			Value:  strings.Title(rule.Mode),
+			Inline: true,
+		},
+		{
+			Name:   "Action",
+			Value:  strings.Title(rule.Action),
+			Inline: true,
+		},
+	}
+	if rule.Action == models.FilterActionReplace {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Replacement",
+			Value:  fmt.Sprintf("`%s`", rule.Replacement),
+			Inline: true,
+		})
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:  "🛡️ Filter Added",
+		Color:  0x00ff00,
+		Fields: fields,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Applies to all messages bridged from this channel's bridge",
+		},
+	}
+
+	h.respondToInteractionWithEmbed(s, i, embed)
+}
+
+// commandConfigFilterList lists content filter rules for the current channel's bridge
+func (h *MessageHandler) commandConfigFilterList(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	rules, err := h.bridgeCore.GetFilterRules(types.PlatformDiscord, i.ChannelID)
+	if err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to get filters: %v", err))
+		return
+	}
+
+	ruleList := ""
+	for n, rule := range rules {
+		ruleList += fmt.Sprintf("%d. `%s` (%s, %s", n+1, rule.Pattern, rule.Mode, rule.Action)
+		if rule.Action == models.FilterActionReplace {
+			ruleList += fmt.Sprintf(" → `%s`", rule.Replacement)
+		}
+		ruleList += ")\n"
+	}
+	if ruleList == "" {
+		ruleList = "No filters configured for this bridge"
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: "🛡️ Content Filters",
+		Color: 0x0099ff,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "Rules",
+				Value:  ruleList,
+				Inline: false,
+			},
+		},
+	}
+
+	h.respondToInteractionWithEmbed(s, i, embed)
+}
+
+// helpLine is a command and its description shown by /help
+type helpLine struct {
+	command     string
+	description string
+}
+
+// Commands listed by /help, by embed field
+var (
+	bridgeHelp = []helpLine{
+		{"/bridge status", "Show bridge status"},
+		{"/bridge create", "Create new bridge"},
+		{"/bridge rename", "Rename a bridge"},
+		{"/bridge setpriority", "Set a bridge's delivery priority"},
+		{"/bridge import", "Create bridges from JSON"},
+		{"/bridge remove", "Remove bridge"},
+		{"/bridge removeall", "Remove all bridges of this server"},
+		{"/bridge undo", "Undo your last change"},
+		{"/bridge leaderboard", "Show the most active users"},
+		{"/bridge stats", "Show bridge statistics"},
+		{"/bridge simulate", "Dry-run a message through the bridge"},
+		{"/bridge setcolor", "Set the bridge embed color"},
+		{"/bridge test", "Send a health check probe"},
+		{"/bridge events", "Show recent delivery attempts"},
+		{"/bridge resetstats", "Reset a bridge's statistics (server admins)"},
+		{"/bridge migrate", "Move all bridges to another channel"},
+		{"/bridge permissions", "Check the bot's channel permissions"},
+		{"/bridge testconnection", "Check the platform API credentials"},
+		{"/bridge poll create", "Create a poll on Telegram"},
+		{"/bridge poll results", "Show poll results"},
+	}
+	configHelp = []helpLine{
+		{"/config platforms", "Show enabled platforms"},
+		{"/config channels", "List available channels"},
+		{"/config filter add", "Add a content filter"},
+		{"/config filter list", "List content filters"},
+		{"/config silent", "Mute Telegram notifications"},
+		{"/config compact", "Hide platform prefixes"},
+		{"/config delay", "Delay bridged messages"},
+		{"/config whitelist", "Restrict who can send through the bridge"},
+		{"/config allowtypes", "Choose the bridged message types"},
+		{"/config audit", "Show recent configuration changes"},
+		{"/config db prune", "Delete old bridged messages"},
+		{"/config db stats", "Show database table sizes"},
+		{"/config db history", "Show the database growth of the last week"},
+		{"/config webhooks cleanup", "Delete unused webhooks"},
+		{"/config templates list", "List bridge templates"},
+		{"/config rate-test", "Simulate a message burst"},
+		{"/config bulkupdate", "Change a setting of every bridge (server admins)"},
+		{"/config adminrole", "Sync Telegram group admins with a Discord role (server admins)"},
+	}
+	generalHelp = []helpLine{
+		{"/help", "Show this help message"},
+		{"/link <code>", "Link your Discord account to your Telegram account"},
+	}
+)
+
+// helpFieldValue renders help lines with descriptions in the user's language
+func helpFieldValue(localizer *i18n.Localizer, lines []helpLine) string {
+	var rendered []string
+	for _, line := range lines {
+		rendered = append(rendered, fmt.Sprintf("`%s` - %s", line.command, localizer.T(line.description)))
+	}
+	return strings.Join(rendered, "\n")
+}
+
 // handleHelpCommand handles help command
 func (h *MessageHandler) handleHelpCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	localizer := interactionLocalizer(i)
 	embed := &discordgo.MessageEmbed{
-		Title:       "🌉 Bridge Bot Help",
-		Description: "Commands to manage cross-platform bridges",
+		Title:       localizer.T("🌉 Bridge Bot Help"),
+		Description: localizer.T("Commands to manage cross-platform bridges"),
 		Color:       0x00ff00,
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "🔗 Bridge Commands",
-				Value:  "`/bridge status` - Show bridge status\n`/bridge create` - Create new bridge\n`/bridge remove` - Remove bridge",
+				Name:   localizer.T("🔗 Bridge Commands"),
+				Value:  helpFieldValue(localizer, bridgeHelp),
 				Inline: false,
 			},
 			{
-				Name:   "⚙️ Config Commands",
-				Value:  "`/config platforms` - Show enabled platforms\n`/config channels` - List available channels",
+				Name:   localizer.T("⚙️ Config Commands"),
+				Value:  helpFieldValue(localizer, configHelp),
 				Inline: false,
 			},
 			{
-				Name:   "ℹ️ General",
-				Value:  "`/help` - Show this help message",
+				Name:   localizer.T("ℹ️ General"),
+				Value:  helpFieldValue(localizer, generalHelp),
 				Inline: false,
 			},
 		},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "Bridge Bot - Discord Control Center",
+			Text: localizer.T("Bridge Bot - Discord Control Center"),
 		},
 		Timestamp: time.Now().Format(time.RFC3339),
 	}
@@ -235,7 +1102,11 @@ func (h *MessageHandler) commandBridgeStatus(s *discordgo.Session, i *discordgo.
 		bridges := h.bridgeCore.GetBridges(channelID)
 		if len(bridges) > 0 {
 			for _, bridge := range bridges {
-				bridgeList += fmt.Sprintf("• **%s**: `%s`\n", strings.Title(bridge.TargetPlatform), bridge.TargetChannelID)
+				bridgeList += fmt.Sprintf("• **%s**: `%s`", strings.Title(bridge.TargetPlatform), bridge.TargetChannelID)
+				if bridge.Name != "" {
+					bridgeList += fmt.Sprintf(" - %s", bridge.Name)
+				}
+				bridgeList += "\n"
 			}
 			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
 				Name:   "🌉 Active Bridges",
@@ -243,6 +1114,23 @@ func (h *MessageHandler) commandBridgeStatus(s *discordgo.Session, i *discordgo.
 				Inline: false,
 			})
 			embed.Color = 0x00ff00
+
+			if config, err := h.bridgeCore.GetBridgeConfig(types.PlatformDiscord, channelID); err == nil {
+				embed.Color = config.EmbedColor
+				embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+					Name:   "🔕 Silent Mode",
+					Value:  silentModeDescription(config),
+					Inline: true,
+				}, &discordgo.MessageEmbedField{
+					Name:   "🎨 Embed Color",
+					Value:  fmt.Sprintf("`#%06X`", config.EmbedColor),
+					Inline: true,
+				}, &discordgo.MessageEmbedField{
+					Name:   "⏳ Delivery Delay",
+					Value:  deliveryDelayDescription(config.DeliveryDelaySeconds),
+					Inline: true,
+				})
+			}
 		} else {
 			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
 				Name:   "🌉 Active Bridges",
@@ -260,6 +1148,9 @@ func (h *MessageHandler) commandBridgeStatus(s *discordgo.Session, i *discordgo.
 					status = "✅ Connected"
 				}
 				platformStatus += fmt.Sprintf("• **%s**: %s\n", strings.Title(platform), status)
+				if isConnected {
+					platformStatus += h.platformChatDetails(platform, i.GuildID, channelID)
+				}
 			}
 		} else {
 			platformStatus = "• **Discord**: ✅ Active (Control Center)\n• **Telegram**: ⏳ Checking..."
@@ -301,6 +1192,31 @@ func (h *MessageHandler) commandBridgeStatus(s *discordgo.Session, i *discordgo.
 	h.respondToInteractionWithEmbed(s, i, embed)
 }
 
+// platformChatDetails describes the Discord server or the bridged Telegram chats of a channel for the status embed
+func (h *MessageHandler) platformChatDetails(platform, guildID, channelID string) string {
+	details := ""
+	switch platform {
+	case types.PlatformDiscord:
+		if info, err := h.bridgeCore.GetChatInfo(types.PlatformDiscord, guildID); err == nil {
+			details += fmt.Sprintf("  Guild: %s (%d members)\n", info.Title, info.MemberCount)
+		} else {
+			h.client.logger.Debug("⚠️ Failed to get guild info", "guild_id", guildID, "error", err)
+		}
+	case types.PlatformTelegram:
+		for _, bridge := range h.bridgeCore.GetBridges(channelID) {
+			if bridge.TargetPlatform != types.PlatformTelegram {
+				continue
+			}
+			if info, err := h.bridgeCore.GetChatInfo(types.PlatformTelegram, bridge.TargetChannelID); err == nil {
+				details += fmt.Sprintf("  Chat: %s (%d members)\n", info.Title, info.MemberCount)
+			} else {
+				h.client.logger.Debug("⚠️ Failed to get chat info", "chat_id", bridge.TargetChannelID, "error", err)
+			}
+		}
+	}
+	return details
+}
+
 // commandBridgeCreate creates a new bridge
 func (h *MessageHandler) commandBridgeCreate(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
 	if len(options) < 2 {
@@ -312,6 +1228,40 @@ func (h *MessageHandler) commandBridgeCreate(s *discordgo.Session, i *discordgo.
 	targetRoom := options[1].StringValue()
 	channelID := i.ChannelID
 
+	// Telegram chats can also be given as an invite code created through /getid
+	if platform == types.PlatformTelegram && h.bridgeCore != nil {
+		if _, err := strconv.ParseInt(targetRoom, 10, 64); err != nil {
+			invite, err := h.bridgeCore.RedeemBridgeInvite(strings.ToUpper(strings.TrimSpace(targetRoom)))
+			if err != nil {
+				h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to redeem invite code: %v", err))
+				return
+			}
+			if invite == nil {
+				h.respondToInteraction(s, i, "❌ Unknown or expired invite code. Run /getid in the Telegram chat for a new one.")
+				return
+			}
+			targetRoom = invite.ChatID
+		}
+	}
+
+	name := ""
+	if option, ok := getOptionMap(options)["name"]; ok {
+		name = strings.TrimSpace(option.StringValue())
+		if err := h.validateBridgeName(s, i.GuildID, name, ""); err != nil {
+			h.respondToInteraction(s, i, fmt.Sprintf("❌ %v", err))
+			return
+		}
+	}
+
+	var template *config.BridgeTemplate
+	if option, ok := getOptionMap(options)["template"]; ok {
+		var err error
+		if template, err = h.bridgeTemplate(strings.TrimSpace(option.StringValue())); err != nil {
+			h.respondToInteraction(s, i, fmt.Sprintf("❌ %v", err))
+			return
+		}
+	}
+
 	// Use bridge core if available
 	if h.bridgeCore != nil {
 		err := h.bridgeCore.AddBridge("discord", channelID, platform, targetRoom)
@@ -319,6 +1269,25 @@ func (h *MessageHandler) commandBridgeCreate(s *discordgo.Session, i *discordgo.
 			h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to create bridge: %v", err))
 			return
 		}
+		details := ""
+		if template != nil {
+			details = "template " + template.Name
+			if err := h.applyBridgeTemplate(channelID, template); err != nil {
+				log.Printf("⚠️ Failed to apply template %s to bridge of channel %s: %v", template.Name, channelID, err)
+				details += " (failed)"
+			}
+		}
+		h.recordAudit(i, AuditActionBridgeCreate, fmt.Sprintf("discord_%s_%s_%s", channelID, platform, targetRoom), details)
+
+		if name != "" {
+			for _, bridge := range h.bridgeCore.GetBridges(channelID) {
+				if bridge.TargetPlatform == platform && bridge.TargetChannelID == targetRoom {
+					if err := h.bridgeCore.SetBridgeName(bridge.ID, name); err != nil {
+						log.Printf("⚠️ Failed to name bridge %s: %v", bridge.ID, err)
+					}
+				}
+			}
+		}
 	} else {
 		// Fallback to old method
 		if h.bridgedChannels[channelID] == nil {
@@ -329,7 +1298,7 @@ func (h *MessageHandler) commandBridgeCreate(s *discordgo.Session, i *discordgo.
 
 	embed := &discordgo.MessageEmbed{
 		Title: "✅ Bridge Created",
-		Color: 0x00ff00,
+		Color: h.bridgeEmbedColor(channelID, 0x00ff00),
 		Fields: []*discordgo.MessageEmbedField{
 			{
 				Name:   "Discord Channel",
@@ -351,37 +1320,275 @@ func (h *MessageHandler) commandBridgeCreate(s *discordgo.Session, i *discordgo.
 			Text: "Bridge is now active - messages will be synchronized",
 		},
 	}
+	if name != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Name",
+			Value:  name,
+			Inline: true,
+		})
+	}
+	if template != nil {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Template",
+			Value:  template.Name,
+			Inline: true,
+		})
+	}
 
 	h.respondToInteractionWithEmbed(s, i, embed)
 	log.Printf("🌉 Bridge created: Discord %s ↔ %s %s", channelID, platform, targetRoom)
 }
 
+// commandBridgeRename renames a bridge
+func (h *MessageHandler) commandBridgeRename(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	optionMap := getOptionMap(options)
+	bridgeOption, hasBridge := optionMap["bridge_id"]
+	nameOption, hasName := optionMap["name"]
+	if !hasBridge || !hasName {
+		h.respondToInteraction(s, i, "❌ Missing required parameters")
+		return
+	}
+
+	bridgeID := bridgeOption.StringValue()
+	name := strings.TrimSpace(nameOption.StringValue())
+	if err := h.validateBridgeName(s, i.GuildID, name, bridgeID); err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ %v", err))
+		return
+	}
+
+	if err := h.bridgeCore.SetBridgeName(bridgeID, name); err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to rename bridge: %v", err))
+		return
+	}
+	h.recordAudit(i, AuditActionBridgeRename, bridgeID, fmt.Sprintf("name: %s", name))
+
+	h.respondToInteraction(s, i, fmt.Sprintf("🏷️ Bridge `%s` renamed to **%s**", bridgeID, name))
+}
+
+// commandBridgeSetPriority sets the delivery priority of a bridge's target channel
+func (h *MessageHandler) commandBridgeSetPriority(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	optionMap := getOptionMap(options)
+	bridgeOption, hasBridge := optionMap["bridge_id"]
+	priorityOption, hasPriority := optionMap["priority"]
+	if !hasBridge || !hasPriority {
+		h.respondToInteraction(s, i, "❌ Missing required parameters")
+		return
+	}
+
+	bridgeID := bridgeOption.StringValue()
+	priority := int(priorityOption.IntValue())
+	if err := h.bridgeCore.SetBridgePriority(bridgeID, priority); err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to set bridge priority: %v", err))
+		return
+	}
+	h.recordAudit(i, AuditActionSetPriority, bridgeID, fmt.Sprintf("priority: %d", priority))
+
+	h.respondToInteraction(s, i, fmt.Sprintf("🔢 Bridge `%s` now has priority **%d**", bridgeID, priority))
+}
+
+// validateBridgeName checks a bridge name's length and that no other bridge in the guild uses it
+func (h *MessageHandler) validateBridgeName(s *discordgo.Session, guildID, name, bridgeID string) error {
+	length := len([]rune(name))
+	if length < 1 || length > 50 {
+		return fmt.Errorf("bridge name must be 1-50 characters")
+	}
+
+	for _, bridge := range h.guildBridges(s, guildID) {
+		if bridge.ID != bridgeID && strings.EqualFold(bridge.Name, name) {
+			return fmt.Errorf("a bridge named %q already exists in this server", bridge.Name)
+		}
+	}
+	return nil
+}
+
+// selectedBridge returns the bridge picked in the bridge_id option, defaulting to the first bridge of the current channel
+func (h *MessageHandler) selectedBridge(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) *types.BridgeConnection {
+	if option, ok := getOptionMap(options)["bridge_id"]; ok {
+		for _, bridge := range h.guildBridges(s, i.GuildID) {
+			if bridge.ID == option.StringValue() {
+				return bridge
+			}
+		}
+		return nil
+	}
+
+	if bridges := h.bridgeCore.GetBridges(i.ChannelID); len(bridges) > 0 {
+		return bridges[0]
+	}
+	return nil
+}
+
+// commandBridgeTest sends a health check probe through a bridge and reports the result
+func (h *MessageHandler) commandBridgeTest(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if h.bridgeCore == nil || h.bridgeTester == nil {
+		h.respondToInteraction(s, i, "❌ Bridge testing is not available")
+		return
+	}
+
+	bridge := h.selectedBridge(s, i, options)
+	if bridge == nil {
+		h.respondToInteraction(s, i, "❌ Bridge not found. Pick a bridge or run this in a bridged channel.")
+		return
+	}
+
+	// Probes may take up to a minute, so acknowledge first and edit the response later
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		log.Printf("❌ Failed to acknowledge bridge test: %v", err)
+		return
+	}
+
+	if err := h.bridgeTester.RunNow(bridge.ID); err != nil {
+		h.editInteractionContent(s, i.Interaction, fmt.Sprintf("❌ Bridge health check failed: %v", err))
+		return
+	}
+	h.editInteractionContent(s, i.Interaction, "✅ Bridge health check passed - the probe reached both sides")
+}
+
+// guildBridges returns the bridge connections whose source is a channel of the guild
+func (h *MessageHandler) guildBridges(s *discordgo.Session, guildID string) []*types.BridgeConnection {
+	if h.bridgeCore == nil {
+		return nil
+	}
+
+	channels, err := s.GuildChannels(guildID)
+	if err != nil {
+		log.Printf("❌ Failed to get guild channels: %v", err)
+		return nil
+	}
+
+	var bridges []*types.BridgeConnection
+	for _, channel := range channels {
+		bridges = append(bridges, h.bridgeCore.GetBridges(channel.ID)...)
+	}
+	return bridges
+}
+
+// handleAutocomplete suggests bridges for bridge_id options, labelled with their names when set
+func (h *MessageHandler) handleAutocomplete(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	choices := []*discordgo.ApplicationCommandOptionChoice{}
+
+	if h.isAdmin(i.Member) {
+		query := strings.ToLower(focusedOptionValue(i.ApplicationCommandData().Options))
+		for _, bridge := range h.guildBridges(s, i.GuildID) {
+			label := bridge.ID
+			if bridge.Name != "" {
+				label = fmt.Sprintf("%s (%s)", bridge.Name, bridge.ID)
+			}
+			if query != "" && !strings.Contains(strings.ToLower(label), query) {
+				continue
+			}
+			choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+				Name:  truncateChoiceName(label),
+				Value: bridge.ID,
+			})
+			if len(choices) == 25 {
+				break
+			}
+		}
+	}
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{
+			Choices: choices,
+		},
+	})
+	if err != nil {
+		log.Printf("❌ Failed to respond to autocomplete: %v", err)
+	}
+}
+
+// focusedOptionValue returns the value the user is currently typing in an autocomplete interaction
+func focusedOptionValue(options []*discordgo.ApplicationCommandInteractionDataOption) string {
+	for _, option := range options {
+		if option.Focused {
+			return option.StringValue()
+		}
+		if value := focusedOptionValue(option.Options); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// truncateChoiceName shortens a label to Discord's 100 character choice name limit
+func truncateChoiceName(label string) string {
+	runes := []rune(label)
+	if len(runes) > 100 {
+		return string(runes[:97]) + "..."
+	}
+	return label
+}
+
 // commandBridgeRemove removes a bridge
 func (h *MessageHandler) commandBridgeRemove(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
 	if len(options) < 1 {
-		h.respondToInteraction(s, i, "❌ Missing platform parameter")
+		h.promptBridgeRemoval(s, i)
 		return
 	}
 
 	platform := options[0].StringValue()
 	channelID := i.ChannelID
 
+	description := fmt.Sprintf("Remove the **%s** bridge from <#%s>?\nMessages will no longer be synchronized.", strings.Title(platform), channelID)
+	err := h.withConfirmation(s, i, description, func() {
+		h.removeBridge(s, i, channelID, platform)
+	})
+	if err != nil {
+		log.Printf("❌ Failed to request bridge removal confirmation: %v", err)
+	}
+}
+
+// removeBridge removes a confirmed bridge and updates the confirmation message with the result
+func (h *MessageHandler) removeBridge(s *discordgo.Session, i *discordgo.InteractionCreate, channelID, platform string) {
 	// Use bridge core if available
 	if h.bridgeCore != nil {
+		var removed *removedBridge
+		for _, bridge := range h.bridgeCore.GetBridges(channelID) {
+			if bridge.TargetPlatform == platform {
+				removed = &removedBridge{
+					SourcePlatform:  bridge.SourcePlatform,
+					SourceChannelID: channelID,
+					TargetPlatform:  platform,
+					TargetChannelID: bridge.TargetChannelID,
+					Name:            bridge.Name,
+				}
+				break
+			}
+		}
+
 		err := h.bridgeCore.RemoveBridge(channelID, platform)
 		if err != nil {
-			h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to remove bridge: %v", err))
+			h.editInteractionContent(s, i.Interaction, fmt.Sprintf("❌ Failed to remove bridge: %v", err))
 			return
 		}
+
+		if removed != nil {
+			h.pushUndo(interactionUserID(i), &UndoAction{ActionType: UndoActionRemoveBridge, Payload: removed})
+		}
+		h.recordAudit(i, AuditActionBridgeRemove, fmt.Sprintf("discord_%s_%s", channelID, platform), "")
 	} else {
 		// Fallback to old method
 		if h.bridgedChannels[channelID] == nil {
-			h.respondToInteraction(s, i, "❌ No bridges configured for this channel")
+			h.editInteractionContent(s, i.Interaction, "❌ No bridges configured for this channel")
 			return
 		}
 
 		if _, exists := h.bridgedChannels[channelID][platform]; !exists {
-			h.respondToInteraction(s, i, fmt.Sprintf("❌ No %s bridge found for this channel", platform))
+			h.editInteractionContent(s, i.Interaction, fmt.Sprintf("❌ No %s bridge found for this channel", platform))
 			return
 		}
 
@@ -394,7 +1601,113 @@ func (h *MessageHandler) commandBridgeRemove(s *discordgo.Session, i *discordgo.
 		}
 	}
 
-	embed := &discordgo.MessageEmbed{
+	h.editInteractionEmbed(s, i.Interaction, bridgeRemovedEmbed(platform, channelID))
+	log.Printf("🗑️ Bridge removed: %s bridge for Discord channel %s", platform, channelID)
+}
+
+// promptBridgeRemoval lists the bridges of the current channel in a select menu
+func (h *MessageHandler) promptBridgeRemoval(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Missing platform parameter")
+		return
+	}
+
+	bridges := h.bridgeCore.GetBridges(i.ChannelID)
+	if len(bridges) == 0 {
+		h.respondToInteraction(s, i, "❌ No bridges configured for this channel")
+		return
+	}
+
+	var menuOptions []discordgo.SelectMenuOption
+	for _, bridge := range bridges {
+		if len(menuOptions) == maxSelectMenuOptions {
+			break
+		}
+		label := fmt.Sprintf("%s → %s", bridge.TargetPlatform, bridge.TargetChannelID)
+		if bridge.Name != "" {
+			label = fmt.Sprintf("%s (%s)", label, bridge.Name)
+		}
+		menuOptions = append(menuOptions, discordgo.SelectMenuOption{
+			Label: truncateChoiceName(label),
+			Value: bridge.ID,
+			Emoji: &discordgo.ComponentEmoji{Name: platformIcon(bridge.TargetPlatform)},
+		})
+	}
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: "Select the bridge to remove:",
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.SelectMenu{
+							CustomID:    removeBridgeSelectCustomID,
+							Placeholder: "Choose a bridge",
+							Options:     menuOptions,
+						},
+					},
+				},
+			},
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		log.Printf("❌ Failed to send bridge selection: %v", err)
+	}
+}
+
+// handleBridgeRemoveSelect asks for confirmation before removing the bridge picked in the select menu
+func (h *MessageHandler) handleBridgeRemoveSelect(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	values := i.MessageComponentData().Values
+	if len(values) == 0 || h.bridgeCore == nil {
+		return
+	}
+
+	var selected *types.BridgeConnection
+	for _, bridge := range h.bridgeCore.GetBridges(i.ChannelID) {
+		if bridge.ID == values[0] {
+			selected = bridge
+			break
+		}
+	}
+	if selected == nil {
+		h.respondToInteraction(s, i, "❌ Bridge not found. It may have been removed already.")
+		return
+	}
+
+	description := fmt.Sprintf("Remove the **%s** bridge from <#%s> to `%s`?\nMessages will no longer be synchronized.", strings.Title(selected.TargetPlatform), i.ChannelID, selected.TargetChannelID)
+	err := h.withConfirmation(s, i, description, func() {
+		h.removeBridgeByID(s, i, selected)
+	})
+	if err != nil {
+		log.Printf("❌ Failed to request bridge removal confirmation: %v", err)
+	}
+}
+
+// removeBridgeByID removes a confirmed bridge picked from the select menu
+func (h *MessageHandler) removeBridgeByID(s *discordgo.Session, i *discordgo.InteractionCreate, bridge *types.BridgeConnection) {
+	if err := h.bridgeCore.RemoveBridgeByID(bridge.ID); err != nil {
+		h.editInteractionContent(s, i.Interaction, fmt.Sprintf("❌ Failed to remove bridge: %v", err))
+		return
+	}
+	h.recordAudit(i, AuditActionBridgeRemove, bridge.ID, "")
+
+	h.pushUndo(interactionUserID(i), &UndoAction{ActionType: UndoActionRemoveBridge, Payload: &removedBridge{
+		SourcePlatform:  bridge.SourcePlatform,
+		SourceChannelID: bridge.SourceChannelID,
+		TargetPlatform:  bridge.TargetPlatform,
+		TargetChannelID: bridge.TargetChannelID,
+		Name:            bridge.Name,
+	}})
+
+	h.editInteractionEmbed(s, i.Interaction, bridgeRemovedEmbed(bridge.TargetPlatform, bridge.SourceChannelID))
+	log.Printf("🗑️ Bridge removed: %s bridge %s for Discord channel %s", bridge.TargetPlatform, bridge.ID, bridge.SourceChannelID)
+}
+
+// bridgeRemovedEmbed builds the embed shown after a bridge was removed
+func bridgeRemovedEmbed(platform, channelID string) *discordgo.MessageEmbed {
+	return &discordgo.MessageEmbed{
 		Title: "🗑️ Bridge Removed",
 		Color: 0xff9900,
 		Fields: []*discordgo.MessageEmbedField{
@@ -410,12 +1723,9 @@ func (h *MessageHandler) commandBridgeRemove(s *discordgo.Session, i *discordgo.
 			},
 		},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "Bridge removed - messages will no longer be synchronized",
+			Text: "Bridge removed - use /bridge undo to restore it",
 		},
 	}
-
-	h.respondToInteractionWithEmbed(s, i, embed)
-	log.Printf("🗑️ Bridge removed: %s bridge for Discord channel %s", platform, channelID)
 }
 
 // commandConfigPlatforms shows enabled platforms
@@ -445,7 +1755,7 @@ func (h *MessageHandler) commandConfigPlatforms(s *discordgo.Session, i *discord
 
 // commandConfigChannels lists available channels
 func (h *MessageHandler) commandConfigChannels(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	channels, err := h.client.GetGuildChannels()
+	channels, err := h.client.GetGuildChannels(i.GuildID)
 	if err != nil {
 		h.respondToInteraction(s, i, "❌ Failed to get channel list")
 		return
@@ -455,7 +1765,7 @@ func (h *MessageHandler) commandConfigChannels(s *discordgo.Session, i *discordg
 	bridgedCount := 0
 
 	for _, channel := range channels {
-		if channel.Type == discordgo.ChannelTypeGuildText {
+		if channel.Type == discordgo.ChannelTypeGuildText || channel.Type == discordgo.ChannelTypeGuildForum {
 			bridgeStatus := ""
 			if bridges, exists := h.bridgedChannels[channel.ID]; exists && len(bridges) > 0 {
 				bridgeStatus = " 🌉"
@@ -519,6 +1829,15 @@ func (h *MessageHandler) isAdmin(member *discordgo.Member) bool {
 	return false
 }
 
+// getOptionMap indexes command options by name
+func getOptionMap(options []*discordgo.ApplicationCommandInteractionDataOption) map[string]*discordgo.ApplicationCommandInteractionDataOption {
+	optionMap := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		optionMap[opt.Name] = opt
+	}
+	return optionMap
+}
+
 // sendErrorMessage sends an error message to a channel
 func (h *MessageHandler) sendErrorMessage(channelID, errorMsg string) {
 	message := fmt.Sprintf("❌ Error: %s", errorMsg)
@@ -528,12 +1847,12 @@ func (h *MessageHandler) sendErrorMessage(channelID, errorMsg string) {
 	}
 }
 
-// respondToInteraction sends a response to a slash command interaction
+// respondToInteraction sends a response to a slash command interaction, translated into the user's language when possible
 func (h *MessageHandler) respondToInteraction(s *discordgo.Session, i *discordgo.InteractionCreate, content string) {
 	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseChannelMessageWithSource,
 		Data: &discordgo.InteractionResponseData{
-			Content: content,
+			Content: interactionLocalizer(i).T(content),
 		},
 	})
 	if err != nil {
@@ -554,6 +1873,176 @@ func (h *MessageHandler) respondToInteractionWithEmbed(s *discordgo.Session, i *
 	}
 }
 
+// withConfirmation asks the user to confirm a destructive operation with Confirm/Cancel buttons.
+// onConfirm runs after the user confirms; the buttons are disabled if nobody answers within confirmationTimeout.
+func (h *MessageHandler) withConfirmation(s *discordgo.Session, i *discordgo.InteractionCreate, description string, onConfirm func()) error {
+	key := i.ID
+
+	h.confirmationsMu.Lock()
+	h.confirmations[key] = &pendingConfirmation{
+		interaction: i.Interaction,
+		onConfirm:   onConfirm,
+	}
+	h.confirmationsMu.Unlock()
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "⚠️ Confirm Operation",
+		Description: description,
+		Color:       0xff9900,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("This confirmation expires in %d seconds", int(confirmationTimeout.Seconds())),
+		},
+	}
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: confirmationButtons(key, false),
+			Flags:      discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		h.takeConfirmation(key)
+		return fmt.Errorf("failed to send confirmation: %v", err)
+	}
+
+	time.AfterFunc(confirmationTimeout, func() {
+		h.expireConfirmation(s, key)
+	})
+	return nil
+}
+
+// confirmationButtons builds the Confirm/Cancel button row for a pending confirmation
+func confirmationButtons(key string, disabled bool) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "✅ Confirm",
+					Style:    discordgo.DangerButton,
+					CustomID: confirmCustomIDPrefix + key,
+					Disabled: disabled,
+				},
+				discordgo.Button{
+					Label:    "❌ Cancel",
+					Style:    discordgo.SecondaryButton,
+					CustomID: cancelCustomIDPrefix + key,
+					Disabled: disabled,
+				},
+			},
+		},
+	}
+}
+
+// takeConfirmation removes and returns a pending confirmation, or nil if it no longer exists
+func (h *MessageHandler) takeConfirmation(key string) *pendingConfirmation {
+	h.confirmationsMu.Lock()
+	defer h.confirmationsMu.Unlock()
+
+	pending := h.confirmations[key]
+	delete(h.confirmations, key)
+	return pending
+}
+
+// expireConfirmation disables the buttons of a confirmation that was not answered in time
+func (h *MessageHandler) expireConfirmation(s *discordgo.Session, key string) {
+	pending := h.takeConfirmation(key)
+	if pending == nil {
+		return
+	}
+
+	components := confirmationButtons(key, true)
+	if _, err := s.InteractionResponseEdit(pending.interaction, &discordgo.WebhookEdit{Components: &components}); err != nil {
+		log.Printf("❌ Failed to disable expired confirmation buttons: %v", err)
+	}
+}
+
+// handleComponentInteraction handles clicks on confirmation buttons and select menus
+func (h *MessageHandler) handleComponentInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	customID := i.MessageComponentData().CustomID
+	if customID == removeBridgeSelectCustomID {
+		h.handleBridgeRemoveSelect(s, i)
+		return
+	}
+	if strings.HasPrefix(customID, removeAllCustomIDPrefix) {
+		h.handleRemoveAllConfirm(s, i, strings.TrimPrefix(customID, removeAllCustomIDPrefix))
+		return
+	}
+	if strings.HasPrefix(customID, auditPageCustomIDPrefix) {
+		h.handleAuditPage(s, i, strings.TrimPrefix(customID, auditPageCustomIDPrefix))
+		return
+	}
+
+	var key string
+	var confirmed bool
+	switch {
+	case strings.HasPrefix(customID, confirmCustomIDPrefix):
+		key, confirmed = strings.TrimPrefix(customID, confirmCustomIDPrefix), true
+	case strings.HasPrefix(customID, cancelCustomIDPrefix):
+		key = strings.TrimPrefix(customID, cancelCustomIDPrefix)
+	default:
+		log.Printf("⚠️ Unknown component interaction: %s", customID)
+		return
+	}
+
+	pending := h.takeConfirmation(key)
+	if pending == nil {
+		h.respondToInteraction(s, i, "⌛ This confirmation has expired.")
+		return
+	}
+
+	if !confirmed {
+		err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Content:    "Operation cancelled.",
+				Embeds:     []*discordgo.MessageEmbed{},
+				Components: []discordgo.MessageComponent{},
+			},
+		})
+		if err != nil {
+			log.Printf("❌ Failed to respond to interaction: %v", err)
+		}
+		return
+	}
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	})
+	if err != nil {
+		log.Printf("❌ Failed to acknowledge confirmation: %v", err)
+	}
+	pending.onConfirm()
+}
+
+// editInteractionContent replaces an interaction response with plain text and removes its buttons
+func (h *MessageHandler) editInteractionContent(s *discordgo.Session, interaction *discordgo.Interaction, content string) {
+	embeds := []*discordgo.MessageEmbed{}
+	components := []discordgo.MessageComponent{}
+	_, err := s.InteractionResponseEdit(interaction, &discordgo.WebhookEdit{
+		Content:    &content,
+		Embeds:     &embeds,
+		Components: &components,
+	})
+	if err != nil {
+		log.Printf("❌ Failed to edit interaction response: %v", err)
+	}
+}
+
+// editInteractionEmbed replaces an interaction response with an embed and removes its buttons
+func (h *MessageHandler) editInteractionEmbed(s *discordgo.Session, interaction *discordgo.Interaction, embed *discordgo.MessageEmbed) {
+	embeds := []*discordgo.MessageEmbed{embed}
+	components := []discordgo.MessageComponent{}
+	_, err := s.InteractionResponseEdit(interaction, &discordgo.WebhookEdit{
+		Embeds:     &embeds,
+		Components: &components,
+	})
+	if err != nil {
+		log.Printf("❌ Failed to edit interaction response: %v", err)
+	}
+}
+
 // SetAdminUsers sets the list of admin user IDs
 func (h *MessageHandler) SetAdminUsers(adminUsers []string) {
 	h.adminUsers = adminUsers
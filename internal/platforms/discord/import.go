@@ -0,0 +1,228 @@
+package discord
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// bridgeImportModalID is the custom ID of the /bridge import modal
+const bridgeImportModalID = "bridge_import"
+
+// maxBridgeImport is the maximum number of bridges accepted in one import
+const maxBridgeImport = 25
+
+// bridgeImportSpec describes one bridge in a /bridge import payload
+type bridgeImportSpec struct {
+	SourceChannel  string `json:"source_channel"`
+	TargetPlatform string `json:"target_platform"`
+	TargetChannel  string `json:"target_channel"`
+	Name           string `json:"name"`
+}
+
+// commandBridgeImport opens a modal asking for a JSON array of bridge specs
+func (h *MessageHandler) commandBridgeImport(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: bridgeImportModalID,
+			Title:    "Import Bridges",
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    "payload",
+							Label:       "Bridges (JSON array)",
+							Style:       discordgo.TextInputParagraph,
+							Placeholder: `[{"source_channel": "123", "target_platform": "telegram", "target_channel": "-100456", "name": "General"}]`,
+							Required:    true,
+							MaxLength:   4000,
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		log.Printf("❌ Failed to open bridge import modal: %v", err)
+	}
+}
+
+// handleModalSubmit dispatches modal submissions
+func (h *MessageHandler) handleModalSubmit(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	data := i.ModalSubmitData()
+	switch data.CustomID {
+	case bridgeImportModalID:
+		h.handleBridgeImport(s, i, modalTextValue(data.Components, "payload"))
+	default:
+		h.respondToInteraction(s, i, "❓ Unknown form")
+	}
+}
+
+// modalTextValue returns the value of a text input in a submitted modal
+func modalTextValue(components []discordgo.MessageComponent, customID string) string {
+	for _, component := range components {
+		row, ok := component.(*discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+		for _, child := range row.Components {
+			if input, ok := child.(*discordgo.TextInput); ok && input.CustomID == customID {
+				return input.Value
+			}
+		}
+	}
+	return ""
+}
+
+// handleBridgeImport creates the bridges of a submitted import payload and reports the results
+func (h *MessageHandler) handleBridgeImport(s *discordgo.Session, i *discordgo.InteractionCreate, payload string) {
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	var specs []bridgeImportSpec
+	if err := json.Unmarshal([]byte(payload), &specs); err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Invalid JSON payload: %v", describeJSONError(payload, err)))
+		return
+	}
+	if len(specs) == 0 {
+		h.respondToInteraction(s, i, "❌ The payload does not contain any bridges")
+		return
+	}
+	if len(specs) > maxBridgeImport {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Too many bridges: %d (maximum %d per import)", len(specs), maxBridgeImport))
+		return
+	}
+
+	actorID := interactionUserID(i)
+
+	var created, existing int
+	var failures []string
+	for index, spec := range specs {
+		status, err := h.importBridge(s, i.GuildID, spec)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("#%d `%s` → %s `%s`: %v", index+1, spec.SourceChannel, spec.TargetPlatform, spec.TargetChannel, err))
+			continue
+		}
+		if !status {
+			existing++
+			continue
+		}
+		created++
+
+		details, _ := json.Marshal(spec)
+		err = h.bridgeCore.RecordAudit(&models.AuditLog{
+			Action:        AuditActionBulkImport,
+			ActorPlatform: types.PlatformDiscord,
+			ActorID:       actorID,
+			Target:        fmt.Sprintf("discord_%s_%s_%s", spec.SourceChannel, spec.TargetPlatform, spec.TargetChannel),
+			Details:       string(details),
+		})
+		if err != nil {
+			log.Printf("⚠️ Failed to write audit log for imported bridge: %v", err)
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "📥 Bridge Import",
+		Description: fmt.Sprintf("%d bridges created, %d already existed, %d failed", created, existing, len(failures)),
+		Color:       0x00ff00,
+	}
+	if len(failures) > 0 {
+		embed.Color = 0xff9900
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "❌ Failures",
+			Value: truncateFieldValue(strings.Join(failures, "\n")),
+		})
+	}
+
+	h.respondToInteractionWithEmbed(s, i, embed)
+	log.Printf("📥 Bridge import: %d created, %d existing, %d failed", created, existing, len(failures))
+}
+
+// importBridge creates a single imported bridge. It returns false if the bridge already existed.
+func (h *MessageHandler) importBridge(s *discordgo.Session, guildID string, spec bridgeImportSpec) (bool, error) {
+	if spec.SourceChannel == "" || spec.TargetPlatform == "" || spec.TargetChannel == "" {
+		return false, fmt.Errorf("source_channel, target_platform and target_channel are required")
+	}
+
+	channel, err := s.State.Channel(spec.SourceChannel)
+	if err != nil {
+		channel, err = s.Channel(spec.SourceChannel)
+		if err != nil {
+			return false, fmt.Errorf("source channel not found")
+		}
+	}
+	if channel.GuildID != guildID {
+		return false, fmt.Errorf("source channel is not in this server")
+	}
+
+	for _, bridge := range h.bridgeCore.GetBridges(spec.SourceChannel) {
+		if bridge.TargetPlatform == spec.TargetPlatform && bridge.TargetChannelID == spec.TargetChannel {
+			return false, nil
+		}
+	}
+
+	name := strings.TrimSpace(spec.Name)
+	if name != "" {
+		if err := h.validateBridgeName(s, guildID, name, ""); err != nil {
+			return false, err
+		}
+	}
+
+	if err := h.bridgeCore.AddBridge(types.PlatformDiscord, spec.SourceChannel, spec.TargetPlatform, spec.TargetChannel); err != nil {
+		return false, err
+	}
+
+	if name != "" {
+		for _, bridge := range h.bridgeCore.GetBridges(spec.SourceChannel) {
+			if bridge.TargetPlatform == spec.TargetPlatform && bridge.TargetChannelID == spec.TargetChannel {
+				if err := h.bridgeCore.SetBridgeName(bridge.ID, name); err != nil {
+					log.Printf("⚠️ Failed to name imported bridge %s: %v", bridge.ID, err)
+				}
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// describeJSONError adds the line and column to JSON syntax errors
+func describeJSONError(payload string, err error) string {
+	var offset int64
+	switch e := err.(type) {
+	case *json.SyntaxError:
+		offset = e.Offset
+	case *json.UnmarshalTypeError:
+		offset = e.Offset
+	default:
+		return err.Error()
+	}
+
+	line, column := 1, 1
+	for _, r := range payload[:min(int(offset), len(payload))] {
+		if r == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+	return fmt.Sprintf("%v (line %d, column %d)", err, line, column)
+}
+
+// truncateFieldValue shortens text to Discord's 1024 character embed field limit
+func truncateFieldValue(value string) string {
+	runes := []rune(value)
+	if len(runes) > 1024 {
+		return string(runes[:1021]) + "..."
+	}
+	return value
+}
@@ -0,0 +1,66 @@
+package discord
+
+import (
+	"fmt"
+	"strings"
+
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// commandBridgeLeaderboard shows the most active users of a bridge
+func (h *MessageHandler) commandBridgeLeaderboard(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	bridge := h.selectedBridge(s, i, options)
+	if bridge == nil {
+		h.respondToInteraction(s, i, "❌ Bridge not found. Pick a bridge or run this in a bridged channel.")
+		return
+	}
+
+	entries, err := h.bridgeCore.GetLeaderboard(bridge.ID)
+	if err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to load leaderboard: %v", err))
+		return
+	}
+
+	title := bridge.Name
+	if title == "" {
+		title = fmt.Sprintf("<#%s> ↔ %s", bridge.SourceChannelID, strings.Title(bridge.TargetPlatform))
+	}
+
+	var lines []string
+	for rank, entry := range entries {
+		lines = append(lines, fmt.Sprintf("**%d.** %s %s — %d messages", rank+1, platformIcon(entry.Platform), entry.Username, entry.MessageCount))
+	}
+	description := strings.Join(lines, "\n")
+	if description == "" {
+		description = "No messages have been bridged yet."
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "🏆 Bridge Leaderboard",
+		Description: fmt.Sprintf("%s\n\n%s", title, description),
+		Color:       0xffd700,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Updated at most every 5 minutes",
+		},
+	}
+
+	h.respondToInteractionWithEmbed(s, i, embed)
+}
+
+// platformIcon returns an emoji representing a platform
+func platformIcon(platform string) string {
+	switch platform {
+	case types.PlatformTelegram:
+		return "✈️"
+	case types.PlatformDiscord:
+		return "🎮"
+	default:
+		return "💬"
+	}
+}
@@ -0,0 +1,55 @@
+package discord
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// handleLinkCommand links the invoking user to the Telegram account that requested the given code
+func (h *MessageHandler) handleLinkCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if h.bridgeCore == nil {
+		h.respondToLinkCommand(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	user := i.User
+	if i.Member != nil {
+		user = i.Member.User
+	}
+	if user == nil {
+		return
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(getOptionMap(i.ApplicationCommandData().Options)["code"].StringValue()))
+	link, err := h.bridgeCore.LinkTelegramAccount(code, user.ID, user.Username)
+	if err != nil {
+		h.respondToLinkCommand(s, i, fmt.Sprintf("❌ Failed to link accounts: %v", err))
+		return
+	}
+	if link == nil {
+		h.respondToLinkCommand(s, i, "❌ Unknown or expired link code. Send /link to the Telegram bot for a new one.")
+		return
+	}
+
+	h.recordAudit(i, AuditActionAccountLink, fmt.Sprintf("telegram_%s", link.PlatformUserID),
+		fmt.Sprintf("Linked Discord user %s to Telegram user %s", user.Username, link.Username))
+
+	h.respondToLinkCommand(s, i, fmt.Sprintf("🔗 Your Discord account is now linked to the Telegram account **%s**", link.Username))
+}
+
+// respondToLinkCommand replies to /link so that only the invoking user sees it
+func (h *MessageHandler) respondToLinkCommand(s *discordgo.Session, i *discordgo.InteractionCreate, content string) {
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		log.Printf("❌ Failed to respond to /link: %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package discord
+
+import (
+	"dcbot/internal/i18n"
+	"github.com/bwmarrin/discordgo"
+)
+
+// localizeCommands fills in the translated names and descriptions of slash commands, options and choices
+func localizeCommands(commands []*discordgo.ApplicationCommand) {
+	for _, command := range commands {
+		if names := localizedNames(command.Name); names != nil {
+			command.NameLocalizations = &names
+		}
+		if descriptions := localizedMessages(command.Description); descriptions != nil {
+			command.DescriptionLocalizations = &descriptions
+		}
+		localizeOptions(command.Options)
+	}
+}
+
+// localizeOptions fills in the translations of options, their subcommands and their choices
+func localizeOptions(options []*discordgo.ApplicationCommandOption) {
+	for _, option := range options {
+		option.NameLocalizations = localizedNames(option.Name)
+		option.DescriptionLocalizations = localizedMessages(option.Description)
+		for _, choice := range option.Choices {
+			choice.NameLocalizations = localizedMessages(choice.Name)
+		}
+		localizeOptions(option.Options)
+	}
+}
+
+// localizedNames returns the translations of a command or option name by locale, or nil if there are none
+func localizedNames(name string) map[discordgo.Locale]string {
+	return localizations(name, i18n.CommandName)
+}
+
+// localizedMessages returns the translations of a description or choice name by locale, or nil if there are none
+func localizedMessages(message string) map[discordgo.Locale]string {
+	return localizations(message, i18n.Message)
+}
+
+// localizations collects the translations of a text in every supported locale
+func localizations(text string, translate func(locale, text string) (string, bool)) map[discordgo.Locale]string {
+	var translations map[discordgo.Locale]string
+	for _, locale := range i18n.SupportedLocales() {
+		translated, ok := translate(locale, text)
+		if !ok {
+			continue
+		}
+		if translations == nil {
+			translations = make(map[discordgo.Locale]string)
+		}
+		translations[discordgo.Locale(locale)] = translated
+	}
+	return translations
+}
+
+// interactionLocalizer returns a localizer for the language of the user who triggered an interaction
+func interactionLocalizer(i *discordgo.InteractionCreate) *i18n.Localizer {
+	return i18n.NewLocalizer(string(i.Locale))
+}
@@ -0,0 +1,102 @@
+package discord
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// migrationRemovedAnnouncement is sent to the old channel once its bridges moved, followed by the new channel
+const migrationRemovedAnnouncement = "🔀 The bridges of this channel have been moved to <#%s>. Messages will no longer be synchronized here."
+
+// migrationCreatedAnnouncement is sent to the new channel once the bridges moved into it, followed by the old channel
+const migrationCreatedAnnouncement = "🔀 The bridges of <#%s> have been moved to this channel. Messages will be synchronized here from now on."
+
+// commandBridgeMigrate moves every bridge of a Discord channel, including its configuration, to another channel after confirmation
+func (h *MessageHandler) commandBridgeMigrate(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+	if i.GuildID == "" {
+		h.respondToInteraction(s, i, "❌ This command can only be used in a server")
+		return
+	}
+
+	optionMap := getOptionMap(options)
+	oldOption, hasOld := optionMap["old_channel_id"]
+	newOption, hasNew := optionMap["new_channel_id"]
+	if !hasOld || !hasNew {
+		h.respondToInteraction(s, i, "❌ Missing required parameters")
+		return
+	}
+
+	oldChannelID := strings.TrimSpace(oldOption.StringValue())
+	newChannelID := strings.TrimSpace(newOption.StringValue())
+	if oldChannelID == newChannelID {
+		h.respondToInteraction(s, i, "❌ The old and new channel must be different")
+		return
+	}
+
+	// Both channels must belong to this server, so bridges cannot be moved into a server the admin does not manage
+	for _, channelID := range []string{oldChannelID, newChannelID} {
+		channel, err := s.Channel(channelID)
+		if err != nil || channel.GuildID != i.GuildID {
+			h.respondToInteraction(s, i, fmt.Sprintf("❌ Channel `%s` was not found in this server", channelID))
+			return
+		}
+	}
+
+	bridges := uniqueBridges(h.bridgeCore.GetBridges(oldChannelID))
+	if len(bridges) == 0 {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ No bridges configured for <#%s>", oldChannelID))
+		return
+	}
+	if len(h.bridgeCore.GetBridges(newChannelID)) > 0 {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ <#%s> already has bridges, remove them first", newChannelID))
+		return
+	}
+
+	var lines []string
+	for _, bridge := range bridges {
+		line := fmt.Sprintf("%s %s `%s`", platformIcon(bridge.TargetPlatform), strings.Title(bridge.TargetPlatform), bridge.TargetChannelID)
+		if bridge.Name != "" {
+			line += fmt.Sprintf(" (%s)", bridge.Name)
+		}
+		lines = append(lines, line)
+	}
+
+	description := fmt.Sprintf("Move these %d bridges from <#%s> to <#%s>?\n%s\nTheir configuration is kept.", len(bridges), oldChannelID, newChannelID, strings.Join(lines, "\n"))
+	err := h.withConfirmation(s, i, description, func() {
+		if err := h.bridgeCore.MigratePlatformChannel(types.PlatformDiscord, oldChannelID, newChannelID); err != nil {
+			h.editInteractionContent(s, i.Interaction, fmt.Sprintf("❌ Failed to migrate bridges: %v", err))
+			return
+		}
+
+		h.announceMigration(oldChannelID, fmt.Sprintf(migrationRemovedAnnouncement, newChannelID))
+		h.announceMigration(newChannelID, fmt.Sprintf(migrationCreatedAnnouncement, oldChannelID))
+
+		h.recordAudit(i, AuditActionBridgeMigrate, oldChannelID, fmt.Sprintf("%d bridges moved to %s", len(bridges), newChannelID))
+		h.editInteractionEmbed(s, i.Interaction, &discordgo.MessageEmbed{
+			Title:       "🔀 Bridges Migrated",
+			Description: fmt.Sprintf("Moved **%d** bridges from <#%s> to <#%s>", len(bridges), oldChannelID, newChannelID),
+			Color:       0x00ff00,
+			Timestamp:   time.Now().Format(time.RFC3339),
+		})
+		log.Printf("🔀 Migrated %d bridges from Discord channel %s to %s", len(bridges), oldChannelID, newChannelID)
+	})
+	if err != nil {
+		log.Printf("❌ Failed to request bridge migration confirmation: %v", err)
+	}
+}
+
+// announceMigration tells a Discord channel that bridges moved out of or into it
+func (h *MessageHandler) announceMigration(channelID, content string) {
+	if err := h.bridgeCore.SendSystemMessage(types.PlatformDiscord, channelID, content); err != nil {
+		log.Printf("⚠️ Failed to announce bridge migration in Discord channel %s: %v", channelID, err)
+	}
+}
@@ -0,0 +1,134 @@
+package discord
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// permissionAuditTTL is how long a guild's /bridge permissions result is reused
+const permissionAuditTTL = 30 * time.Second
+
+// requiredPermission is a channel permission the bot needs to bridge a channel
+type requiredPermission struct {
+	flag  int64
+	name  string
+	label string
+	fix   string
+}
+
+// requiredChannelPermissions are checked by /bridge permissions, in column order
+var requiredChannelPermissions = []requiredPermission{
+	{discordgo.PermissionSendMessages, "SEND_MESSAGES", "Send", "needed to deliver bridged messages"},
+	{discordgo.PermissionManageWebhooks, "MANAGE_WEBHOOKS", "Webhooks", "needed to post with the sender's name and avatar"},
+	{discordgo.PermissionReadMessageHistory, "READ_MESSAGE_HISTORY", "History", "needed to resolve replies and edits"},
+}
+
+// permissionAudit is a cached /bridge permissions result for a guild
+type permissionAudit struct {
+	embed     *discordgo.MessageEmbed
+	checkedAt time.Time
+}
+
+// commandBridgePermissions shows which required permissions the bot has in every bridged channel of the guild
+func (h *MessageHandler) commandBridgePermissions(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	h.permissionAuditsMu.Lock()
+	audit, ok := h.permissionAudits[i.GuildID]
+	h.permissionAuditsMu.Unlock()
+
+	if !ok || time.Since(audit.checkedAt) > permissionAuditTTL {
+		audit = &permissionAudit{
+			embed:     h.auditBridgePermissions(s, i.GuildID),
+			checkedAt: time.Now(),
+		}
+		h.permissionAuditsMu.Lock()
+		h.permissionAudits[i.GuildID] = audit
+		h.permissionAuditsMu.Unlock()
+	}
+
+	h.respondToInteractionWithEmbed(s, i, audit.embed)
+}
+
+// auditBridgePermissions builds the permissions matrix embed for the bridged channels of a guild
+func (h *MessageHandler) auditBridgePermissions(s *discordgo.Session, guildID string) *discordgo.MessageEmbed {
+	channelIDs := make(map[string]bool)
+	for _, bridge := range h.guildBridges(s, guildID) {
+		channelIDs[bridge.SourceChannelID] = true
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:     "🔐 Bridge Permissions",
+		Color:     0x00ff00,
+		Footer:    &discordgo.MessageEmbedFooter{Text: fmt.Sprintf("Results are cached for %s", permissionAuditTTL)},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+
+	if len(channelIDs) == 0 {
+		embed.Description = "No bridged channels in this server"
+		return embed
+	}
+
+	sorted := make([]string, 0, len(channelIDs))
+	for channelID := range channelIDs {
+		sorted = append(sorted, channelID)
+	}
+	sort.Strings(sorted)
+
+	var header []string
+	for _, permission := range requiredChannelPermissions {
+		header = append(header, "`"+permission.label+"`")
+	}
+	rows := []string{strings.Join(header, " ") + " Channel"}
+
+	missing := make(map[string][]string) // permission name -> channel mentions
+	var unreadable []string
+	for _, channelID := range sorted {
+		permissions, err := s.State.UserChannelPermissions(s.State.User.ID, channelID)
+		if err != nil {
+			log.Printf("⚠️ Failed to get permissions for Discord channel %s: %v", channelID, err)
+			unreadable = append(unreadable, "<#"+channelID+">")
+			rows = append(rows, fmt.Sprintf("❔ <#%s> (permissions unavailable)", channelID))
+			continue
+		}
+
+		var cells []string
+		for _, permission := range requiredChannelPermissions {
+			if permissions&permission.flag != 0 {
+				cells = append(cells, "✅")
+				continue
+			}
+			cells = append(cells, "❌")
+			missing[permission.name] = append(missing[permission.name], "<#"+channelID+">")
+		}
+		rows = append(rows, strings.Join(cells, " ")+" <#"+channelID+">")
+	}
+	embed.Description = strings.Join(rows, "\n")
+
+	var fixes []string
+	for _, permission := range requiredChannelPermissions {
+		if channels := missing[permission.name]; len(channels) > 0 {
+			fixes = append(fixes, fmt.Sprintf("• Grant **%s** (%s) in %s", permission.name, permission.fix, strings.Join(channels, ", ")))
+		}
+	}
+	if len(unreadable) > 0 {
+		fixes = append(fixes, fmt.Sprintf("• Make sure the bot can view %s", strings.Join(unreadable, ", ")))
+	}
+
+	if len(fixes) > 0 {
+		embed.Color = 0xffa500
+		embed.Fields = []*discordgo.MessageEmbedField{{
+			Name:  "⚠️ Action Required",
+			Value: truncateFieldValue(strings.Join(fixes, "\n")),
+		}}
+	}
+	return embed
+}
@@ -0,0 +1,106 @@
+package discord
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// maxPinPreviewLength is how much of a pinned message is quoted in the pin notice
+const maxPinPreviewLength = 100
+
+// maxPinAge is how long after a pin its ChannelPinsUpdate event is still treated as a new pin.
+// Unpinning also fires the event, with the pin time of the most recent remaining pin.
+const maxPinAge = time.Minute
+
+// onChannelPinsUpdate bridges a newly pinned message as a pin notice quoting the start of the message
+func (h *MessageHandler) onChannelPinsUpdate(s *discordgo.Session, p *discordgo.ChannelPinsUpdate) {
+	if h.bridgeCore == nil || len(h.bridgeCore.GetBridges(p.ChannelID)) == 0 {
+		return
+	}
+
+	// All pins were removed, or the event is about an unpin
+	pinnedAt, err := time.Parse(time.RFC3339, p.LastPinTimestamp)
+	if err != nil || time.Since(pinnedAt) > maxPinAge {
+		return
+	}
+
+	pinned, err := s.ChannelMessagesPinned(p.ChannelID)
+	if err != nil {
+		log.Printf("❌ Failed to get pinned messages of Discord channel %s: %v", p.ChannelID, err)
+		return
+	}
+	if len(pinned) == 0 {
+		return
+	}
+	message := pinned[0] // Most recently pinned first
+
+	h.lastPinsMu.Lock()
+	duplicate := h.lastPins[p.ChannelID] == message.ID
+	h.lastPins[p.ChannelID] = message.ID
+	h.lastPinsMu.Unlock()
+	if duplicate {
+		return
+	}
+
+	pinnerID, pinner := h.findPinner(s, p.GuildID, p.ChannelID, message.ID)
+	if pinnerID == s.State.User.ID {
+		return // Pins mirrored from Telegram by the bridge itself
+	}
+
+	preview := message.Content
+	if runes := []rune(preview); len(runes) > maxPinPreviewLength {
+		preview = string(runes[:maxPinPreviewLength]) + "…"
+	}
+
+	content := fmt.Sprintf("📌 Message pinned by %s", pinner)
+	if preview != "" {
+		content += fmt.Sprintf(": \"%s\"", preview)
+	}
+
+	bridgeMessage := &types.BridgeMessage{
+		ID:              fmt.Sprintf("discord_%s_pin_%s", p.ChannelID, message.ID),
+		SourcePlatform:  types.PlatformDiscord,
+		SourceChannelID: p.ChannelID,
+		SourceUserID:    pinnerID,
+		Username:        pinner,
+		Content:         content,
+		MessageType:     types.MessageTypePin,
+		Timestamp:       pinnedAt,
+		PinnedMessageID: message.ID,
+	}
+
+	log.Printf("📌 Discord message %s pinned by %s", message.ID, pinner)
+	if err := h.bridgeCore.ProcessMessage(bridgeMessage); err != nil {
+		log.Printf("❌ Failed to bridge Discord pin: %v", err)
+	}
+}
+
+// findPinner looks up who pinned a message in the server's audit log, since pin events do not say.
+// Without the View Audit Log permission the pinner is unknown and "someone" is returned.
+func (h *MessageHandler) findPinner(s *discordgo.Session, guildID, channelID, messageID string) (string, string) {
+	auditLog, err := s.GuildAuditLog(guildID, "", "", int(discordgo.AuditLogActionMessagePin), 10)
+	if err != nil {
+		log.Printf("⚠️ Failed to read audit log of Discord server %s: %v", guildID, err)
+		return "", "someone"
+	}
+
+	for _, entry := range auditLog.AuditLogEntries {
+		if entry.Options == nil || entry.Options.ChannelID != channelID || entry.Options.MessageID != messageID {
+			continue
+		}
+		for _, user := range auditLog.Users {
+			if user.ID == entry.UserID {
+				if user.GlobalName != "" {
+					return user.ID, user.GlobalName
+				}
+				return user.ID, user.Username
+			}
+		}
+		return entry.UserID, "someone"
+	}
+	return "", "someone"
+}
@@ -0,0 +1,182 @@
+package discord
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	maxPollOptions        = 10  // Telegram allows 2-10 poll options
+	maxPollQuestionLength = 300 // Telegram poll question limit
+	maxPollOptionLength   = 100 // Telegram poll option limit
+	pollBarWidth          = 10
+)
+
+// pollCreateOptions builds the options of /bridge poll create
+func pollCreateOptions() []*discordgo.ApplicationCommandOption {
+	options := []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "question",
+			Description: "Poll question",
+			Required:    true,
+			MaxLength:   maxPollQuestionLength,
+		},
+	}
+	for n := 1; n <= maxPollOptions; n++ {
+		options = append(options, &discordgo.ApplicationCommandOption{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        fmt.Sprintf("option%d", n),
+			Description: fmt.Sprintf("Answer option %d", n),
+			Required:    n <= 2,
+			MaxLength:   maxPollOptionLength,
+		})
+	}
+	options = append(options, &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionBoolean,
+		Name:        "anonymous",
+		Description: "Hide who voted for what (default: true)",
+		Required:    false,
+	})
+	return options
+}
+
+// handleBridgePollCommand handles poll subcommands
+func (h *MessageHandler) handleBridgePollCommand(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if len(options) == 0 {
+		h.respondToInteraction(s, i, "❌ No poll subcommand specified")
+		return
+	}
+
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	switch options[0].Name {
+	case "create":
+		h.commandBridgePollCreate(s, i, options[0].Options)
+	case "results":
+		h.commandBridgePollResults(s, i, options[0].Options)
+	default:
+		h.respondToInteraction(s, i, "❓ Unknown poll subcommand")
+	}
+}
+
+// commandBridgePollCreate creates a poll in the Telegram chat bridged to this channel
+func (h *MessageHandler) commandBridgePollCreate(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	optionMap := getOptionMap(options)
+
+	question := strings.TrimSpace(optionMap["question"].StringValue())
+	if question == "" {
+		h.respondToInteraction(s, i, "❌ Poll question cannot be empty")
+		return
+	}
+
+	var answers []string
+	for n := 1; n <= maxPollOptions; n++ {
+		opt, ok := optionMap[fmt.Sprintf("option%d", n)]
+		if !ok {
+			continue
+		}
+		if answer := strings.TrimSpace(opt.StringValue()); answer != "" {
+			answers = append(answers, answer)
+		}
+	}
+	if len(answers) < 2 {
+		h.respondToInteraction(s, i, "❌ A poll needs at least two options")
+		return
+	}
+
+	anonymous := true
+	if opt, ok := optionMap["anonymous"]; ok {
+		anonymous = opt.BoolValue()
+	}
+
+	poll, err := h.bridgeCore.CreatePoll(i.ChannelID, question, answers, anonymous)
+	if err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to create poll: %v", err))
+		return
+	}
+
+	h.respondToInteraction(s, i, fmt.Sprintf("📊 Poll **#%d** created on Telegram. Use `/bridge poll results poll_id:%d` to see the votes.", poll.ID, poll.ID))
+}
+
+// commandBridgePollResults shows the latest vote counts of a poll
+func (h *MessageHandler) commandBridgePollResults(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	optionMap := getOptionMap(options)
+
+	poll, err := h.bridgeCore.GetPoll(int(optionMap["poll_id"].IntValue()))
+	if err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to load poll: %v", err))
+		return
+	}
+	// Polls are only visible from the guild they were created in
+	if poll == nil || !h.channelInGuild(s, poll.DiscordChannelID, i.GuildID) {
+		h.respondToInteraction(s, i, "❌ Poll not found")
+		return
+	}
+
+	var answers []string
+	var votes []int
+	if err := json.Unmarshal([]byte(poll.OptionsJSON), &answers); err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to read poll options: %v", err))
+		return
+	}
+	if err := json.Unmarshal([]byte(poll.ResultsJSON), &votes); err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to read poll results: %v", err))
+		return
+	}
+
+	total := 0
+	for _, count := range votes {
+		total += count
+	}
+
+	var lines []string
+	for n, answer := range answers {
+		count := 0
+		if n < len(votes) {
+			count = votes[n]
+		}
+		percent := 0
+		if total > 0 {
+			percent = count * 100 / total
+		}
+		filled := percent * pollBarWidth / 100
+		bar := strings.Repeat("▰", filled) + strings.Repeat("▱", pollBarWidth-filled)
+		lines = append(lines, fmt.Sprintf("**%s**\n%s %d votes (%d%%)", answer, bar, count, percent))
+	}
+
+	mode := "Anonymous"
+	if !poll.IsAnonymous {
+		mode = "Public"
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📊 %s", poll.Question),
+		Description: strings.Join(lines, "\n\n"),
+		Color:       0x0088cc,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Poll #%d • %s • %d voters on Telegram", poll.ID, mode, poll.TotalVoters),
+		},
+		Timestamp: poll.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	h.respondToInteractionWithEmbed(s, i, embed)
+}
+
+// channelInGuild reports whether a Discord channel belongs to the given guild
+func (h *MessageHandler) channelInGuild(s *discordgo.Session, channelID, guildID string) bool {
+	channel, err := s.State.Channel(channelID)
+	if err != nil {
+		channel, err = s.Channel(channelID)
+		if err != nil {
+			return false
+		}
+	}
+	return channel.GuildID == guildID
+}
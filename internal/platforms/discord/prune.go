@@ -0,0 +1,167 @@
+package discord
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"dcbot/internal/database/models"
+	"github.com/bwmarrin/discordgo"
+)
+
+// historyDays is how many daily snapshots /config db history shows
+const historyDays = 7
+
+// sparklineBlocks are the bar heights of a sparkline, lowest first
+var sparklineBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// DatabasePruner deletes old rows so the database does not grow without bound, and reports its size
+type DatabasePruner interface {
+	RunNow() (int64, error)
+	TableSizes() map[string]int
+	SnapshotHistory(days int) ([]*models.DBSnapshot, error)
+}
+
+// SetDatabasePruner sets the pruner used by /config db prune, stats and history
+func (h *MessageHandler) SetDatabasePruner(pruner DatabasePruner) {
+	h.databasePruner = pruner
+}
+
+// commandConfigDBPrune prunes old messages and message mappings on demand
+func (h *MessageHandler) commandConfigDBPrune(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if h.databasePruner == nil {
+		h.respondToInteraction(s, i, "❌ Database pruning is not available")
+		return
+	}
+
+	// Pruning large tables can take a while, so acknowledge first and edit the response later
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		log.Printf("❌ Failed to defer database prune response: %v", err)
+		return
+	}
+
+	deleted, err := h.databasePruner.RunNow()
+	if err != nil {
+		h.editInteractionContent(s, i.Interaction, fmt.Sprintf("❌ Database pruning failed: %v", err))
+		return
+	}
+
+	h.recordAudit(i, AuditActionDBPrune, "database", fmt.Sprintf("%d rows deleted", deleted))
+	h.editInteractionContent(s, i.Interaction, fmt.Sprintf("🧹 Pruned %d old database rows", deleted))
+}
+
+// commandConfigDBStats shows the row count of each table and the WAL size
+func (h *MessageHandler) commandConfigDBStats(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if h.databasePruner == nil {
+		h.respondToInteraction(s, i, "❌ Database statistics are not available")
+		return
+	}
+
+	sizes := h.databasePruner.TableSizes()
+	walFrames, hasWAL := sizes["wal_frames"]
+	delete(sizes, "wal_frames")
+
+	tables := make([]string, 0, len(sizes))
+	for table := range sizes {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	var rows []string
+	for _, table := range tables {
+		rows = append(rows, fmt.Sprintf("`%s`: %d", table, sizes[table]))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "🗄️ Database Statistics",
+		Description: strings.Join(rows, "\n"),
+		Color:       0x00ff00,
+		Timestamp:   time.Now().Format(time.RFC3339),
+	}
+	if hasWAL {
+		embed.Fields = []*discordgo.MessageEmbedField{{
+			Name:  "WAL Frames",
+			Value: fmt.Sprintf("%d", walFrames),
+		}}
+	}
+
+	h.respondToInteractionWithEmbed(s, i, embed)
+}
+
+// commandConfigDBHistory shows the trend of the stored message count over the last week
+func (h *MessageHandler) commandConfigDBHistory(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if h.databasePruner == nil {
+		h.respondToInteraction(s, i, "❌ Database statistics are not available")
+		return
+	}
+
+	snapshots, err := h.databasePruner.SnapshotHistory(historyDays)
+	if err != nil {
+		log.Printf("❌ Failed to get database snapshots: %v", err)
+		h.respondToInteraction(s, i, "❌ Failed to get the database history")
+		return
+	}
+	if len(snapshots) == 0 {
+		h.respondToInteraction(s, i, "📈 No database snapshots yet, one is taken daily at midnight UTC")
+		return
+	}
+
+	counts := make([]int, len(snapshots))
+	for n, snapshot := range snapshots {
+		counts[n] = snapshot.MessagesCount
+	}
+	first, latest := snapshots[0], snapshots[len(snapshots)-1]
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "📈 Database History",
+		Description: fmt.Sprintf("Stored messages over the last %d days\n```\n%s\n```", historyDays, sparkline(counts)),
+		Color:       0x0099ff,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "💬 Messages",
+				Value:  fmt.Sprintf("%d → %d", first.MessagesCount, latest.MessagesCount),
+				Inline: true,
+			},
+			{
+				Name:   "🔗 Mappings",
+				Value:  fmt.Sprintf("%d → %d", first.MappingsCount, latest.MappingsCount),
+				Inline: true,
+			},
+			{
+				Name:   "🗄️ Size",
+				Value:  fmt.Sprintf("%s (WAL %s)", formatFileSize(latest.DBSizeBytes), formatFileSize(latest.WALSizeBytes)),
+				Inline: true,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("%s to %s, snapshots are taken daily at midnight UTC",
+				first.SnapshotAt.UTC().Format("2006-01-02"), latest.SnapshotAt.UTC().Format("2006-01-02")),
+		},
+	}
+
+	h.respondToInteractionWithEmbed(s, i, embed)
+}
+
+// sparkline draws values as block characters scaled between their minimum and maximum
+func sparkline(values []int) string {
+	low, high := slices.Min(values), slices.Max(values)
+
+	var b strings.Builder
+	for _, value := range values {
+		level := len(sparklineBlocks) / 2
+		if high > low {
+			level = (value - low) * (len(sparklineBlocks) - 1) / (high - low)
+		}
+		b.WriteRune(sparklineBlocks[level])
+	}
+	return b.String()
+}
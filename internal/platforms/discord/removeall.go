@@ -0,0 +1,172 @@
+package discord
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// removeAllCustomIDPrefix identifies the confirm button of /bridge removeall, followed by "guildID_adminUserID"
+const removeAllCustomIDPrefix = "confirm_removeall_"
+
+// maxRemoveAllListed is the number of bridges listed in the /bridge removeall confirmation
+const maxRemoveAllListed = 30
+
+// teardownAnnouncement is sent to both sides of every bridge removed by /bridge removeall
+const teardownAnnouncement = "🔌 This bridge has been removed by an administrator. Messages will no longer be synchronized."
+
+// commandBridgeRemoveAll lists every bridge of the guild and asks the invoking admin to confirm removing them
+func (h *MessageHandler) commandBridgeRemoveAll(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+	if i.GuildID == "" {
+		h.respondToInteraction(s, i, "❌ This command can only be used in a server")
+		return
+	}
+
+	bridges := uniqueBridges(h.guildBridges(s, i.GuildID))
+	if len(bridges) == 0 {
+		h.respondToInteraction(s, i, "❌ No bridges configured in this server")
+		return
+	}
+
+	var lines []string
+	for index, bridge := range bridges {
+		if index == maxRemoveAllListed {
+			lines = append(lines, fmt.Sprintf("... and %d more", len(bridges)-maxRemoveAllListed))
+			break
+		}
+		line := fmt.Sprintf("%s <#%s> → %s `%s`", platformIcon(bridge.TargetPlatform), bridge.SourceChannelID, strings.Title(bridge.TargetPlatform), bridge.TargetChannelID)
+		if bridge.Name != "" {
+			line += fmt.Sprintf(" (%s)", bridge.Name)
+		}
+		lines = append(lines, line)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "⚠️ Remove All Bridges",
+		Description: fmt.Sprintf("The following %d bridges of this server will be removed:\n%s", len(bridges), strings.Join(lines, "\n")),
+		Color:       0xff0000,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Only the admin who ran this command can confirm",
+		},
+	}
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds: []*discordgo.MessageEmbed{embed},
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.Button{
+							Label:    "⚠️ Confirm Delete All",
+							Style:    discordgo.DangerButton,
+							CustomID: fmt.Sprintf("%s%s_%s", removeAllCustomIDPrefix, i.GuildID, interactionUserID(i)),
+						},
+					},
+				},
+			},
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		log.Printf("❌ Failed to send bulk removal confirmation: %v", err)
+	}
+}
+
+// handleRemoveAllConfirm removes every bridge of the guild once the invoking admin clicks the confirm button
+func (h *MessageHandler) handleRemoveAllConfirm(s *discordgo.Session, i *discordgo.InteractionCreate, state string) {
+	parts := strings.SplitN(state, "_", 2)
+	if len(parts) != 2 || h.bridgeCore == nil {
+		return
+	}
+	guildID, adminUserID := parts[0], parts[1]
+	if interactionUserID(i) != adminUserID {
+		h.respondToInteraction(s, i, "❌ Only the admin who ran this command can confirm it.")
+		return
+	}
+
+	// Removing and announcing many bridges can take a while, so acknowledge first and edit the response later
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	})
+	if err != nil {
+		log.Printf("❌ Failed to acknowledge bulk removal: %v", err)
+	}
+
+	var removedIDs []string
+	var failures []string
+	channels := make(map[string]bool)
+	announced := make(map[string]bool)
+	for _, bridge := range uniqueBridges(h.guildBridges(s, guildID)) {
+		if err := h.bridgeCore.RemoveBridgeByID(bridge.ID); err != nil {
+			failures = append(failures, fmt.Sprintf("`%s`: %v", bridge.ID, err))
+			continue
+		}
+		removedIDs = append(removedIDs, bridge.ID)
+		channels[bridge.SourceChannelID] = true
+
+		h.announceTeardown(announced, bridge.SourcePlatform, bridge.SourceChannelID)
+		h.announceTeardown(announced, bridge.TargetPlatform, bridge.TargetChannelID)
+	}
+
+	details, _ := json.Marshal(map[string]interface{}{
+		"connection_ids": removedIDs,
+		"channels":       len(channels),
+	})
+	h.recordAudit(i, AuditActionBridgeRemoveAll, fmt.Sprintf("guild_%s", guildID), string(details))
+
+	summary := fmt.Sprintf("Removed %d bridges across %d channels.", len(removedIDs), len(channels))
+	embed := &discordgo.MessageEmbed{
+		Title:       "🗑️ Bridges Removed",
+		Description: summary,
+		Color:       0xff9900,
+	}
+	if len(failures) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "Failed",
+			Value: truncateFieldValue(strings.Join(failures, "\n")),
+		})
+	}
+	h.editInteractionEmbed(s, i.Interaction, embed)
+	log.Printf("🗑️ Bulk bridge removal in guild %s: %s", guildID, summary)
+}
+
+// announceTeardown tells a channel that its bridge was removed, once per channel
+func (h *MessageHandler) announceTeardown(announced map[string]bool, platform, channelID string) {
+	key := platform + ":" + channelID
+	if announced[key] {
+		return
+	}
+	announced[key] = true
+
+	if err := h.bridgeCore.SendSystemMessage(platform, channelID, teardownAnnouncement); err != nil {
+		log.Printf("⚠️ Failed to announce bridge removal in %s channel %s: %v", platform, channelID, err)
+	}
+}
+
+// uniqueBridges drops reverse connections of bridges already in the list, since removing a bridge removes both directions
+func uniqueBridges(bridges []*types.BridgeConnection) []*types.BridgeConnection {
+	seen := make(map[string]bool)
+	var unique []*types.BridgeConnection
+	for _, bridge := range bridges {
+		if seen[bridgePairKey(bridge.TargetPlatform, bridge.TargetChannelID, bridge.SourcePlatform, bridge.SourceChannelID)] {
+			continue
+		}
+		seen[bridgePairKey(bridge.SourcePlatform, bridge.SourceChannelID, bridge.TargetPlatform, bridge.TargetChannelID)] = true
+		unique = append(unique, bridge)
+	}
+	return unique
+}
+
+// bridgePairKey identifies one direction of a bridge
+func bridgePairKey(sourcePlatform, sourceChannelID, targetPlatform, targetChannelID string) string {
+	return fmt.Sprintf("%s:%s>%s:%s", sourcePlatform, sourceChannelID, targetPlatform, targetChannelID)
+}
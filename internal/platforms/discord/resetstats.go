@@ -0,0 +1,55 @@
+package discord
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// commandBridgeResetStats clears the leaderboard counts and older delivery events of a bridge after confirmation
+func (h *MessageHandler) commandBridgeResetStats(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	// Resetting statistics cannot be undone, so bot admins alone are not enough
+	if i.Member == nil || i.Member.Permissions&discordgo.PermissionAdministrator == 0 {
+		h.respondToInteraction(s, i, "❌ Only server administrators can reset bridge statistics")
+		return
+	}
+
+	bridge := h.selectedBridge(s, i, options)
+	if bridge == nil {
+		h.respondToInteraction(s, i, "❌ Bridge not found. Pick a bridge or run this in a bridged channel.")
+		return
+	}
+
+	name := bridge.ID
+	if bridge.Name != "" {
+		name = fmt.Sprintf("%s (%s)", bridge.Name, bridge.ID)
+	}
+
+	description := fmt.Sprintf("Reset the statistics of bridge **%s**?\nAll message counts will be set to zero and delivery events older than one hour will be deleted.", name)
+	err := h.withConfirmation(s, i, description, func() {
+		affected, err := h.bridgeCore.ResetBridgeStats(bridge.ID)
+		if err != nil {
+			h.editInteractionContent(s, i.Interaction, fmt.Sprintf("❌ Failed to reset statistics: %v", err))
+			return
+		}
+
+		h.recordAudit(i, AuditActionStatsReset, bridge.ID, fmt.Sprintf("%d rows affected", affected))
+		h.editInteractionEmbed(s, i.Interaction, &discordgo.MessageEmbed{
+			Title:       "🧹 Statistics Reset",
+			Description: fmt.Sprintf("Cleared **%d** stat rows of bridge **%s** → %s", affected, name, strings.Title(bridge.TargetPlatform)),
+			Color:       0x00ff00,
+			Timestamp:   time.Now().Format(time.RFC3339),
+		})
+	})
+	if err != nil {
+		log.Printf("❌ Failed to request stats reset confirmation: %v", err)
+	}
+}
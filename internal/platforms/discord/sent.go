@@ -0,0 +1,44 @@
+package discord
+
+import (
+	"log"
+
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// SentMessageStore remembers the messages sent by the bot so they are never bridged back
+type SentMessageStore interface {
+	RecordBotSentMessage(platform, messageID string) error
+	IsBotSentMessage(platform, messageID string) (bool, error)
+}
+
+// SetSentMessageStore sets where messages sent by the bot are recorded
+func (c *Client) SetSentMessageStore(store SentMessageStore) {
+	c.sentStore = store
+}
+
+// recordSent remembers a message the bot has sent, directly or through a webhook
+func (c *Client) recordSent(message *discordgo.Message) {
+	if c.sentStore == nil || message == nil || message.ID == "" {
+		return
+	}
+
+	if err := c.sentStore.RecordBotSentMessage(types.PlatformDiscord, message.ID); err != nil {
+		log.Printf("⚠️ Failed to record sent Discord message %s: %v", message.ID, err)
+	}
+}
+
+// isBotSent reports whether a message was sent by the bot itself
+func (c *Client) isBotSent(messageID string) bool {
+	if c.sentStore == nil {
+		return false
+	}
+
+	sent, err := c.sentStore.IsBotSentMessage(types.PlatformDiscord, messageID)
+	if err != nil {
+		log.Printf("⚠️ Failed to check Discord message %s: %v", messageID, err)
+		return false
+	}
+	return sent
+}
@@ -0,0 +1,90 @@
+package discord
+
+import (
+	"fmt"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// minHour is the lower bound of hour options; MinValue needs an addressable value
+var minHour = 0.0
+
+// minPriority and maxPriority bound the bridge priority option
+var (
+	minPriority = float64(types.MinBridgePriority)
+	maxPriority = float64(types.MaxBridgePriority)
+)
+
+// handleConfigSilentCommand handles silent mode subcommands
+func (h *MessageHandler) handleConfigSilentCommand(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if len(options) == 0 {
+		h.respondToInteraction(s, i, "❌ No silent subcommand specified")
+		return
+	}
+
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	previous, err := h.bridgeCore.GetBridgeConfig(types.PlatformDiscord, i.ChannelID)
+	if err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to update silent mode: %v", err))
+		return
+	}
+
+	config := *previous
+	switch options[0].Name {
+	case "enable":
+		config.SilentMode = true
+	case "disable":
+		config.SilentMode = false
+		config.SilentHoursStart = 0
+		config.SilentHoursEnd = 0
+	case "hours":
+		optionMap := getOptionMap(options[0].Options)
+		config.SilentMode = false
+		config.SilentHoursStart = int(optionMap["start"].IntValue())
+		config.SilentHoursEnd = int(optionMap["end"].IntValue())
+	default:
+		h.respondToInteraction(s, i, "❓ Unknown silent subcommand")
+		return
+	}
+
+	if err := h.bridgeCore.UpdateBridgeConfig(types.PlatformDiscord, i.ChannelID, &config); err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to update silent mode: %v", err))
+		return
+	}
+
+	h.recordAudit(i, AuditActionSilentMode, fmt.Sprintf("discord_%s", i.ChannelID), silentModeDescription(&config))
+
+	h.pushUndo(interactionUserID(i), &UndoAction{
+		ActionType: UndoActionUpdateConfig,
+		Payload:    &configSnapshot{Platform: types.PlatformDiscord, ChannelID: i.ChannelID, Config: previous},
+	})
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "🔕 Silent Mode Updated",
+		Description: silentModeDescription(&config),
+		Color:       0x00ff00,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Applies to messages bridged into Telegram",
+		},
+	}
+
+	h.respondToInteractionWithEmbed(s, i, embed)
+}
+
+// silentModeDescription describes when a bridge sends messages without notification
+func silentModeDescription(config *models.BridgeConfig) string {
+	switch {
+	case config.SilentMode:
+		return "Enabled - Telegram messages are always sent silently"
+	case config.SilentHoursStart != config.SilentHoursEnd:
+		return fmt.Sprintf("Silent hours %02d:00-%02d:00", config.SilentHoursStart, config.SilentHoursEnd)
+	default:
+		return "Disabled"
+	}
+}
@@ -0,0 +1,78 @@
+package discord
+
+import (
+	"fmt"
+	"time"
+
+	"dcbot/internal/types"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// maxSimulationPreview is the longest formatted message shown in a simulation embed field
+const maxSimulationPreview = 1000
+
+// commandBridgeSimulate shows what would happen to a message sent in this channel, without sending it
+func (h *MessageHandler) commandBridgeSimulate(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	optionMap := getOptionMap(options)
+	contentOption, ok := optionMap["content"]
+	if !ok {
+		h.respondToInteraction(s, i, "❌ Missing content parameter")
+		return
+	}
+
+	username := "Unknown"
+	if i.Member != nil && i.Member.User != nil {
+		username = i.Member.User.Username
+	} else if i.User != nil {
+		username = i.User.Username
+	}
+
+	message := &types.BridgeMessage{
+		ID:              fmt.Sprintf("simulate_%s", i.ID),
+		SourcePlatform:  types.PlatformDiscord,
+		SourceChannelID: i.ChannelID,
+		SourceUserID:    interactionUserID(i),
+		Username:        username,
+		Content:         contentOption.StringValue(),
+		MessageType:     types.MessageTypeText,
+		Timestamp:       time.Now(),
+	}
+
+	result := h.bridgeCore.SimulateMessage(message)
+
+	embed := &discordgo.MessageEmbed{
+		Title:  "🧪 Bridge Simulation",
+		Color:  h.bridgeEmbedColor(i.ChannelID, 0x00ff00),
+		Fields: []*discordgo.MessageEmbedField{},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Dry run, nothing was sent",
+		},
+	}
+
+	if result.WouldBeFiltered {
+		embed.Color = 0xff0000
+		embed.Description = fmt.Sprintf("🚫 This message would be dropped (`%s`)", result.FilterReason)
+	} else {
+		embed.Description = fmt.Sprintf("✅ This message would be bridged to %d target(s)", len(result.TargetConnections))
+	}
+
+	for _, connection := range result.TargetConnections {
+		preview := result.FormattedMessages[connection.TargetPlatform]
+		if runes := []rune(preview); len(runes) > maxSimulationPreview {
+			preview = string(runes[:maxSimulationPreview]) + "…"
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("%s %s → %s", platformIcon(connection.TargetPlatform), connection.TargetPlatform, connection.TargetChannelID),
+			Value:  fmt.Sprintf("```\n%s\n```", preview),
+			Inline: false,
+		})
+	}
+
+	h.respondToInteractionWithEmbed(s, i, embed)
+}
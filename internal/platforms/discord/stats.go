@@ -0,0 +1,71 @@
+package discord
+
+import (
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// filterTypeLabels names the reasons a message can be dropped, in display order
+var filterTypeLabels = []struct {
+	key   string
+	label string
+}{
+	{"word_filter", "Content filter"},
+	{"rate_limit", "Rate limit"},
+	{"max_length", "Too long"},
+	{"media_not_allowed", "Type not allowed"},
+	{"bridge_inactive", "Bridge paused"},
+	{"no_connection", "No connection"},
+	{"sender_not_allowed", "Sender not whitelisted"},
+	{"duplicate", "Duplicate"},
+}
+
+// commandBridgeStats shows bridge counts and how many messages were dropped since startup
+func (h *MessageHandler) commandBridgeStats(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	stats := h.bridgeCore.GetBridgeStats()
+	channelStats := h.bridgeCore.GetChannelStats(i.ChannelID)
+
+	filtered := ""
+	for _, filterType := range filterTypeLabels {
+		filtered += fmt.Sprintf("• **%s**: %d\n", filterType.label, stats["filtered_"+filterType.key])
+	}
+	filtered += fmt.Sprintf("**Total**: %d", stats["filtered_total"])
+
+	embed := &discordgo.MessageEmbed{
+		Title: "📊 Bridge Statistics",
+		Color: 0x0099ff,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "🌉 Bridges",
+				Value:  fmt.Sprintf("%d active / %d total", stats["active_bridges"], stats["total_bridges"]),
+				Inline: true,
+			},
+			{
+				Name:   "🔌 Platforms",
+				Value:  fmt.Sprintf("%d registered", stats["registered_platforms"]),
+				Inline: true,
+			},
+			{
+				Name:   "📍 This Channel",
+				Value:  fmt.Sprintf("%d sent / %d received", channelStats["sent"], channelStats["received"]),
+				Inline: true,
+			},
+			{
+				Name:   "🚫 Dropped Messages",
+				Value:  filtered,
+				Inline: false,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Counts since the last restart",
+		},
+	}
+
+	h.respondToInteractionWithEmbed(s, i, embed)
+}
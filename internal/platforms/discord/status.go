@@ -0,0 +1,75 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// statusUpdateInterval is how often the bot status is refreshed with the bridge health
+const statusUpdateInterval = 5 * time.Minute
+
+// StartStatusUpdates shows the bridge health as the bot's activity, refreshing it until ctx is done.
+// onReady sets the status as well, since the first update is skipped while the gateway is still connecting
+func (h *MessageHandler) StartStatusUpdates(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(statusUpdateInterval)
+		defer ticker.Stop()
+
+		h.updateStatus()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				h.updateStatus()
+			}
+		}
+	}()
+}
+
+// updateStatus sets the bot's activity to the number of bridges and messages per minute, or a warning while something is wrong
+func (h *MessageHandler) updateStatus() {
+	if !h.client.isConnected {
+		return
+	}
+	h.setStatus(h.client.session)
+}
+
+// setStatus sends the bridge health activity over the gateway of s
+func (h *MessageHandler) setStatus(s *discordgo.Session) {
+	if h.bridgeCore == nil {
+		return
+	}
+
+	activity := h.statusActivity()
+	err := s.UpdateStatusComplex(discordgo.UpdateStatusData{
+		Activities: []*discordgo.Activity{activity},
+		Status:     string(discordgo.StatusOnline),
+	})
+	if err != nil {
+		log.Printf("⚠️ Failed to update Discord bot status: %v", err)
+	}
+}
+
+// statusActivity describes the bridge health, failing bridges taking precedence over disconnected platforms
+func (h *MessageHandler) statusActivity() *discordgo.Activity {
+	if h.bridgeCore.HasFailingBridges() {
+		return &discordgo.Activity{Name: "❌ bridge errors", Type: discordgo.ActivityTypeGame}
+	}
+
+	for _, connected := range h.bridgeCore.GetPlatformStatus() {
+		if !connected {
+			return &discordgo.Activity{Name: "⚠ degraded mode", Type: discordgo.ActivityTypeListening}
+		}
+	}
+
+	bridges := h.bridgeCore.GetBridgeStats()["total_bridges"]
+	return &discordgo.Activity{
+		Name: fmt.Sprintf("%d bridges | %d msgs/min", bridges, h.bridgeCore.MessagesPerMinute()),
+		Type: discordgo.ActivityTypeWatching,
+	}
+}
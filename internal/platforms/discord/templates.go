@@ -0,0 +1,77 @@
+package discord
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"dcbot/internal/config"
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// SetTemplates sets the bridge templates used by /bridge create and /config templates list
+func (h *MessageHandler) SetTemplates(templates *config.TemplateLibrary) {
+	h.templates = templates
+}
+
+// bridgeTemplate returns a template by name, or an error listing the available ones
+func (h *MessageHandler) bridgeTemplate(name string) (*config.BridgeTemplate, error) {
+	if h.templates != nil {
+		if template, ok := h.templates.Get(name); ok {
+			return template, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown template %q, see /config templates list", name)
+}
+
+// applyBridgeTemplate overrides the config of a channel's bridge with a template's values
+func (h *MessageHandler) applyBridgeTemplate(channelID string, template *config.BridgeTemplate) error {
+	bridgeConfig, err := h.bridgeCore.GetBridgeConfig(types.PlatformDiscord, channelID)
+	if err != nil {
+		return err
+	}
+	if err := template.Apply(bridgeConfig); err != nil {
+		return err
+	}
+	return h.bridgeCore.UpdateBridgeConfig(types.PlatformDiscord, channelID, bridgeConfig)
+}
+
+// commandConfigTemplatesList lists the bridge templates with their descriptions and settings
+func (h *MessageHandler) commandConfigTemplatesList(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if h.templates == nil || len(h.templates.List()) == 0 {
+		h.respondToInteraction(s, i, "📋 No bridge templates are defined")
+		return
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: "📋 Bridge Templates",
+		Color: 0x0099ff,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Apply one with /bridge create template:<name>",
+		},
+	}
+
+	for _, template := range h.templates.List() {
+		settings := make([]string, 0, len(template.Config))
+		for key, value := range template.Config {
+			settings = append(settings, fmt.Sprintf("`%s: %v`", key, value))
+		}
+		sort.Strings(settings)
+
+		value := template.Description
+		if value == "" {
+			value = "No description"
+		}
+		if len(settings) > 0 {
+			value += "\n" + strings.Join(settings, " ")
+		}
+
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  template.Name,
+			Value: value,
+		})
+	}
+
+	h.respondToInteractionWithEmbed(s, i, embed)
+}
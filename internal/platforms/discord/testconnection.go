@@ -0,0 +1,74 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// credentialTestTimeout bounds how long /bridge testconnection waits for every platform
+const credentialTestTimeout = 10 * time.Second
+
+// commandBridgeTestConnection verifies that the API credentials of every platform are still valid
+func (h *MessageHandler) commandBridgeTestConnection(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	// The API calls can take a while, so acknowledge first and edit the response later
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		log.Printf("❌ Failed to defer test connection response: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), credentialTestTimeout)
+	defer cancel()
+
+	color := 0x00ff00
+	var lines []string
+	for _, check := range h.bridgeCore.TestCredentials(ctx) {
+		if check.Err != nil {
+			color = 0xff0000
+			lines = append(lines, fmt.Sprintf("❌ %s: %v", platformTitle(check.Platform), check.Err))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("✅ %s: authenticated as %s (%dms)", platformTitle(check.Platform), check.Info, check.Latency.Milliseconds()))
+	}
+	if len(lines) == 0 {
+		lines = append(lines, "No platforms are registered")
+	}
+
+	h.editInteractionEmbed(s, i.Interaction, &discordgo.MessageEmbed{
+		Title:       "🔑 Platform Credentials",
+		Description: strings.Join(lines, "\n"),
+		Color:       color,
+		Footer:      &discordgo.MessageEmbedFooter{Text: "Checked with an authenticated API call to each platform"},
+		Timestamp:   time.Now().Format(time.RFC3339),
+	})
+}
+
+// platformTitle returns the name of a platform as shown to users
+func platformTitle(platform string) string {
+	switch platform {
+	case types.PlatformDiscord:
+		return "Discord"
+	case types.PlatformTelegram:
+		return "Telegram"
+	case types.PlatformDiscordWebhook:
+		return "Discord webhook"
+	default:
+		return platform
+	}
+}
@@ -0,0 +1,144 @@
+package discord
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"dcbot/internal/database/models"
+	"github.com/bwmarrin/discordgo"
+)
+
+// maxUndoDepth is the number of actions remembered per admin
+const maxUndoDepth = 5
+
+// Undo action types
+const (
+	UndoActionRemoveBridge = "remove_bridge"
+	UndoActionUpdateConfig = "update_config"
+)
+
+// UndoAction is a reversible admin action
+type UndoAction struct {
+	ActionType string
+	Payload    interface{} // *removedBridge or *configSnapshot
+}
+
+// removedBridge holds what is needed to recreate a removed bridge
+type removedBridge struct {
+	SourcePlatform  string
+	SourceChannelID string
+	TargetPlatform  string
+	TargetChannelID string
+	Name            string
+}
+
+// configSnapshot holds a bridge configuration as it was before a change
+type configSnapshot struct {
+	Platform  string
+	ChannelID string
+	Config    *models.BridgeConfig
+}
+
+// pushUndo records a reversible action for an admin, dropping the oldest beyond maxUndoDepth
+func (h *MessageHandler) pushUndo(userID string, action *UndoAction) {
+	if userID == "" {
+		return
+	}
+
+	h.undoMu.Lock()
+	defer h.undoMu.Unlock()
+
+	stack := append(h.undoStacks[userID], action)
+	if len(stack) > maxUndoDepth {
+		stack = stack[len(stack)-maxUndoDepth:]
+	}
+	h.undoStacks[userID] = stack
+}
+
+// popUndo removes and returns an admin's most recent action, or nil if there is none
+func (h *MessageHandler) popUndo(userID string) *UndoAction {
+	h.undoMu.Lock()
+	defer h.undoMu.Unlock()
+
+	stack := h.undoStacks[userID]
+	if len(stack) == 0 {
+		return nil
+	}
+
+	action := stack[len(stack)-1]
+	h.undoStacks[userID] = stack[:len(stack)-1]
+	return action
+}
+
+// commandBridgeUndo reverses the invoking admin's most recent destructive action
+func (h *MessageHandler) commandBridgeUndo(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	userID := interactionUserID(i)
+	action := h.popUndo(userID)
+	if action == nil {
+		h.respondToInteraction(s, i, "ℹ️ Nothing to undo")
+		return
+	}
+
+	description, err := h.undo(action)
+	if err != nil {
+		// Keep the action so the admin can retry
+		h.pushUndo(userID, action)
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to undo: %v", err))
+		return
+	}
+
+	h.recordAudit(i, AuditActionUndo, "", description)
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "↩️ Action Undone",
+		Description: description,
+		Color:       0x00ff00,
+	}
+	h.respondToInteractionWithEmbed(s, i, embed)
+	log.Printf("↩️ Undo by %s: %s", userID, action.ActionType)
+}
+
+// undo reverses an action and describes what was undone
+func (h *MessageHandler) undo(action *UndoAction) (string, error) {
+	switch payload := action.Payload.(type) {
+	case *removedBridge:
+		err := h.bridgeCore.AddBridge(payload.SourcePlatform, payload.SourceChannelID, payload.TargetPlatform, payload.TargetChannelID)
+		if err != nil {
+			return "", err
+		}
+		if payload.Name != "" {
+			for _, bridge := range h.bridgeCore.GetBridges(payload.SourceChannelID) {
+				if bridge.TargetPlatform == payload.TargetPlatform && bridge.TargetChannelID == payload.TargetChannelID {
+					if err := h.bridgeCore.SetBridgeName(bridge.ID, payload.Name); err != nil {
+						log.Printf("⚠️ Failed to restore name of bridge %s: %v", bridge.ID, err)
+					}
+				}
+			}
+		}
+		return fmt.Sprintf("Restored the **%s** bridge of <#%s> to `%s`", strings.Title(payload.TargetPlatform), payload.SourceChannelID, payload.TargetChannelID), nil
+	case *configSnapshot:
+		if err := h.bridgeCore.UpdateBridgeConfig(payload.Platform, payload.ChannelID, payload.Config); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Restored the previous bridge configuration of <#%s>", payload.ChannelID), nil
+	default:
+		return "", fmt.Errorf("unknown action type: %s", action.ActionType)
+	}
+}
+
+// interactionUserID returns the ID of the user who triggered an interaction
+func interactionUserID(i *discordgo.InteractionCreate) string {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User.ID
+	}
+	if i.User != nil {
+		return i.User.ID
+	}
+	return ""
+}
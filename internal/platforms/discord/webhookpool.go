@@ -0,0 +1,150 @@
+package discord
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/metrics"
+)
+
+// defaultMaxWebhooksPerChannel is the webhook pool size used until SetMaxWebhooksPerChannel is called
+const defaultMaxWebhooksPerChannel = 3
+
+// webhookBusyInterval is the pace of Discord's per-webhook rate limit of 30 requests per 10 seconds.
+// A channel whose least recently used webhook was used more recently than this gets another webhook.
+const webhookBusyInterval = 10 * time.Second / 30
+
+// pooledWebhook is one of the webhooks a channel's bridged messages are spread over
+type pooledWebhook struct {
+	url              string
+	lastUsed         time.Time
+	rateLimitedUntil time.Time
+}
+
+// available reports whether the webhook is not rate limited at now
+func (w *pooledWebhook) available(now time.Time) bool {
+	return !now.Before(w.rateLimitedUntil)
+}
+
+// webhookRateLimitError is returned when Discord rejects a webhook request with 429 Too Many Requests
+type webhookRateLimitError struct {
+	retryAfter time.Duration
+}
+
+func (e *webhookRateLimitError) Error() string {
+	return fmt.Sprintf("webhook rate limited, retry after %s", e.retryAfter)
+}
+
+// SetMaxWebhooksPerChannel sets how many webhooks the messages of a busy channel are spread over
+func (c *Client) SetMaxWebhooksPerChannel(max int) {
+	if max < 1 {
+		max = 1
+	}
+	c.webhooksMu.Lock()
+	c.maxWebhooks = max
+	c.webhooksMu.Unlock()
+}
+
+// webhookPool returns a channel's webhooks, loading the ones stored before the last restart. Callers hold webhooksMu.
+func (c *Client) webhookPool(channelID string) []*pooledWebhook {
+	if pool, loaded := c.webhooks[channelID]; loaded {
+		return pool
+	}
+
+	pool := []*pooledWebhook{}
+	if c.webhookStore != nil {
+		stored, err := c.webhookStore.GetChannelWebhooks(channelID)
+		if err != nil {
+			log.Printf("⚠️ Failed to load stored webhooks for channel %s: %v", channelID, err)
+		}
+		for _, webhook := range stored {
+			pool = append(pool, &pooledWebhook{url: buildWebhookURL(webhook.WebhookID, webhook.Token)})
+		}
+	}
+
+	c.webhooks[channelID] = pool
+	metrics.SetActiveWebhooks(channelID, len(pool))
+	return pool
+}
+
+// addWebhook creates a webhook in a channel and adds it to the channel's pool. Callers hold webhooksMu.
+func (c *Client) addWebhook(channelID string) (*pooledWebhook, error) {
+	webhook, err := c.session.WebhookCreate(channelID, webhookName, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %v", err)
+	}
+
+	if c.webhookStore != nil {
+		err := c.webhookStore.SaveWebhook(&models.Webhook{ChannelID: channelID, WebhookID: webhook.ID, Token: webhook.Token})
+		if err != nil {
+			log.Printf("⚠️ Failed to store webhook for channel %s: %v", channelID, err)
+		}
+	}
+
+	pooled := &pooledWebhook{url: buildWebhookURL(webhook.ID, webhook.Token)}
+	c.webhooks[channelID] = append(c.webhooks[channelID], pooled)
+	metrics.SetActiveWebhooks(channelID, len(c.webhooks[channelID]))
+
+	log.Printf("✅ Created Discord webhook %d/%d for channel %s", len(c.webhooks[channelID]), c.maxWebhooks, channelID)
+	return pooled, nil
+}
+
+// leastRecentlyUsed returns the available webhook of a pool used longest ago, nil if all are rate limited
+func leastRecentlyUsed(pool []*pooledWebhook, now time.Time) *pooledWebhook {
+	var selected *pooledWebhook
+	for _, webhook := range pool {
+		if webhook.available(now) && (selected == nil || webhook.lastUsed.Before(selected.lastUsed)) {
+			selected = webhook
+		}
+	}
+	return selected
+}
+
+// acquireWebhook picks the webhook for the next message to a channel and marks it used.
+// The pool grows while messages arrive faster than one webhook's rate limit allows.
+func (c *Client) acquireWebhook(channelID string) (*pooledWebhook, error) {
+	c.webhooksMu.Lock()
+	defer c.webhooksMu.Unlock()
+
+	now := time.Now()
+	pool := c.webhookPool(channelID)
+	selected := leastRecentlyUsed(pool, now)
+
+	busy := selected == nil || now.Sub(selected.lastUsed) < webhookBusyInterval
+	if busy && len(pool) < c.maxWebhooks {
+		created, err := c.addWebhook(channelID)
+		if err == nil {
+			selected = created
+		} else if selected == nil {
+			return nil, err
+		} else {
+			log.Printf("⚠️ Failed to grow webhook pool of channel %s: %v", channelID, err)
+		}
+	}
+	if selected == nil {
+		return nil, fmt.Errorf("all %d webhooks of channel %s are rate limited", len(pool), channelID)
+	}
+
+	selected.lastUsed = now
+	return selected, nil
+}
+
+// markWebhookRateLimited keeps a webhook out of rotation until its rate limit resets
+func (c *Client) markWebhookRateLimited(webhook *pooledWebhook, retryAfter time.Duration) {
+	c.webhooksMu.Lock()
+	webhook.rateLimitedUntil = time.Now().Add(retryAfter)
+	c.webhooksMu.Unlock()
+}
+
+// retryAfter reads the Retry-After header of a 429 response, in seconds, defaulting to one second
+func retryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.ParseFloat(resp.Header.Get("Retry-After"), 64)
+	if err != nil || seconds <= 0 {
+		return time.Second
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,147 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"dcbot/internal/database/models"
+	"github.com/bwmarrin/discordgo"
+)
+
+// webhookName is the name of webhooks created by the bot
+const webhookName = "Bridge Bot"
+
+// webhookCleanupInterval is how often orphaned webhooks are removed
+const webhookCleanupInterval = 24 * time.Hour
+
+// webhookCleanupDelay gives the gateway time to report guilds before the startup cleanup
+const webhookCleanupDelay = time.Minute
+
+// WebhookStore persists the webhooks created by the bot
+type WebhookStore interface {
+	GetChannelWebhooks(channelID string) ([]*models.Webhook, error)
+	SaveWebhook(webhook *models.Webhook) error
+	GetWebhooks() ([]*models.Webhook, error)
+}
+
+// SetWebhookStore sets where created webhooks are persisted
+func (c *Client) SetWebhookStore(store WebhookStore) {
+	c.webhookStore = store
+}
+
+// buildWebhookURL returns the execute URL of a webhook
+func buildWebhookURL(webhookID, token string) string {
+	return fmt.Sprintf("https://discord.com/api/webhooks/%s/%s", webhookID, token)
+}
+
+// CleanupWebhooks deletes bot webhooks in a guild that are no longer stored, returning how many were deleted
+func (c *Client) CleanupWebhooks(guildID string) (int, error) {
+	if !c.isConnected {
+		return 0, fmt.Errorf("Discord client is not connected")
+	}
+	// Without the store every bot webhook would look orphaned
+	if c.webhookStore == nil {
+		return 0, fmt.Errorf("webhook store not configured")
+	}
+
+	stored, err := c.webhookStore.GetWebhooks()
+	if err != nil {
+		return 0, err
+	}
+	known := make(map[string]bool, len(stored))
+	for _, webhook := range stored {
+		known[webhook.WebhookID] = true
+	}
+
+	webhooks, err := c.session.GuildWebhooks(guildID)
+	if err != nil {
+		return 0, fmt.Errorf("error getting guild webhooks: %v", err)
+	}
+
+	botUser := c.GetBotUser()
+	deleted := 0
+	for _, webhook := range webhooks {
+		if webhook.Name != webhookName || known[webhook.ID] {
+			continue
+		}
+		// Leave webhooks that someone else happened to name the same way
+		if botUser != nil && webhook.User != nil && webhook.User.ID != botUser.ID {
+			continue
+		}
+
+		if err := c.session.WebhookDelete(webhook.ID); err != nil {
+			log.Printf("⚠️ Failed to delete orphaned webhook %s: %v", webhook.ID, err)
+			continue
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+// StartWebhookCleanup removes orphaned webhooks in every guild shortly after startup and then daily
+func (c *Client) StartWebhookCleanup(ctx context.Context) {
+	go func() {
+		timer := time.NewTimer(webhookCleanupDelay)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+				c.cleanupAllWebhooks()
+				timer.Reset(webhookCleanupInterval)
+			}
+		}
+	}()
+}
+
+// cleanupAllWebhooks runs CleanupWebhooks for every guild the bot is in
+func (c *Client) cleanupAllWebhooks() {
+	if !c.isConnected {
+		return
+	}
+
+	c.session.State.RLock()
+	guildIDs := make([]string, 0, len(c.session.State.Guilds))
+	for _, guild := range c.session.State.Guilds {
+		guildIDs = append(guildIDs, guild.ID)
+	}
+	c.session.State.RUnlock()
+
+	for _, guildID := range guildIDs {
+		deleted, err := c.CleanupWebhooks(guildID)
+		if err != nil {
+			log.Printf("⚠️ Webhook cleanup failed for guild %s: %v", guildID, err)
+			continue
+		}
+		log.Printf("🧹 Deleted %d orphaned webhooks in guild %s", deleted, guildID)
+	}
+}
+
+// commandConfigWebhooksCleanup deletes orphaned bridge webhooks in the current guild
+func (h *MessageHandler) commandConfigWebhooksCleanup(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		log.Printf("❌ Failed to defer webhook cleanup response: %v", err)
+		return
+	}
+
+	deleted, err := h.client.CleanupWebhooks(i.GuildID)
+	if err != nil {
+		h.editInteractionContent(s, i.Interaction, fmt.Sprintf("❌ Webhook cleanup failed: %v", err))
+		return
+	}
+
+	h.recordAudit(i, AuditActionWebhookClean, fmt.Sprintf("guild_%s", i.GuildID), fmt.Sprintf("%d deleted", deleted))
+	log.Printf("🧹 Deleted %d orphaned webhooks in guild %s", deleted, i.GuildID)
+	h.editInteractionContent(s, i.Interaction, fmt.Sprintf("🧹 Removed %d orphaned webhooks", deleted))
+}
@@ -0,0 +1,132 @@
+package discord
+
+import (
+	"fmt"
+	"strings"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/types"
+	"github.com/bwmarrin/discordgo"
+)
+
+// whitelistUserOptions are the options identifying a whitelisted user
+func whitelistUserOptions() []*discordgo.ApplicationCommandOption {
+	return []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "platform",
+			Description: "Platform of the user",
+			Required:    true,
+			Choices: []*discordgo.ApplicationCommandOptionChoice{
+				{
+					Name:  "Discord",
+					Value: types.PlatformDiscord,
+				},
+				{
+					Name:  "Telegram",
+					Value: types.PlatformTelegram,
+				},
+			},
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "user_id",
+			Description: "User ID on that platform",
+			Required:    true,
+		},
+	}
+}
+
+// handleConfigWhitelistCommand handles allowed-sender whitelist subcommands
+func (h *MessageHandler) handleConfigWhitelistCommand(s *discordgo.Session, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
+	if len(options) == 0 {
+		h.respondToInteraction(s, i, "❌ No whitelist subcommand specified")
+		return
+	}
+
+	if h.bridgeCore == nil {
+		h.respondToInteraction(s, i, "❌ Bridge core is not available")
+		return
+	}
+
+	previous, err := h.bridgeCore.GetBridgeConfig(types.PlatformDiscord, i.ChannelID)
+	if err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to get bridge config: %v", err))
+		return
+	}
+
+	if options[0].Name == "list" {
+		h.respondToInteractionWithEmbed(s, i, whitelistEmbed("📋 Bridge Whitelist", previous.AllowedSenders))
+		return
+	}
+
+	optionMap := getOptionMap(options[0].Options)
+	platform := optionMap["platform"].StringValue()
+	userID := strings.TrimSpace(optionMap["user_id"].StringValue())
+	if userID == "" {
+		h.respondToInteraction(s, i, "❌ User ID cannot be empty")
+		return
+	}
+	sender := platform + ":" + userID
+
+	config := *previous
+	switch options[0].Name {
+	case "add":
+		if previous.AllowedSenders.Contains(sender) {
+			h.respondToInteraction(s, i, fmt.Sprintf("⚠️ `%s` is already whitelisted", sender))
+			return
+		}
+		config.AllowedSenders = append(models.StringList{}, previous.AllowedSenders...)
+		config.AllowedSenders = append(config.AllowedSenders, sender)
+	case "remove":
+		if !previous.AllowedSenders.Contains(sender) {
+			h.respondToInteraction(s, i, fmt.Sprintf("❌ `%s` is not whitelisted", sender))
+			return
+		}
+		config.AllowedSenders = models.StringList{}
+		for _, allowed := range previous.AllowedSenders {
+			if allowed != sender {
+				config.AllowedSenders = append(config.AllowedSenders, allowed)
+			}
+		}
+	default:
+		h.respondToInteraction(s, i, "❓ Unknown whitelist subcommand")
+		return
+	}
+
+	if err := h.bridgeCore.UpdateBridgeConfig(types.PlatformDiscord, i.ChannelID, &config); err != nil {
+		h.respondToInteraction(s, i, fmt.Sprintf("❌ Failed to update whitelist: %v", err))
+		return
+	}
+
+	h.recordAudit(i, AuditActionWhitelist, fmt.Sprintf("discord_%s", i.ChannelID), fmt.Sprintf("%s %s", options[0].Name, sender))
+
+	h.pushUndo(interactionUserID(i), &UndoAction{
+		ActionType: UndoActionUpdateConfig,
+		Payload:    &configSnapshot{Platform: types.PlatformDiscord, ChannelID: i.ChannelID, Config: previous},
+	})
+
+	h.respondToInteractionWithEmbed(s, i, whitelistEmbed("✅ Bridge Whitelist Updated", config.AllowedSenders))
+}
+
+// whitelistEmbed lists the senders allowed through a bridge
+func whitelistEmbed(title string, senders models.StringList) *discordgo.MessageEmbed {
+	description := "Everyone can send through this bridge"
+	if len(senders) > 0 {
+		lines := make([]string, 0, len(senders))
+		for _, sender := range senders {
+			platform, userID, _ := strings.Cut(sender, ":")
+			lines = append(lines, fmt.Sprintf("%s `%s`", platformIcon(platform), userID))
+		}
+		description = "Only these users can send through this bridge:\n" + strings.Join(lines, "\n")
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       title,
+		Description: description,
+		Color:       0x00ff00,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Messages from other users are dropped",
+		},
+	}
+}
@@ -0,0 +1,126 @@
+package incomingwebhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// defaultUsername is shown for messages sent without a username
+const defaultUsername = "LinkCord Bridge"
+
+// Config configures a Discord incoming webhook client
+type Config struct {
+	WebhookURL string // Webhook URL created in the Discord channel settings
+	Username   string // Optional, overrides the webhook's name when a message has no username
+	AvatarURL  string // Optional, overrides the webhook's avatar when a message has no avatar
+}
+
+// Client posts messages to a manually created Discord webhook; no bot token is required
+type Client struct {
+	webhookURL string
+	username   string
+	avatarURL  string
+	httpClient *http.Client
+}
+
+// webhookPayload is the JSON body of a webhook message
+type webhookPayload struct {
+	Content   string `json:"content"`
+	Username  string `json:"username,omitempty"`
+	AvatarURL string `json:"avatar_url,omitempty"`
+}
+
+// NewClient creates a new incoming webhook client
+func NewClient(cfg Config) (*Client, error) {
+	parsed, err := url.Parse(cfg.WebhookURL)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid Discord webhook URL")
+	}
+
+	username := cfg.Username
+	if username == "" {
+		username = defaultUsername
+	}
+
+	return &Client{
+		webhookURL: cfg.WebhookURL,
+		username:   username,
+		avatarURL:  cfg.AvatarURL,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// IsConfigured returns whether a webhook URL is set
+func (c *Client) IsConfigured() bool {
+	return c.webhookURL != ""
+}
+
+// SendMessage posts a message with the default username and avatar.
+// The webhook is bound to a single channel, so channelID is only used for logging.
+func (c *Client) SendMessage(ctx context.Context, channelID, content string) error {
+	return c.SendWebhookMessage(ctx, channelID, content, "", "")
+}
+
+// SendWebhookMessage posts a message with a custom username and avatar
+func (c *Client) SendWebhookMessage(ctx context.Context, channelID, content, username, avatarURL string) error {
+	if username == "" {
+		username = c.username
+	}
+	if avatarURL == "" {
+		avatarURL = c.avatarURL
+	}
+
+	jsonData, err := json.Marshal(webhookPayload{
+		Content:   content,
+		Username:  username,
+		AvatarURL: avatarURL,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook payload: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.webhookURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		// The URL contains the webhook token, so it is not included in the error
+		return fmt.Errorf("failed to send webhook message")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook request failed with status: %d", resp.StatusCode)
+	}
+
+	log.Printf("✅ Incoming webhook message sent (%s)", channelID)
+	return nil
+}
+
+// Ping checks that the webhook still exists
+func (c *Client) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.webhookURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %v", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook is unreachable")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("webhook check failed with status: %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package telegram
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"dcbot/internal/database/models"
+	"dcbot/internal/types"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// adminAccessRequired is the reply to admin commands from other users
+const adminAccessRequired = "⛔ Admin access required."
+
+// adminListTTL is how long the /bridge_admins reply is cached
+const adminListTTL = 60 * time.Second
+
+// UserMappingStore looks up the stored names of platform users
+type UserMappingStore interface {
+	GetUserMapping(platform, platformUserID string) (*models.UserMapping, error)
+}
+
+// SetUserMappingStore sets where /bridge_admins looks up admin names
+func (c *Client) SetUserMappingStore(store UserMappingStore) {
+	c.userStore = store
+}
+
+// isAdmin checks whether a Telegram user is listed in the admin user IDs
+func (c *Client) isAdmin(user *tgbotapi.User) bool {
+	if user == nil {
+		return false
+	}
+	for _, id := range c.adminUserIDs {
+		if id == user.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// commandBridgeCreate handles /bridge_create <discord_channel_id>, bridging the current chat to a Discord channel
+func (c *Client) commandBridgeCreate(message *tgbotapi.Message) {
+	if !c.isAdmin(message.From) {
+		c.sendMessage(message.Chat.ID, adminAccessRequired)
+		return
+	}
+
+	if c.bridgeCore == nil {
+		c.sendMessage(message.Chat.ID, "❌ Bridge core is not available")
+		return
+	}
+
+	discordChannelID := strings.TrimSpace(message.CommandArguments())
+	if discordChannelID == "" {
+		c.sendMessage(message.Chat.ID, "Usage: /bridge_create <discord_channel_id>")
+		return
+	}
+	if _, err := strconv.ParseUint(discordChannelID, 10, 64); err != nil {
+		c.sendMessage(message.Chat.ID, "❌ Discord channel ID must be numeric")
+		return
+	}
+
+	chatID := strconv.FormatInt(message.Chat.ID, 10)
+	if err := c.bridgeCore.AddBridge(types.PlatformTelegram, chatID, types.PlatformDiscord, discordChannelID); err != nil {
+		c.sendMessage(message.Chat.ID, fmt.Sprintf("❌ Failed to create bridge: %v", err))
+		return
+	}
+
+	err := c.bridgeCore.RecordAudit(&models.AuditLog{
+		Action:        "bridge_create",
+		ActorPlatform: types.PlatformTelegram,
+		ActorID:       strconv.FormatInt(message.From.ID, 10),
+		Target:        fmt.Sprintf("telegram_%s_discord_%s", chatID, discordChannelID),
+	})
+	if err != nil {
+		log.Printf("⚠️ Failed to write audit log for bridge creation: %v", err)
+	}
+
+	c.sendMessage(message.Chat.ID, fmt.Sprintf("✅ Bridge created\n\n🌉 This chat is now bridged with Discord channel %s", discordChannelID))
+
+	chatName := message.Chat.Title
+	if chatName == "" {
+		chatName = chatID
+	}
+	announcement := fmt.Sprintf("🌉 This channel is now bridged with Telegram chat **%s**", chatName)
+	if err := c.bridgeCore.SendSystemMessage(types.PlatformDiscord, discordChannelID, announcement); err != nil {
+		log.Printf("⚠️ Failed to announce bridge in Discord channel %s: %v", discordChannelID, err)
+	}
+
+	log.Printf("🌉 Bridge created from Telegram by %s: %s ↔ discord #%s", getUsername(message.From), chatID, discordChannelID)
+}
+
+// commandBridgeList handles /bridge_list, listing the bridges of the current chat
+func (c *Client) commandBridgeList(message *tgbotapi.Message) {
+	if !c.isAdmin(message.From) {
+		c.sendMessage(message.Chat.ID, adminAccessRequired)
+		return
+	}
+
+	if c.bridgeCore == nil {
+		c.sendMessage(message.Chat.ID, "❌ Bridge core is not available")
+		return
+	}
+
+	bridges := c.bridgeCore.GetBridges(strconv.FormatInt(message.Chat.ID, 10))
+
+	text := "🌉 Active bridges for this chat:\n"
+	if len(bridges) == 0 {
+		text += "• No bridges found"
+	}
+	for _, bridge := range bridges {
+		target := "`" + bridge.TargetChannelID + "`"
+		if bridge.TargetPlatform == types.PlatformDiscord && c.discordResolver != nil {
+			if name, err := c.discordResolver(bridge.TargetChannelID); err == nil {
+				target = "#" + escapeMarkdown(name)
+			}
+		}
+		if bridge.Name != "" {
+			target += " \"" + escapeMarkdown(bridge.Name) + "\""
+		}
+		state := ""
+		if !bridge.IsActive {
+			state = " ⏸ paused"
+		}
+		text += fmt.Sprintf("• %s → %s (`%s`)%s\n   since %s\n", bridge.TargetPlatform, target, bridge.ID, state, bridge.CreatedAt.Format("2006-01-02 15:04"))
+	}
+
+	c.sendMessage(message.Chat.ID, text)
+}
+
+// escapeMarkdown escapes characters with a meaning in Telegram Markdown
+func escapeMarkdown(text string) string {
+	return strings.NewReplacer("_", "\\_", "*", "\\*", "`", "\\`", "[", "\\[").Replace(text)
+}
+
+// commandBridgeAdmins handles /bridge_admins, listing the Telegram and Discord admins of the bridge
+func (c *Client) commandBridgeAdmins(message *tgbotapi.Message) {
+	if !c.isAdmin(message.From) {
+		c.sendMessage(message.Chat.ID, adminAccessRequired)
+		return
+	}
+
+	list, ok := c.adminList.Get("admins")
+	if !ok {
+		list = c.buildAdminList()
+		c.adminList.Add("admins", list)
+	}
+	c.sendMessage(message.Chat.ID, list)
+}
+
+// buildAdminList formats the names of the Telegram and Discord admins
+func (c *Client) buildAdminList() string {
+	var telegramAdmins []string
+	for _, id := range c.adminUserIDs {
+		telegramAdmins = append(telegramAdmins, escapeMarkdown(c.telegramAdminName(strconv.FormatInt(id, 10))))
+	}
+
+	var discordNames map[string]string
+	if c.bridgeCore != nil {
+		discordNames = c.bridgeCore.GetUserMappings(types.PlatformDiscord)
+	}
+	var discordAdmins []string
+	for _, id := range c.discordAdminIDs {
+		name := discordNames[id]
+		if name == "" {
+			name = id
+		}
+		discordAdmins = append(discordAdmins, escapeMarkdown(name))
+	}
+
+	return fmt.Sprintf("👮 Bridge Admins:\n• Telegram: %s\n• Discord: %s", joinOrNone(telegramAdmins), joinOrNone(discordAdmins))
+}
+
+// telegramAdminName returns the @username of a Telegram admin, falling back to the last seen name or the user ID
+func (c *Client) telegramAdminName(userID string) string {
+	if c.userStore != nil {
+		mapping, err := c.userStore.GetUserMapping(types.PlatformTelegram, userID)
+		if err != nil {
+			log.Printf("⚠️ Failed to look up Telegram admin %s: %v", userID, err)
+		} else if mapping != nil && mapping.Username != "" {
+			return "@" + mapping.Username
+		} else if mapping != nil && mapping.DisplayName != "" {
+			return mapping.DisplayName
+		}
+	}
+
+	c.userMappingsMu.RLock()
+	defer c.userMappingsMu.RUnlock()
+	if name, exists := c.userMappings[userID]; exists {
+		return name
+	}
+	return userID
+}
+
+// joinOrNone joins names with commas, or returns "none" if there are none
+func joinOrNone(names []string) string {
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,52 @@
+package telegram
+
+import (
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// recordingSender stubs the bot API, recording the text of every sent message
+type recordingSender struct {
+	texts []string
+}
+
+func (r *recordingSender) send(c tgbotapi.Chattable) (tgbotapi.Message, error) {
+	if msg, ok := c.(tgbotapi.MessageConfig); ok {
+		r.texts = append(r.texts, msg.Text)
+	}
+	return tgbotapi.Message{}, nil
+}
+
+func TestCommandBridgeCreateRequiresAdmin(t *testing.T) {
+	tests := []struct {
+		name   string
+		userID int64
+		want   string
+	}{
+		{"non-admin", 2, adminAccessRequired},
+		{"admin", 1, "❌ Bridge core is not available"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sender := &recordingSender{}
+			client := newTestClient()
+			client.sendFunc = sender.send
+			client.adminUserIDs = []int64{1}
+
+			client.commandBridgeCreate(&tgbotapi.Message{
+				From: &tgbotapi.User{ID: tt.userID},
+				Chat: &tgbotapi.Chat{ID: -100},
+				Text: "/bridge_create 123456789012345678",
+				Entities: []tgbotapi.MessageEntity{
+					{Type: "bot_command", Offset: 0, Length: len("/bridge_create")},
+				},
+			})
+
+			if len(sender.texts) != 1 || sender.texts[0] != tt.want {
+				t.Errorf("replies = %q, want [%q]", sender.texts, tt.want)
+			}
+		})
+	}
+}
@@ -1,51 +1,230 @@
 package telegram
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"log/slog"
+	"net/http"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"dcbot/internal/cache"
+	"dcbot/internal/types"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 )
 
 type Client struct {
 	bot         *tgbotapi.BotAPI
+	sendFunc    func(tgbotapi.Chattable) (tgbotapi.Message, error) // Sends through bot, replaced in tests
 	chatID      int64
 	isRunning   bool
+	listening   atomic.Bool // False once the update listener goroutine has died
 	stopChan    chan struct{}
 	updatesChan tgbotapi.UpdatesChannel
+	logger      *slog.Logger
+
+	requestTimeout time.Duration // Maximum duration of a Bot API request
+
+	maxMediaSizeBytes   int64
+	streamMediaTransfer bool
+	adminUserIDs        []int64
+	discordAdminIDs     []string
+
+	// userMappings stores user ID to display name mappings
+	userMappings   map[string]string
+	userMappingsMu sync.RWMutex
+
+	// messageHandlerCallback stores the bridge message handler
+	messageHandlerCallback func(string, string, string, string, string) error
+
+	// bridgeMessageHandler receives fully built bridge messages (events, media)
+	bridgeMessageHandler func(*types.BridgeMessage) error
+
+	// bridgeCore is used by commands that show or change bridge state
+	bridgeCore types.BridgeCore
+
+	// discordResolver returns the name of a Discord channel, optional
+	discordResolver func(channelID string) (string, error)
+
+	// messageTopics stores the forum topic of received messages until they are handled
+	messageTopics map[topicKey]messageTopic
+	topicNames    map[int]string // Forum topic names by topic ID
+	topicsMu      sync.Mutex
+
+	// sentStore records messages sent by the bot for loop prevention, optional
+	sentStore SentMessageStore
+
+	// userStore looks up stored user names for /bridge_admins, optional
+	userStore UserMappingStore
+
+	// inviteStore stores invites created through /getid deep links, optional
+	inviteStore BridgeInviteStore
+	// linkStore stores account link codes created through /link, optional
+	linkStore AccountLinkStore
+	adminList *cache.LRU[string, string] // Cached /bridge_admins reply
+
+	// stickerSets caches sticker sets by name for stickerSetTTL
+	stickerSets   map[string]*cachedStickerSet
+	stickerSetsMu sync.Mutex
 }
 
 type Config struct {
-	BotToken string
-	ChatID   string
+	BotToken            string
+	ChatID              string
+	Logger              *slog.Logger // Optional, defaults to slog.Default()
+	MaxMediaSizeBytes   int64        // Documents above this size are not downloaded
+	StreamMediaTransfer bool         // Stream videos to the target platform instead of downloading them first
+	AdminUserIDs        []int64      // Users allowed to run admin commands
+	DiscordAdminIDs     []string     // Discord admins, listed by /bridge_admins
+
+	// RequestTimeout bounds every Bot API request, 0 uses defaultRequestTimeout.
+	// Keep it at or below the bridge's delivery timeout: a send the bridge gave up on
+	// is retried, and must not still be able to reach Telegram after that.
+	RequestTimeout time.Duration
+}
+
+const (
+	// defaultRequestTimeout leaves room for the longest long poll
+	defaultRequestTimeout = maxLongPollTimeout + longPollMargin
+	// maxLongPollTimeout is how long getUpdates waits for new updates with a long request timeout
+	maxLongPollTimeout = 60 * time.Second
+	// longPollMargin is kept between the long poll and the request timeout for the response to arrive
+	longPollMargin = 5 * time.Second
+)
+
+// newBotAPI creates a bot API whose requests give up after the given timeout
+func newBotAPI(token string, timeout time.Duration) (*tgbotapi.BotAPI, error) {
+	return tgbotapi.NewBotAPIWithClient(token, tgbotapi.APIEndpoint, &http.Client{Timeout: timeout})
+}
+
+// longPollTimeout returns how many seconds getUpdates may wait for updates, finishing within the request timeout
+func longPollTimeout(requestTimeout time.Duration) int {
+	poll := min(requestTimeout-longPollMargin, maxLongPollTimeout)
+	if poll < requestTimeout/2 {
+		poll = requestTimeout / 2
+	}
+	return max(int(poll/time.Second), 1)
 }
 
 // NewClient creates a new Telegram bot client
 func NewClient(cfg Config) (*Client, error) {
-	bot, err := tgbotapi.NewBotAPI(cfg.BotToken)
+	requestTimeout := cfg.RequestTimeout
+	if requestTimeout <= 0 {
+		requestTimeout = defaultRequestTimeout
+	}
+
+	bot, err := newBotAPI(cfg.BotToken, requestTimeout)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Telegram bot: %v", err)
 	}
 
-	// Parse chat ID
-	chatID, err := strconv.ParseInt(cfg.ChatID, 10, 64)
+	// Parse chat ID, broadcast channels may be given by their @username
+	chatID, err := resolveChatID(bot, cfg.ChatID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid Telegram chat ID: %v", err)
 	}
 
 	log.Printf("✅ Telegram bot authorized: %s", bot.Self.UserName)
 
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
 	client := &Client{
-		bot:      bot,
-		chatID:   chatID,
-		stopChan: make(chan struct{}),
+		bot:          bot,
+		sendFunc:     bot.Send,
+		chatID:       chatID,
+		stopChan:     make(chan struct{}),
+		userMappings: make(map[string]string),
+		logger:       logger,
+
+		requestTimeout: requestTimeout,
+
+		messageTopics: make(map[topicKey]messageTopic),
+		topicNames:    make(map[int]string),
+
+		maxMediaSizeBytes:   cfg.MaxMediaSizeBytes,
+		streamMediaTransfer: cfg.StreamMediaTransfer,
+		adminUserIDs:        cfg.AdminUserIDs,
+		discordAdminIDs:     cfg.DiscordAdminIDs,
+		adminList:           cache.NewLRU[string, string](1, adminListTTL),
+
+		stickerSets: make(map[string]*cachedStickerSet),
 	}
 
 	return client, nil
 }
 
+// botCommands are the commands shown to every member in Telegram's command menu
+var botCommands = []tgbotapi.BotCommand{
+	{Command: "start", Description: "Start the bot"},
+	{Command: "help", Description: "Show help"},
+	{Command: "status", Description: "Show bridge status"},
+	{Command: "bridge", Description: "Bridge this chat with other platforms"},
+	{Command: "unbridge", Description: "Remove bridge connections"},
+	{Command: "getid", Description: "Show this chat's ID and a bridge invite link"},
+	{Command: "link", Description: "Link your Telegram account to your Discord account"},
+}
+
+// adminBotCommands are only shown to administrators of the monitored chat
+var adminBotCommands = []tgbotapi.BotCommand{
+	{Command: "bridge_create", Description: "Bridge this chat to a Discord channel ID"},
+	{Command: "bridge_list", Description: "List the bridges of this chat"},
+	{Command: "bridge_admins", Description: "List the bridge admins"},
+}
+
+// knownCommands maps command names to whether they are admin-only
+var knownCommands = func() map[string]bool {
+	commands := make(map[string]bool, len(botCommands)+len(adminBotCommands))
+	for _, command := range botCommands {
+		commands[command.Command] = false
+	}
+	for _, command := range adminBotCommands {
+		commands[command.Command] = true
+	}
+	return commands
+}()
+
+// registerCommands publishes the command list to Telegram's command suggestion menu
+func (c *Client) registerCommands() {
+	if _, err := c.bot.Request(tgbotapi.NewSetMyCommands(botCommands...)); err != nil {
+		log.Printf("⚠️ Warning: Could not register Telegram commands: %v", err)
+		return
+	}
+
+	// A scoped list replaces the default one, so administrators get every command
+	adminCommands := append(append([]tgbotapi.BotCommand{}, botCommands...), adminBotCommands...)
+	scope := tgbotapi.NewBotCommandScopeChatAdministrators(c.chatID)
+	if _, err := c.bot.Request(tgbotapi.NewSetMyCommandsWithScopeAndLanguage(scope, "", adminCommands...)); err != nil {
+		log.Printf("⚠️ Warning: Could not register Telegram admin commands: %v", err)
+		return
+	}
+
+	log.Printf("✅ Registered %d Telegram commands", len(knownCommands))
+}
+
+// commandHelp renders the /help text from the registered command lists
+func commandHelp() string {
+	var help strings.Builder
+	help.WriteString("🤖 DCBot Commands:\n")
+	for _, command := range botCommands {
+		fmt.Fprintf(&help, "/%s - %s\n", command.Command, command.Description)
+	}
+	for _, command := range adminBotCommands {
+		fmt.Fprintf(&help, "/%s - %s (admins)\n", command.Command, command.Description)
+	}
+	help.WriteString("\n💡 The bot will bridge messages between Telegram and Discord platforms.")
+	return help.String()
+}
+
 // Start begins listening for Telegram updates
 func (c *Client) Start(messageHandler func(platform, chatID, userID, messageType, content string) error) error {
 	if c.isRunning {
@@ -53,7 +232,7 @@ func (c *Client) Start(messageHandler func(platform, chatID, userID, messageType
 	}
 
 	// Store message handler callback
-	messageHandlerCallback = messageHandler
+	c.messageHandlerCallback = messageHandler
 
 	log.Printf("🚀 Starting Telegram bot...")
 	log.Printf("📱 Bot username: @%s", c.bot.Self.UserName)
@@ -70,21 +249,32 @@ func (c *Client) Start(messageHandler func(platform, chatID, userID, messageType
 		log.Printf("✅ Webhook deleted, using polling")
 	}
 
+	c.registerCommands()
+
 	// Configure updates
 	u := tgbotapi.NewUpdate(0)
-	u.Timeout = 60
+	u.Timeout = longPollTimeout(c.requestTimeout)
 
 	log.Printf("🔄 Getting updates channel...")
-	// Get updates channel
-	c.updatesChan = c.bot.GetUpdatesChan(u)
+	// Get updates channel, polled by the client to keep forum topic IDs
+	c.updatesChan = c.getUpdatesChan(u, c.stopChan)
 
 	// Start processing updates in a goroutine
+	c.listening.Store(true)
 	go func() {
+		// A panic stops the listener; IsRunning reports it so the watchdog can restart the client
+		defer func() {
+			if r := recover(); r != nil {
+				c.listening.Store(false)
+				log.Printf("❌ Telegram update listener crashed: %v", r)
+			}
+		}()
+
 		log.Printf("📡 Starting update listener goroutine...")
 		for {
 			select {
 			case update := <-c.updatesChan:
-				log.Printf("📥 Received Telegram update: %+v", update)
+				c.logger.Debug("📥 Received Telegram update", "update_id", update.UpdateID)
 				c.handleUpdate(update, messageHandler)
 			case <-c.stopChan:
 				log.Printf("🛑 Telegram update listener stopped")
@@ -100,12 +290,31 @@ func (c *Client) Start(messageHandler func(platform, chatID, userID, messageType
 
 // handleUpdate processes incoming Telegram updates
 func (c *Client) handleUpdate(update tgbotapi.Update, messageHandler func(string, string, string, string, string) error) {
-	log.Printf("🔍 Processing update: %+v", update)
+	c.logger.Debug("🔍 Processing update", "update", fmt.Sprintf("%+v", update))
 	
-	// Handle messages
-	if update.Message != nil {
-		message := update.Message
+	// Handle messages; broadcast channel posts are handled as messages sent by the channel itself
+	message := update.Message
+	if message == nil && update.ChannelPost != nil {
+		message = channelPostMessage(update.ChannelPost)
+	}
+	if message != nil {
 		log.Printf("📨 Message received - Chat ID: %d, User: %s, Text: %s", message.Chat.ID, message.From.UserName, message.Text)
+		topic := c.messageTopic(message)
+		defer c.forgetTopic(message)
+
+		// A group upgraded to a supergroup continues under a new chat ID
+		if message.MigrateFromChatID != 0 {
+			c.handleChatMigration(message)
+			return
+		}
+
+		// Private chats with the bot only take commands, like /start deep links and /link
+		if message.Chat.ID != c.chatID && message.Chat.IsPrivate() {
+			if !message.From.IsBot && strings.HasPrefix(message.Text, "/") {
+				c.handleCommand(message)
+			}
+			return
+		}
 
 		// Check if this is the monitored chat
 		if message.Chat.ID != c.chatID {
@@ -119,31 +328,42 @@ func (c *Client) handleUpdate(update tgbotapi.Update, messageHandler func(string
 			return
 		}
 
-		// Skip messages that look like bridge messages to prevent loops
-		if strings.Contains(message.Text, "[DISCORD]") {
-			log.Printf("⏭️ Ignoring potential bridge message: %s", message.Text)
+		// Skip messages the bot sent itself to prevent loops
+		if c.isBotSent(message) {
+			log.Printf("⏭️ Ignoring message %d sent by the bridge", message.MessageID)
+			return
+		}
+
+		// Topic creation and renames are not bridged
+		if topic.Service {
+			return
+		}
+
+		// Handle group photo changes
+		if message.NewChatPhoto != nil {
+			c.handleChatPhotoChange(message)
+			return
+		}
+
+		// Handle pinned messages
+		if message.PinnedMessage != nil {
+			c.handlePinnedMessage(message)
+			return
+		}
+
+		// Handle group renames
+		if message.NewChatTitle != "" {
+			c.handleChatTitleChange(message)
 			return
 		}
 
 		// Extract message information
 		chatID := strconv.FormatInt(message.Chat.ID, 10)
 		userID := strconv.FormatInt(message.From.ID, 10)
-		
-		// Get username - prioritize Telegram username over first name
-		username := ""
-		if message.From.UserName != "" {
-			username = message.From.UserName // Telegram @username (without @)
-		} else if message.From.FirstName != "" {
-			username = message.From.FirstName
-			if message.From.LastName != "" {
-				username += " " + message.From.LastName
-			}
-		} else {
-			username = "User" + userID // Fallback to User + ID
-		}
+		username := getUsername(message.From)
 
-		log.Printf("📨 Telegram user info - ID: %s, Username: %s, FirstName: %s, LastName: %s", 
-			userID, message.From.UserName, message.From.FirstName, message.From.LastName)
+		c.logger.Debug("📨 Telegram user info", "user_id", userID, "username", message.From.UserName,
+			"first_name", message.From.FirstName, "last_name", message.From.LastName)
 		
 		// Store user mapping for bridge core
 		c.storeUserMapping(userID, username)
@@ -163,15 +383,35 @@ func (c *Client) handleUpdate(update tgbotapi.Update, messageHandler func(string
 				return
 			}
 
+			// Topic messages carry the topic so they reach the matching Discord thread,
+			// forwarded messages carry where they were forwarded from
+			if (topic.ID != 0 || isForwarded(message)) && c.bridgeMessageHandler != nil {
+				c.handleTopicText(message, userID, username)
+				return
+			}
+
 		case message.Photo != nil:
+			// Bridge photos with their contents when the bridge core accepts full messages
+			if c.bridgeMessageHandler != nil {
+				photo := message.Photo[len(message.Photo)-1]
+				c.handleMedia(message, userID, username, types.MessageTypeImage, photo.FileID, int64(photo.FileSize), "photo.jpg", "image/jpeg")
+				return
+			}
+
+			// The text-only message handler cannot carry the file, so the caption or a placeholder stands in for the photo
 			messageType = "image"
 			content = message.Caption
 			if content == "" {
 				content = "📷 Image"
 			}
-			// TODO: Add photo URL/file handling
 
 		case message.Document != nil:
+			// Upload documents natively when the bridge core accepts full messages
+			if c.bridgeMessageHandler != nil {
+				c.handleDocument(message, userID, username)
+				return
+			}
+
 			messageType = "file"
 			content = message.Document.FileName
 			if message.Caption != "" {
@@ -179,6 +419,12 @@ func (c *Client) handleUpdate(update tgbotapi.Update, messageHandler func(string
 			}
 
 		case message.Audio != nil:
+			if c.bridgeMessageHandler != nil {
+				audio := message.Audio
+				c.handleMedia(message, userID, username, types.MessageTypeAudio, audio.FileID, int64(audio.FileSize), mediaFileName(audio.FileName, "audio.mp3"), audio.MimeType)
+				return
+			}
+
 			messageType = "audio"
 			content = "🎵 Audio"
 			if message.Caption != "" {
@@ -186,6 +432,12 @@ func (c *Client) handleUpdate(update tgbotapi.Update, messageHandler func(string
 			}
 
 		case message.Video != nil:
+			if c.bridgeMessageHandler != nil {
+				video := message.Video
+				c.handleMedia(message, userID, username, types.MessageTypeVideo, video.FileID, int64(video.FileSize), mediaFileName(video.FileName, "video.mp4"), video.MimeType)
+				return
+			}
+
 			messageType = "video"
 			content = "🎥 Video"
 			if message.Caption != "" {
@@ -197,9 +449,23 @@ func (c *Client) handleUpdate(update tgbotapi.Update, messageHandler func(string
 			content = "🎤 Voice message"
 
 		case message.Sticker != nil:
-			messageType = "sticker"
+			if c.bridgeMessageHandler != nil {
+				c.handleSticker(message, userID, username)
+				return
+			}
+
+			messageType = types.MessageTypeSticker
 			content = "🎨 " + message.Sticker.Emoji + " Sticker"
 
+		case message.Location != nil:
+			if c.bridgeMessageHandler != nil {
+				c.handleLocation(message, userID, username)
+				return
+			}
+
+			messageType = types.MessageTypeLocation
+			content = locationSharedContent
+
 		default:
 			messageType = "text"
 			content = "📎 Unsupported message type"
@@ -218,6 +484,21 @@ func (c *Client) handleUpdate(update tgbotapi.Update, messageHandler func(string
 		}
 	}
 
+	if update.EditedChannelPost != nil {
+		c.handleEditedChannelPost(update.EditedChannelPost, messageHandler)
+	}
+
+	// Telegram sends updated vote counts for polls created by the bot
+	if update.Poll != nil && c.bridgeCore != nil {
+		votes := make([]int, len(update.Poll.Options))
+		for i, option := range update.Poll.Options {
+			votes[i] = option.VoterCount
+		}
+		if err := c.bridgeCore.UpdatePollResults(update.Poll.ID, votes, update.Poll.TotalVoterCount); err != nil {
+			log.Printf("❌ Failed to update poll results: %v", err)
+		}
+	}
+
 	// Handle callback queries (inline button presses)
 	if update.CallbackQuery != nil {
 		// Acknowledge the callback query
@@ -225,12 +506,258 @@ func (c *Client) handleUpdate(update tgbotapi.Update, messageHandler func(string
 		c.bot.Request(callback)
 
 		log.Printf("🔘 Telegram callback: %s", update.CallbackQuery.Data)
+		c.handleCallbackQuery(update.CallbackQuery)
 	}
 }
 
+// getUsername returns a display name for a Telegram user, prioritizing the @username over first name
+func getUsername(user *tgbotapi.User) string {
+	if user.UserName != "" {
+		return user.UserName // Telegram @username (without @)
+	}
+	if user.FirstName != "" {
+		username := user.FirstName
+		if user.LastName != "" {
+			username += " " + user.LastName
+		}
+		return username
+	}
+	return "User" + strconv.FormatInt(user.ID, 10) // Fallback to User + ID
+}
+
+// maxPinPreviewLength is how much of a pinned message is quoted in the pin notice
+const maxPinPreviewLength = 100
+
+// handlePinnedMessage bridges a pinned message as a pin notice quoting the start of the message
+func (c *Client) handlePinnedMessage(message *tgbotapi.Message) {
+	if c.bridgeMessageHandler == nil {
+		return
+	}
+
+	userID := strconv.FormatInt(message.From.ID, 10)
+	username := getUsername(message.From)
+	c.storeUserMapping(userID, username)
+
+	pinner := username
+	if message.From.UserName != "" {
+		pinner = "@" + message.From.UserName
+	}
+
+	pinned := message.PinnedMessage
+	preview := pinned.Text
+	if preview == "" {
+		preview = pinned.Caption
+	}
+	if runes := []rune(preview); len(runes) > maxPinPreviewLength {
+		preview = string(runes[:maxPinPreviewLength]) + "…"
+	}
+
+	content := fmt.Sprintf("📌 %s pinned a message", pinner)
+	if preview != "" {
+		content += fmt.Sprintf(": \"%s\"", preview)
+	}
+
+	bridgeMessage := &types.BridgeMessage{
+		ID:              fmt.Sprintf("telegram_%d_%d", message.Chat.ID, message.MessageID),
+		SourcePlatform:  types.PlatformTelegram,
+		SourceChannelID: strconv.FormatInt(message.Chat.ID, 10),
+		SourceUserID:    userID,
+		Username:        username,
+		Content:         content,
+		MessageType:     types.MessageTypePin,
+		Timestamp:       message.Time(),
+		PinnedMessageID: strconv.Itoa(pinned.MessageID),
+	}
+	c.setTopic(bridgeMessage, message)
+
+	log.Printf("📌 Telegram message %d pinned by %s", pinned.MessageID, username)
+	if err := c.bridgeMessageHandler(bridgeMessage); err != nil {
+		log.Printf("❌ Failed to bridge Telegram pin: %v", err)
+	}
+}
+
+// handleChatPhotoChange bridges a group photo change as an event message with the new photo attached
+func (c *Client) handleChatPhotoChange(message *tgbotapi.Message) {
+	if c.bridgeMessageHandler == nil || len(message.NewChatPhoto) == 0 {
+		return
+	}
+
+	userID := strconv.FormatInt(message.From.ID, 10)
+	username := getUsername(message.From)
+	c.storeUserMapping(userID, username)
+
+	// The last photo size is the largest one
+	photo := message.NewChatPhoto[len(message.NewChatPhoto)-1]
+	data, err := c.downloadFile(photo.FileID)
+	if err != nil {
+		log.Printf("❌ Failed to download new Telegram group photo: %v", err)
+		return
+	}
+
+	bridgeMessage := &types.BridgeMessage{
+		ID:              fmt.Sprintf("telegram_%d_%d", message.Chat.ID, message.MessageID),
+		SourcePlatform:  types.PlatformTelegram,
+		SourceChannelID: strconv.FormatInt(message.Chat.ID, 10),
+		SourceUserID:    userID,
+		Username:        username,
+		Content:         fmt.Sprintf("📸 Group photo updated by @%s", username),
+		MessageType:     types.MessageTypeEvent,
+		Timestamp:       message.Time(),
+		MediaBytes:      data,
+	}
+
+	log.Printf("📸 Telegram group photo changed in %d by %s", message.Chat.ID, username)
+	if err := c.bridgeMessageHandler(bridgeMessage); err != nil {
+		log.Printf("❌ Failed to bridge Telegram group photo change: %v", err)
+	}
+}
+
+// handleChatTitleChange bridges a group rename as a system message
+func (c *Client) handleChatTitleChange(message *tgbotapi.Message) {
+	if c.bridgeMessageHandler == nil {
+		return
+	}
+
+	userID := strconv.FormatInt(message.From.ID, 10)
+	username := getUsername(message.From)
+	c.storeUserMapping(userID, username)
+
+	bridgeMessage := &types.BridgeMessage{
+		ID:              fmt.Sprintf("telegram_%d_%d", message.Chat.ID, message.MessageID),
+		SourcePlatform:  types.PlatformTelegram,
+		SourceChannelID: strconv.FormatInt(message.Chat.ID, 10),
+		SourceUserID:    userID,
+		Username:        username,
+		Content:         "✏️ Group renamed to: " + message.NewChatTitle,
+		MessageType:     types.MessageTypeSystem,
+		Timestamp:       message.Time(),
+	}
+
+	log.Printf("✏️ Telegram group %d renamed to %q by %s", message.Chat.ID, message.NewChatTitle, username)
+	if err := c.bridgeMessageHandler(bridgeMessage); err != nil {
+		log.Printf("❌ Failed to bridge Telegram group rename: %v", err)
+	}
+}
+
+// handleDocument bridges a document with its file contents, or a note if it is too large
+func (c *Client) handleDocument(message *tgbotapi.Message, userID, username string) {
+	document := message.Document
+
+	bridgeMessage := &types.BridgeMessage{
+		ID:              fmt.Sprintf("telegram_%d_%d", message.Chat.ID, message.MessageID),
+		SourcePlatform:  types.PlatformTelegram,
+		SourceChannelID: strconv.FormatInt(message.Chat.ID, 10),
+		SourceUserID:    userID,
+		Username:        username,
+		Content:         message.Caption,
+		MessageType:     types.MessageTypeFile,
+		Timestamp:       time.Now(),
+		MediaMimeType:   document.MimeType,
+		MediaFileName:   document.FileName,
+		SourceMessageID: strconv.Itoa(message.MessageID),
+	}
+	c.setTopic(bridgeMessage, message)
+	setForwardOrigin(bridgeMessage, message)
+
+	if c.maxMediaSizeBytes > 0 && int64(document.FileSize) > c.maxMediaSizeBytes {
+		note := fmt.Sprintf("[File too large to bridge: %s (%s)]", document.FileName, formatFileSize(int64(document.FileSize)))
+		bridgeMessage.Content = strings.TrimSpace(bridgeMessage.Content + "\n" + note)
+	} else {
+		data, err := c.downloadFile(document.FileID)
+		if err != nil {
+			log.Printf("❌ Failed to download Telegram document %s: %v", document.FileName, err)
+			bridgeMessage.Content = strings.TrimSpace(bridgeMessage.Content + "\n📎 " + document.FileName)
+		} else {
+			bridgeMessage.MediaBytes = data
+		}
+	}
+
+	log.Printf("📎 Telegram document from %s: %s (%s)", username, document.FileName, formatFileSize(int64(document.FileSize)))
+	if err := c.bridgeMessageHandler(bridgeMessage); err != nil {
+		log.Printf("❌ Failed to bridge Telegram document: %v", err)
+	}
+}
+
+// handleMedia bridges a photo, video or audio message with its contents; the caption travels as the content
+func (c *Client) handleMedia(message *tgbotapi.Message, userID, username, messageType, fileID string, fileSize int64, fileName, mimeType string) {
+	bridgeMessage := &types.BridgeMessage{
+		ID:              fmt.Sprintf("telegram_%d_%d", message.Chat.ID, message.MessageID),
+		SourcePlatform:  types.PlatformTelegram,
+		SourceChannelID: strconv.FormatInt(message.Chat.ID, 10),
+		SourceUserID:    userID,
+		Username:        username,
+		Content:         message.Caption,
+		MessageType:     messageType,
+		Timestamp:       message.Time(),
+		MediaMimeType:   mimeType,
+		MediaFileName:   fileName,
+		SourceMessageID: strconv.Itoa(message.MessageID),
+	}
+	c.setTopic(bridgeMessage, message)
+	setForwardOrigin(bridgeMessage, message)
+
+	if c.maxMediaSizeBytes > 0 && fileSize > c.maxMediaSizeBytes {
+		note := fmt.Sprintf("[File too large to bridge: %s (%s)]", fileName, formatFileSize(fileSize))
+		bridgeMessage.Content = strings.TrimSpace(bridgeMessage.Content + "\n" + note)
+	} else if messageType == types.MessageTypeVideo && c.streamMediaTransfer {
+		bridgeMessage.MediaStream = c.streamFile(fileID)
+	} else {
+		data, err := c.downloadFile(fileID)
+		if err != nil {
+			log.Printf("❌ Failed to download Telegram %s %s: %v", messageType, fileName, err)
+		} else {
+			bridgeMessage.MediaBytes = data
+		}
+	}
+
+	log.Printf("🖼️ Telegram %s from %s: %s (%s)", messageType, username, fileName, formatFileSize(fileSize))
+	if err := c.bridgeMessageHandler(bridgeMessage); err != nil {
+		log.Printf("❌ Failed to bridge Telegram %s: %v", messageType, err)
+	}
+}
+
+// mediaFileName returns the file name of a media attachment, or a fallback if it has none
+func mediaFileName(name, fallback string) string {
+	if name == "" {
+		return fallback
+	}
+	return name
+}
+
+// formatFileSize formats a byte count as KB or MB
+func formatFileSize(size int64) string {
+	const mb = 1024 * 1024
+	if size >= mb {
+		return fmt.Sprintf("%dMB", (size+mb/2)/mb)
+	}
+	return fmt.Sprintf("%dKB", (size+512)/1024)
+}
+
+// downloadFile downloads a Telegram file by its file ID
+func (c *Client) downloadFile(fileID string) ([]byte, error) {
+	fileURL, err := c.bot.GetFileDirectURL(fileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file URL: %v", err)
+	}
+
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	resp, err := httpClient.Get(fileURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download file: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("file download failed with status: %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
 // handleCommand processes bot commands
 func (c *Client) handleCommand(message *tgbotapi.Message) {
 	command := strings.Split(message.Text, " ")[0]
+	command, _, _ = strings.Cut(command, "@") // Commands in groups may be addressed as /cmd@botname
 	_ = "" // args placeholder for future use
 	if len(strings.Split(message.Text, " ")) > 1 {
 		_ = strings.Join(strings.Split(message.Text, " ")[1:], " ")
@@ -238,38 +765,45 @@ func (c *Client) handleCommand(message *tgbotapi.Message) {
 
 	log.Printf("🤖 Telegram command: %s from %s", command, message.From.UserName)
 
-	switch command {
-	case "/start":
-		c.sendMessage(message.Chat.ID, "🌉 DCBot Bridge activated!\n\nAvailable commands:\n/help - Show help\n/status - Bridge status\n/bridge - Bridge management")
+	name := strings.TrimPrefix(command, "/")
+	if _, ok := knownCommands[name]; !ok {
+		c.sendMessage(message.Chat.ID, "❓ Unknown command. Type /help for available commands.")
+		return
+	}
 
-	case "/help":
-		helpText := `🤖 DCBot Commands:
-/start - Start the bot
-/help - Show this help
-/status - Show bridge status
-/bridge - Bridge this chat with other platforms
-/unbridge - Remove bridge connections
+	switch name {
+	case "start":
+		c.commandStart(message)
 
-💡 The bot will bridge messages between Telegram and Discord platforms.`
-		c.sendMessage(message.Chat.ID, helpText)
+	case "help":
+		c.sendMessage(message.Chat.ID, commandHelp())
 
-	case "/status":
-		statusText := "🌉 Bridge Status:\n"
-		statusText += "• Telegram: ✅ Connected\n"
-		statusText += "• Discord: ⏳ Checking...\n"
-		c.sendMessage(message.Chat.ID, statusText)
+	case "status":
+		c.sendStatus(message.Chat.ID)
 
-	case "/bridge":
+	case "bridge":
 		bridgeText := "🔗 Bridge Management:\n\n"
 		bridgeText += "To bridge this chat with other platforms, an admin needs to configure the bridge settings.\n\n"
 		bridgeText += "Current chat ID: " + strconv.FormatInt(message.Chat.ID, 10)
 		c.sendMessage(message.Chat.ID, bridgeText)
 
-	case "/unbridge":
-		c.sendMessage(message.Chat.ID, "🔗 Unbridge functionality will be implemented in the next phase.")
+	case "getid":
+		c.commandGetID(message)
 
-	default:
-		c.sendMessage(message.Chat.ID, "❓ Unknown command. Type /help for available commands.")
+	case "link":
+		c.commandLink(message)
+
+	case "bridge_create":
+		c.commandBridgeCreate(message)
+
+	case "bridge_list":
+		c.commandBridgeList(message)
+
+	case "bridge_admins":
+		c.commandBridgeAdmins(message)
+
+	case "unbridge":
+		c.sendMessage(message.Chat.ID, "🔗 Unbridge functionality will be implemented in the next phase.")
 	}
 }
 
@@ -284,17 +818,102 @@ func (c *Client) SendMessage(chatID, message string) error {
 	return c.sendMessage(id, message)
 }
 
+// SendMessageWithID sends a text message to a Telegram chat and returns the sent message ID.
+// Silent messages are delivered without a notification.
+func (c *Client) SendMessageWithID(chatID, message string, silent bool) (int, error) {
+	id, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid chat ID: %v", err)
+	}
+
+	return c.send(id, message, silent)
+}
+
+// EditMessageText replaces the text of a previously sent message
+func (c *Client) EditMessageText(chatID string, messageID int, text string) error {
+	id, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid chat ID: %v", err)
+	}
+
+	edit := tgbotapi.NewEditMessageText(id, messageID, text)
+	edit.ParseMode = tgbotapi.ModeMarkdown
+
+	if _, err := c.bot.Send(edit); err != nil {
+		return fmt.Errorf("failed to edit Telegram message: %v", err)
+	}
+	return nil
+}
+
 // sendMessage internal method to send message
 func (c *Client) sendMessage(chatID int64, message string) error {
+	_, err := c.sendMessageWithID(chatID, message)
+	return err
+}
+
+// sendMessageWithID sends a message and returns its Telegram message ID
+func (c *Client) sendMessageWithID(chatID int64, message string) (int, error) {
+	return c.send(chatID, message, false)
+}
+
+// send sends a Markdown message, optionally without notification, and returns its Telegram message ID
+func (c *Client) send(chatID int64, message string, silent bool) (int, error) {
 	msg := tgbotapi.NewMessage(chatID, message)
 	msg.ParseMode = tgbotapi.ModeMarkdown
+	msg.DisableNotification = silent
 
-	_, err := c.bot.Send(msg)
+	sent, err := c.sendFunc(msg)
 	if err != nil {
-		return fmt.Errorf("failed to send Telegram message: %v", err)
+		return 0, fmt.Errorf("failed to send Telegram message: %v", err)
 	}
+	c.recordSent(sent)
 
 	log.Printf("✅ Message sent to Telegram chat %d", chatID)
+	return sent.MessageID, nil
+}
+
+// SendPoll creates a poll in a Telegram chat and returns the sent message ID and the poll ID
+func (c *Client) SendPoll(chatID, question string, options []string, anonymous bool) (int, string, error) {
+	id, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid chat ID: %v", err)
+	}
+
+	poll := tgbotapi.NewPoll(id, question, options...)
+	poll.IsAnonymous = anonymous
+
+	sent, err := c.bot.Send(poll)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to send Telegram poll: %v", err)
+	}
+	c.recordSent(sent)
+	if sent.Poll == nil {
+		return 0, "", fmt.Errorf("Telegram did not return the created poll")
+	}
+
+	log.Printf("📊 Poll sent to Telegram chat %d", id)
+	return sent.MessageID, sent.Poll.ID, nil
+}
+
+// SendPhoto sends a photo with a caption to a Telegram chat
+func (c *Client) SendPhoto(chatID, caption string, data []byte, silent bool) error {
+	id, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid chat ID: %v", err)
+	}
+
+	photo := tgbotapi.NewPhoto(id, tgbotapi.FileBytes{Name: "photo.jpg", Bytes: data})
+	photo.Caption = caption
+	photo.ParseMode = tgbotapi.ModeMarkdown
+	photo.DisableNotification = silent
+
+	sent, err := c.bot.Send(photo)
+	if err != nil {
+		return fmt.Errorf("failed to send Telegram photo: %v", err)
+	}
+	c.recordSent(sent)
+
+	log.Printf("✅ Photo sent to Telegram chat %d", id)
 	return nil
 }
 
@@ -316,10 +935,11 @@ func (c *Client) SendReply(chatID, replyToMessageID, message string) error {
 	msg.ReplyToMessageID = msgID
 	msg.ParseMode = tgbotapi.ModeMarkdown
 
-	_, err = c.bot.Send(msg)
+	sent, err := c.bot.Send(msg)
 	if err != nil {
 		return fmt.Errorf("failed to send Telegram reply: %v", err)
 	}
+	c.recordSent(sent)
 
 	log.Printf("✅ Reply sent to Telegram chat %d", id)
 	return nil
@@ -345,14 +965,79 @@ func (c *Client) Stop() error {
 
 // IsRunning returns whether the client is currently running
 func (c *Client) IsRunning() bool {
-	return c.isRunning
+	return c.isRunning && c.listening.Load()
+}
+
+// Restart stops the client and starts polling again with a fresh bot API instance
+func (c *Client) Restart() error {
+	if c.isRunning {
+		c.bot.StopReceivingUpdates()
+		close(c.stopChan)
+		c.isRunning = false
+	}
+
+	// The bot API cannot resume polling once stopped, so create a new one
+	bot, err := newBotAPI(c.bot.Token, c.requestTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to recreate Telegram bot: %v", err)
+	}
+	c.bot = bot
+	c.stopChan = make(chan struct{})
+
+	return c.Start(c.messageHandlerCallback)
+}
+
+// Ping verifies the bot API is reachable and the token is valid by calling getMe
+func (c *Client) Ping(ctx context.Context) error {
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.bot.GetMe()
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("getMe failed: %v", err)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
-// GetChatInfo returns information about the configured chat
-func (c *Client) GetChatInfo() (*tgbotapi.Chat, error) {
+// TestCredentials verifies the bot token with getMe and returns the bot's username
+func (c *Client) TestCredentials(ctx context.Context) (string, error) {
+	type result struct {
+		user tgbotapi.User
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		user, err := c.bot.GetMe()
+		done <- result{user, err}
+	}()
+
+	select {
+	case r := <-done:
+		var apiErr *tgbotapi.Error
+		if errors.As(r.err, &apiErr) && apiErr.Code == http.StatusUnauthorized {
+			return "", fmt.Errorf("401 Unauthorized - token may be invalid")
+		}
+		if r.err != nil {
+			return "", r.err
+		}
+		return "@" + r.user.UserName, nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// GetChatInfo returns information about a chat
+func (c *Client) GetChatInfo(chatID int64) (*tgbotapi.Chat, error) {
 	chatConfig := tgbotapi.ChatInfoConfig{
 		ChatConfig: tgbotapi.ChatConfig{
-			ChatID: c.chatID,
+			ChatID: chatID,
 		},
 	}
 
@@ -364,28 +1049,115 @@ func (c *Client) GetChatInfo() (*tgbotapi.Chat, error) {
 	return &chat, nil
 }
 
-// userMappings stores user ID to display name mappings
-var userMappings = make(map[string]string)
+// GetChatAdministrators returns the user IDs of the human administrators of a Telegram group
+func (c *Client) GetChatAdministrators(chatID int64) ([]int64, error) {
+	members, err := c.bot.GetChatAdministrators(tgbotapi.ChatAdministratorsConfig{
+		ChatConfig: tgbotapi.ChatConfig{ChatID: chatID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat administrators: %v", err)
+	}
+
+	var userIDs []int64
+	for _, member := range members {
+		if member.User == nil || member.User.IsBot {
+			continue
+		}
+		userIDs = append(userIDs, member.User.ID)
+	}
+	return userIDs, nil
+}
+
+// SetChatAdmin promotes a member of a Telegram group to administrator with moderation rights, or demotes them to a regular member
+func (c *Client) SetChatAdmin(chatID, userID int64, admin bool) error {
+	// Promoting with every right false is how the Bot API demotes an administrator
+	config := tgbotapi.PromoteChatMemberConfig{
+		ChatMemberConfig:   tgbotapi.ChatMemberConfig{ChatID: chatID, UserID: userID},
+		CanManageChat:      admin,
+		CanDeleteMessages:  admin,
+		CanInviteUsers:     admin,
+		CanRestrictMembers: admin,
+		CanPinMessages:     admin,
+	}
+	if _, err := c.bot.Request(config); err != nil {
+		return fmt.Errorf("failed to change chat member rights: %v", err)
+	}
+	return nil
+}
 
-// messageHandlerCallback stores the bridge message handler
-var messageHandlerCallback func(string, string, string, string, string) error
+// SetChatDescription replaces the description of a Telegram group, which needs the bot to be allowed to change group info
+func (c *Client) SetChatDescription(chatID int64, description string) error {
+	if _, err := c.bot.Request(tgbotapi.NewChatDescription(chatID, description)); err != nil {
+		return fmt.Errorf("failed to set chat description: %v", err)
+	}
+	return nil
+}
+
+// GetChatMemberCount returns the number of members in a chat
+func (c *Client) GetChatMemberCount(chatID int64) (int, error) {
+	count, err := c.bot.GetChatMembersCount(tgbotapi.ChatMemberCountConfig{
+		ChatConfig: tgbotapi.ChatConfig{
+			ChatID: chatID,
+		},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get chat member count: %v", err)
+	}
+
+	return count, nil
+}
+
+// IsMember reports whether the bot is a member of a chat
+func (c *Client) IsMember(chatID int64) (bool, error) {
+	member, err := c.bot.GetChatMember(tgbotapi.GetChatMemberConfig{
+		ChatConfigWithUser: tgbotapi.ChatConfigWithUser{
+			ChatID: chatID,
+			UserID: c.bot.Self.ID,
+		},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to get chat member: %v", err)
+	}
+
+	return !member.HasLeft() && !member.WasKicked(), nil
+}
 
 // storeUserMapping stores user mapping for consistent display names
 func (c *Client) storeUserMapping(userID, username string) {
 	if username != "" && userID != "" {
-		userMappings[userID] = username
-		log.Printf("📝 Stored Telegram user mapping: %s -> %s", userID, username)
+		c.userMappingsMu.Lock()
+		c.userMappings[userID] = username
+		c.userMappingsMu.Unlock()
+		c.logger.Debug("📝 Stored Telegram user mapping", "user_id", userID, "username", username)
 	}
 }
 
 // getUserDisplayName gets the display name for a user ID
 func (c *Client) getUserDisplayName(userID string) string {
-	if displayName, exists := userMappings[userID]; exists {
+	c.userMappingsMu.RLock()
+	defer c.userMappingsMu.RUnlock()
+
+	if displayName, exists := c.userMappings[userID]; exists {
 		return displayName
 	}
 	return "User" + userID
 }
 
+// SetBridgeCore sets the bridge core used by status commands
+func (c *Client) SetBridgeCore(bc types.BridgeCore) {
+	c.bridgeCore = bc
+}
+
+// SetDiscordResolver sets the function used to look up Discord channel names
+func (c *Client) SetDiscordResolver(resolver func(channelID string) (string, error)) {
+	c.discordResolver = resolver
+}
+
+// SetBridgeMessageHandler sets the handler for messages that carry more than plain text (events, media)
+func (c *Client) SetBridgeMessageHandler(handler func(*types.BridgeMessage) error) {
+	c.bridgeMessageHandler = handler
+}
+
 // GetUserDisplayName returns the display name for a user ID (public method)
 func (c *Client) GetUserDisplayName(userID string) string {
 	return c.getUserDisplayName(userID)
@@ -0,0 +1,57 @@
+package telegram
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a client without a bot connection, for testing state kept by the client
+func newTestClient() *Client {
+	return &Client{
+		userMappings: make(map[string]string),
+		logger:       slog.Default(),
+	}
+}
+
+func TestMultipleTelegramClients(t *testing.T) {
+	first := newTestClient()
+	second := newTestClient()
+
+	first.storeUserMapping("42", "alice")
+	second.storeUserMapping("42", "bob")
+	first.storeUserMapping("7", "carol")
+
+	if got := first.GetUserDisplayName("42"); got != "alice" {
+		t.Errorf("first client user 42 = %q, want %q", got, "alice")
+	}
+	if got := second.GetUserDisplayName("42"); got != "bob" {
+		t.Errorf("second client user 42 = %q, want %q", got, "bob")
+	}
+	if got := second.GetUserDisplayName("7"); got != "User7" {
+		t.Errorf("second client user 7 = %q, want %q, mappings are shared between clients", got, "User7")
+	}
+}
+
+func TestLongPollTimeout(t *testing.T) {
+	tests := []struct {
+		requestTimeout time.Duration
+		want           int
+	}{
+		{defaultRequestTimeout, 60},
+		{2 * time.Minute, 60},
+		{30 * time.Second, 25},
+		{8 * time.Second, 4},
+		{time.Second, 1},
+	}
+
+	for _, tt := range tests {
+		got := longPollTimeout(tt.requestTimeout)
+		if got != tt.want {
+			t.Errorf("longPollTimeout(%s) = %d, want %d", tt.requestTimeout, got, tt.want)
+		}
+		if tt.requestTimeout > 2*time.Second && time.Duration(got)*time.Second >= tt.requestTimeout {
+			t.Errorf("longPollTimeout(%s) = %ds does not finish within the request timeout", tt.requestTimeout, got)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package telegram
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"dcbot/internal/types"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// resolveChatID parses a numeric chat ID, or looks up a public chat such as a broadcast channel by its @username
+func resolveChatID(bot *tgbotapi.BotAPI, chatID string) (int64, error) {
+	if !strings.HasPrefix(chatID, "@") {
+		return strconv.ParseInt(chatID, 10, 64)
+	}
+
+	chat, err := bot.GetChat(tgbotapi.ChatInfoConfig{ChatConfig: tgbotapi.ChatConfig{SuperGroupUsername: chatID}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to look up %s: %v", chatID, err)
+	}
+	log.Printf("📢 Resolved Telegram chat %s to ID %d", chatID, chat.ID)
+	return chat.ID, nil
+}
+
+// channelPostMessage returns a broadcast channel post as a message sent by the channel, which has no individual senders
+func channelPostMessage(post *tgbotapi.Message) *tgbotapi.Message {
+	message := *post
+	message.From = &tgbotapi.User{ID: post.Chat.ID, FirstName: chatTitle(post.Chat)}
+	return &message
+}
+
+// handleEditedChannelPost bridges the new text of an edited channel post.
+// Bridged copies on Discord cannot be edited, so the edit is sent as a new message.
+func (c *Client) handleEditedChannelPost(post *tgbotapi.Message, messageHandler func(string, string, string, string, string) error) {
+	if post.Chat.ID != c.chatID || c.isBotSent(post) {
+		return
+	}
+
+	content := post.Text
+	if content == "" {
+		content = post.Caption
+	}
+	if content == "" || messageHandler == nil {
+		return
+	}
+
+	message := channelPostMessage(post)
+	userID := strconv.FormatInt(post.Chat.ID, 10)
+	c.storeUserMapping(userID, getUsername(message.From))
+
+	err := messageHandler(types.PlatformTelegram, userID, userID, types.MessageTypeText, "✏️ Edited: "+content)
+	if err != nil {
+		log.Printf("❌ Failed to bridge edited Telegram channel post %d: %v", post.MessageID, err)
+	}
+}
@@ -0,0 +1,41 @@
+package telegram
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"dcbot/internal/types"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// isForwarded reports whether a message was forwarded from another user or channel
+func isForwarded(message *tgbotapi.Message) bool {
+	return message.ForwardFrom != nil || message.ForwardFromChat != nil
+}
+
+// setForwardOrigin records where a forwarded message came from
+func setForwardOrigin(bridgeMessage *types.BridgeMessage, message *tgbotapi.Message) {
+	if message.ForwardFromChat != nil {
+		bridgeMessage.ForwardedFromChatTitle = message.ForwardFromChat.Title
+		if message.ForwardFromMessageID != 0 {
+			bridgeMessage.ForwardedFromURL = messageURL(message.ForwardFromChat, message.ForwardFromMessageID)
+		}
+		return
+	}
+
+	if message.ForwardFrom != nil {
+		bridgeMessage.ForwardedFrom = getUsername(message.ForwardFrom)
+	}
+}
+
+// messageURL returns the t.me link of a message in a channel
+func messageURL(chat *tgbotapi.Chat, messageID int) string {
+	if chat.UserName != "" {
+		return fmt.Sprintf("https://t.me/%s/%d", chat.UserName, messageID)
+	}
+
+	// Private channel links use the chat ID without its -100 prefix and only open for members
+	id := strings.TrimPrefix(strconv.FormatInt(chat.ID, 10), "-100")
+	return fmt.Sprintf("https://t.me/c/%s/%d", id, messageID)
+}
@@ -0,0 +1,134 @@
+package telegram
+
+import (
+	"crypto/rand"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"dcbot/internal/database/models"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+const (
+	// bridgeInviteTTL is how long an invite code can be redeemed with /bridge create on Discord
+	bridgeInviteTTL = 24 * time.Hour
+	// bridgeInvitePrefix marks /start payloads of bridge invite deep links
+	bridgeInvitePrefix = "bridge_"
+
+	// Invite codes leave out characters that are easily confused, and cannot be mistaken for chat IDs
+	inviteCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	inviteCodeLength   = 8
+)
+
+// BridgeInviteStore stores pending invitations to bridge a Telegram chat
+type BridgeInviteStore interface {
+	CreateBridgeInvite(invite *models.PendingBridgeInvite) error
+}
+
+// SetBridgeInviteStore sets where invites created through /getid deep links are stored
+func (c *Client) SetBridgeInviteStore(store BridgeInviteStore) {
+	c.inviteStore = store
+}
+
+// commandGetID handles /getid, replying with the chat's ID and title and a deep link to invite it to a bridge
+func (c *Client) commandGetID(message *tgbotapi.Message) {
+	text := fmt.Sprintf("Chat ID: `%d`\nTitle: %s", message.Chat.ID, escapeMarkdown(chatTitle(message.Chat)))
+	if c.inviteStore != nil && !message.Chat.IsPrivate() {
+		link := fmt.Sprintf("https://t.me/%s?start=%s%d", c.bot.Self.UserName, bridgeInvitePrefix, message.Chat.ID)
+		text += fmt.Sprintf("\n\n🔗 [Get a bridge invite code](%s) for this chat", link)
+	}
+	c.sendMessage(message.Chat.ID, text)
+}
+
+// commandStart handles /start, creating a bridge invite when opened through a /getid deep link
+func (c *Client) commandStart(message *tgbotapi.Message) {
+	payload := message.CommandArguments()
+	if !strings.HasPrefix(payload, bridgeInvitePrefix) {
+		c.sendMessage(message.Chat.ID, "🌉 DCBot Bridge activated!\n\nAvailable commands:\n/help - Show help\n/status - Bridge status\n/bridge - Bridge management")
+		return
+	}
+
+	chatID, err := strconv.ParseInt(strings.TrimPrefix(payload, bridgeInvitePrefix), 10, 64)
+	if err != nil || !message.Chat.IsPrivate() {
+		c.sendMessage(message.Chat.ID, "❌ Invalid bridge invite link")
+		return
+	}
+	c.createBridgeInvite(message, chatID)
+}
+
+// createBridgeInvite stores an invite for a chat the user administers and sends them its code
+func (c *Client) createBridgeInvite(message *tgbotapi.Message, chatID int64) {
+	if c.inviteStore == nil {
+		c.sendMessage(message.Chat.ID, "❌ Bridge invites are not available")
+		return
+	}
+
+	// Only administrators of the chat may invite it to a bridge
+	if !c.isAdmin(message.From) {
+		member, err := c.bot.GetChatMember(tgbotapi.GetChatMemberConfig{
+			ChatConfigWithUser: tgbotapi.ChatConfigWithUser{ChatID: chatID, UserID: message.From.ID},
+		})
+		if err != nil || (!member.IsAdministrator() && !member.IsCreator()) {
+			c.sendMessage(message.Chat.ID, "⛔ Only administrators of the chat can invite it to a bridge.")
+			return
+		}
+	}
+
+	chat, err := c.GetChatInfo(chatID)
+	if err != nil {
+		log.Printf("❌ Failed to get Telegram chat %d for bridge invite: %v", chatID, err)
+		c.sendMessage(message.Chat.ID, "❌ The bot cannot access that chat")
+		return
+	}
+
+	code, err := newInviteCode()
+	if err != nil {
+		log.Printf("❌ Failed to generate bridge invite code: %v", err)
+		c.sendMessage(message.Chat.ID, "❌ Failed to create bridge invite")
+		return
+	}
+
+	now := time.Now()
+	err = c.inviteStore.CreateBridgeInvite(&models.PendingBridgeInvite{
+		Code:      code,
+		ChatID:    strconv.FormatInt(chatID, 10),
+		ChatTitle: chatTitle(chat),
+		CreatedBy: strconv.FormatInt(message.From.ID, 10),
+		CreatedAt: now,
+		ExpiresAt: now.Add(bridgeInviteTTL),
+	})
+	if err != nil {
+		log.Printf("❌ Failed to store bridge invite for Telegram chat %d: %v", chatID, err)
+		c.sendMessage(message.Chat.ID, "❌ Failed to create bridge invite")
+		return
+	}
+
+	log.Printf("🎟️ Bridge invite created for Telegram chat %d by %s", chatID, getUsername(message.From))
+	c.sendMessage(message.Chat.ID, fmt.Sprintf("🎟️ Bridge invite for *%s*\n\nRun this command in the Discord channel to bridge:\n`/bridge create telegram %s`\n\nThe code expires in 24 hours.",
+		escapeMarkdown(chatTitle(chat)), code))
+}
+
+// newInviteCode returns a random bridge invite code
+func newInviteCode() (string, error) {
+	random := make([]byte, inviteCodeLength)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+
+	code := make([]byte, inviteCodeLength)
+	for i, b := range random {
+		code[i] = inviteCodeAlphabet[int(b)%len(inviteCodeAlphabet)]
+	}
+	return string(code), nil
+}
+
+// chatTitle returns a chat's title, or its ID if it has none
+func chatTitle(chat *tgbotapi.Chat) string {
+	if chat.Title != "" {
+		return chat.Title
+	}
+	return strconv.FormatInt(chat.ID, 10)
+}
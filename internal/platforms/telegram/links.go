@@ -0,0 +1,63 @@
+package telegram
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"dcbot/internal/database/models"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// accountLinkTTL is how long a link code can be redeemed with /link on Discord
+const accountLinkTTL = 10 * time.Minute
+
+// AccountLinkStore stores pending requests to link a Telegram account to a Discord account
+type AccountLinkStore interface {
+	CreateAccountLink(link *models.PendingAccountLink) error
+}
+
+// SetAccountLinkStore sets where link codes created through /link are stored
+func (c *Client) SetAccountLinkStore(store AccountLinkStore) {
+	c.linkStore = store
+}
+
+// commandLink handles /link, sending the user a code that links their account when redeemed on Discord
+func (c *Client) commandLink(message *tgbotapi.Message) {
+	if c.linkStore == nil {
+		c.sendMessage(message.Chat.ID, "❌ Account linking is not available")
+		return
+	}
+
+	// Whoever redeems the code is linked to this account, so it is only sent in a private chat
+	if !message.Chat.IsPrivate() {
+		link := fmt.Sprintf("https://t.me/%s", c.bot.Self.UserName)
+		c.sendMessage(message.Chat.ID, fmt.Sprintf("🔒 [Message me privately](%s) and send /link to get your link code.", link))
+		return
+	}
+
+	code, err := newInviteCode()
+	if err != nil {
+		log.Printf("❌ Failed to generate account link code: %v", err)
+		c.sendMessage(message.Chat.ID, "❌ Failed to create link code")
+		return
+	}
+
+	now := time.Now()
+	err = c.linkStore.CreateAccountLink(&models.PendingAccountLink{
+		Code:           code,
+		PlatformUserID: strconv.FormatInt(message.From.ID, 10),
+		Username:       getUsername(message.From),
+		CreatedAt:      now,
+		ExpiresAt:      now.Add(accountLinkTTL),
+	})
+	if err != nil {
+		log.Printf("❌ Failed to store account link for Telegram user %d: %v", message.From.ID, err)
+		c.sendMessage(message.Chat.ID, "❌ Failed to create link code")
+		return
+	}
+
+	log.Printf("🔗 Account link code created for Telegram user %s", getUsername(message.From))
+	c.sendMessage(message.Chat.ID, fmt.Sprintf("🔗 Run this command in a Discord server with the bridge to link your accounts:\n`/link %s`\n\nThe code expires in 10 minutes. Do not share it, whoever uses it is linked to your Telegram account.", code))
+}
@@ -0,0 +1,41 @@
+package telegram
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"dcbot/internal/types"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// locationSharedContent is the text of a bridged location message
+const locationSharedContent = "📍 Location shared"
+
+// handleLocation bridges a shared location with its coordinates
+func (c *Client) handleLocation(message *tgbotapi.Message, userID, username string) {
+	location := message.Location
+	bridgeMessage := &types.BridgeMessage{
+		ID:              fmt.Sprintf("telegram_%d_%d", message.Chat.ID, message.MessageID),
+		SourcePlatform:  types.PlatformTelegram,
+		SourceChannelID: strconv.FormatInt(message.Chat.ID, 10),
+		SourceUserID:    userID,
+		Username:        username,
+		Content:         locationSharedContent,
+		MessageType:     types.MessageTypeLocation,
+		Timestamp:       message.Time(),
+		SourceMessageID: strconv.Itoa(message.MessageID),
+		Location: &types.Location{
+			Latitude:   location.Latitude,
+			Longitude:  location.Longitude,
+			LivePeriod: location.LivePeriod,
+		},
+	}
+	c.setTopic(bridgeMessage, message)
+	setForwardOrigin(bridgeMessage, message)
+
+	log.Printf("📍 Telegram location from %s: %f, %f", username, location.Latitude, location.Longitude)
+	if err := c.bridgeMessageHandler(bridgeMessage); err != nil {
+		log.Printf("❌ Failed to bridge Telegram location: %v", err)
+	}
+}
@@ -0,0 +1,30 @@
+package telegram
+
+import (
+	"log"
+	"strconv"
+
+	"dcbot/internal/types"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// handleChatMigration follows the monitored group to its new chat ID after it was upgraded to a supergroup
+func (c *Client) handleChatMigration(message *tgbotapi.Message) {
+	if message.MigrateFromChatID != c.chatID {
+		log.Printf("⏭️ Ignoring migration of unmonitored chat %d to %d", message.MigrateFromChatID, message.Chat.ID)
+		return
+	}
+
+	c.chatID = message.Chat.ID
+	log.Printf("🔀 Telegram group %d was upgraded to supergroup %d, update TELEGRAM_CHAT_ID to keep it after a restart", message.MigrateFromChatID, message.Chat.ID)
+
+	if c.bridgeCore == nil {
+		return
+	}
+
+	oldChatID := strconv.FormatInt(message.MigrateFromChatID, 10)
+	newChatID := strconv.FormatInt(message.Chat.ID, 10)
+	if err := c.bridgeCore.MigratePlatformChannel(types.PlatformTelegram, oldChatID, newChatID); err != nil {
+		log.Printf("❌ Failed to migrate bridges of Telegram chat %s: %v", oldChatID, err)
+	}
+}
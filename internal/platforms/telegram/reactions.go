@@ -0,0 +1,68 @@
+package telegram
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// reactionsJSON lists the emoji Telegram accepts as message reactions
+//
+//go:embed reactions.json
+var reactionsJSON []byte
+
+var (
+	supportedReactions     map[string]bool
+	supportedReactionsOnce sync.Once
+)
+
+// normalizeReaction strips emoji variation selectors, which Telegram's reaction list omits
+func normalizeReaction(emoji string) string {
+	return strings.ReplaceAll(emoji, "\ufe0f", "")
+}
+
+// IsSupportedReaction reports whether Telegram accepts the emoji as a message reaction
+func IsSupportedReaction(emoji string) bool {
+	supportedReactionsOnce.Do(func() {
+		var emojis []string
+		if err := json.Unmarshal(reactionsJSON, &emojis); err != nil {
+			log.Printf("❌ Failed to load Telegram reaction emoji: %v", err)
+		}
+		supportedReactions = make(map[string]bool, len(emojis))
+		for _, e := range emojis {
+			supportedReactions[e] = true
+		}
+	})
+	return supportedReactions[normalizeReaction(emoji)]
+}
+
+// SetMessageReaction sets the bot's reaction on a message.
+// The library does not expose setMessageReaction (Bot API 7.0), so the request is made directly.
+func (c *Client) SetMessageReaction(chatID string, messageID int, emoji string) error {
+	if !IsSupportedReaction(emoji) {
+		return fmt.Errorf("emoji %s is not a supported Telegram reaction", emoji)
+	}
+
+	reaction, err := json.Marshal([]map[string]string{
+		{"type": "emoji", "emoji": normalizeReaction(emoji)},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal reaction: %v", err)
+	}
+
+	params := tgbotapi.Params{
+		"chat_id":    chatID,
+		"message_id": strconv.Itoa(messageID),
+		"reaction":   string(reaction),
+	}
+	if _, err := c.bot.MakeRequest("setMessageReaction", params); err != nil {
+		return fmt.Errorf("failed to set Telegram reaction: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package telegram
+
+import (
+	"fmt"
+	"log"
+
+	"dcbot/internal/types"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// SentMessageStore remembers the messages sent by the bot so they are never bridged back
+type SentMessageStore interface {
+	RecordBotSentMessage(platform, messageID string) error
+	IsBotSentMessage(platform, messageID string) (bool, error)
+}
+
+// SetSentMessageStore sets where messages sent by the bot are recorded
+func (c *Client) SetSentMessageStore(store SentMessageStore) {
+	c.sentStore = store
+}
+
+// sentMessageKey identifies a Telegram message; message IDs are only unique within a chat
+func sentMessageKey(chatID int64, messageID int) string {
+	return fmt.Sprintf("%d_%d", chatID, messageID)
+}
+
+// recordSent remembers a message the bot has sent
+func (c *Client) recordSent(message tgbotapi.Message) {
+	if c.sentStore == nil || message.Chat == nil {
+		return
+	}
+
+	if err := c.sentStore.RecordBotSentMessage(types.PlatformTelegram, sentMessageKey(message.Chat.ID, message.MessageID)); err != nil {
+		log.Printf("⚠️ Failed to record sent Telegram message %d: %v", message.MessageID, err)
+	}
+}
+
+// isBotSent reports whether a received message was sent by the bot itself
+func (c *Client) isBotSent(message *tgbotapi.Message) bool {
+	if c.sentStore == nil {
+		return false
+	}
+
+	sent, err := c.sentStore.IsBotSentMessage(types.PlatformTelegram, sentMessageKey(message.Chat.ID, message.MessageID))
+	if err != nil {
+		log.Printf("⚠️ Failed to check Telegram message %d: %v", message.MessageID, err)
+		return false
+	}
+	return sent
+}
@@ -0,0 +1,173 @@
+package telegram
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// Status keyboard callback actions, sent as "action:chatID"
+const (
+	statusActionRefresh = "refresh"
+	statusActionPause   = "pause"
+	statusActionResume  = "resume"
+	statusActionStats   = "stats"
+)
+
+// sendStatus sends the bridge status of a chat with quick action buttons
+func (c *Client) sendStatus(chatID int64) {
+	msg := tgbotapi.NewMessage(chatID, c.statusText(chatID))
+	msg.ReplyMarkup = c.statusKeyboard(chatID)
+
+	sent, err := c.bot.Send(msg)
+	if err != nil {
+		log.Printf("❌ Failed to send Telegram status: %v", err)
+		return
+	}
+	c.recordSent(sent)
+}
+
+// statusText describes the platforms and bridges of a chat
+func (c *Client) statusText(chatID int64) string {
+	text := "🌉 Bridge Status:\n"
+	if c.bridgeCore == nil {
+		text += "• Telegram: ✅ Connected\n"
+		text += "• Discord: ⏳ Checking...\n"
+		return text
+	}
+
+	statusMap := c.bridgeCore.GetPlatformStatus()
+	platforms := make([]string, 0, len(statusMap))
+	for platform := range statusMap {
+		platforms = append(platforms, platform)
+	}
+	sort.Strings(platforms)
+	for _, platform := range platforms {
+		status := "❌ Disconnected"
+		if statusMap[platform] {
+			status = "✅ Connected"
+		}
+		text += fmt.Sprintf("• %s: %s\n", strings.Title(platform), status)
+	}
+
+	bridges := c.bridgeCore.GetBridges(strconv.FormatInt(chatID, 10))
+	if len(bridges) == 0 {
+		text += "\nNo bridges configured for this chat"
+		return text
+	}
+
+	text += "\n🔗 Bridges:\n"
+	for _, bridge := range bridges {
+		state := "▶ active"
+		if !bridge.IsActive {
+			state = "⏸ paused"
+		}
+		label := bridge.TargetChannelID
+		if bridge.Name != "" {
+			label = bridge.Name
+		}
+		text += fmt.Sprintf("• %s %s (%s)\n", strings.Title(bridge.TargetPlatform), label, state)
+	}
+	return text
+}
+
+// statsText shows how many messages this chat bridged since startup
+func (c *Client) statsText(chatID int64) string {
+	if c.bridgeCore == nil {
+		return "📊 Stats are not available"
+	}
+
+	stats := c.bridgeCore.GetChannelStats(strconv.FormatInt(chatID, 10))
+	text := "📊 Bridge Stats (since startup):\n"
+	text += fmt.Sprintf("• Messages sent from this chat: %d\n", stats["sent"])
+	text += fmt.Sprintf("• Messages received in this chat: %d\n", stats["received"])
+	return text
+}
+
+// statusKeyboard builds the status action buttons, offering Resume instead of Pause for paused bridges
+func (c *Client) statusKeyboard(chatID int64) tgbotapi.InlineKeyboardMarkup {
+	target := strconv.FormatInt(chatID, 10)
+
+	pauseButton := tgbotapi.NewInlineKeyboardButtonData("⏸ Pause Bridge", statusActionPause+":"+target)
+	if c.isPaused(chatID) {
+		pauseButton = tgbotapi.NewInlineKeyboardButtonData("▶ Resume Bridge", statusActionResume+":"+target)
+	}
+
+	return tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData("🔄 Refresh", statusActionRefresh+":"+target),
+			pauseButton,
+			tgbotapi.NewInlineKeyboardButtonData("📊 Stats", statusActionStats+":"+target),
+		),
+	)
+}
+
+// isPaused reports whether every bridge of a chat is paused
+func (c *Client) isPaused(chatID int64) bool {
+	if c.bridgeCore == nil {
+		return false
+	}
+
+	bridges := c.bridgeCore.GetBridges(strconv.FormatInt(chatID, 10))
+	for _, bridge := range bridges {
+		if bridge.IsActive {
+			return false
+		}
+	}
+	return len(bridges) > 0
+}
+
+// handleCallbackQuery handles presses on the status keyboard
+func (c *Client) handleCallbackQuery(query *tgbotapi.CallbackQuery) {
+	if query.Message == nil {
+		return
+	}
+
+	action, target, ok := strings.Cut(query.Data, ":")
+	if !ok {
+		return
+	}
+	chatID, err := strconv.ParseInt(target, 10, 64)
+	if err != nil || chatID != query.Message.Chat.ID {
+		log.Printf("⚠️ Ignoring callback for another chat: %s", query.Data)
+		return
+	}
+
+	text := ""
+	switch action {
+	case statusActionRefresh:
+		text = c.statusText(chatID)
+	case statusActionPause, statusActionResume:
+		// Pausing is saved with the bridge, so only admins may do it
+		if !c.isAdmin(query.From) {
+			c.sendMessage(chatID, adminAccessRequired)
+			return
+		}
+		if c.bridgeCore == nil {
+			return
+		}
+		if action == statusActionPause {
+			err = c.bridgeCore.PauseBridge(target)
+		} else {
+			err = c.bridgeCore.ResumeBridge(target)
+		}
+		text = c.statusText(chatID)
+		if err != nil {
+			text += fmt.Sprintf("\n❌ %v", err)
+		}
+	case statusActionStats:
+		text = c.statsText(chatID)
+	default:
+		log.Printf("⚠️ Unknown Telegram callback action: %s", action)
+		return
+	}
+
+	edit := tgbotapi.NewEditMessageTextAndMarkup(chatID, query.Message.MessageID, text, c.statusKeyboard(chatID))
+	if _, err := c.bot.Send(edit); err != nil {
+		log.Printf("❌ Failed to update Telegram status message: %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+package telegram
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"dcbot/internal/types"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// stickerSetTTL is how long sticker sets are served from memory before they are looked up again
+const stickerSetTTL = time.Hour
+
+// cachedStickerSet is a sticker set fetched from Telegram
+type cachedStickerSet struct {
+	set      *tgbotapi.StickerSet
+	loadedAt time.Time
+}
+
+// handleSticker bridges a sticker with its image and the sticker set it belongs to
+func (c *Client) handleSticker(message *tgbotapi.Message, userID, username string) {
+	sticker := message.Sticker
+	bridgeMessage := &types.BridgeMessage{
+		ID:              fmt.Sprintf("telegram_%d_%d", message.Chat.ID, message.MessageID),
+		SourcePlatform:  types.PlatformTelegram,
+		SourceChannelID: strconv.FormatInt(message.Chat.ID, 10),
+		SourceUserID:    userID,
+		Username:        username,
+		Content:         "🎨 " + sticker.Emoji + " Sticker",
+		MessageType:     types.MessageTypeSticker,
+		Timestamp:       message.Time(),
+		SourceMessageID: strconv.Itoa(message.MessageID),
+		Sticker: &types.Sticker{
+			Emoji:   sticker.Emoji,
+			SetName: sticker.SetName,
+		},
+	}
+	c.setTopic(bridgeMessage, message)
+	setForwardOrigin(bridgeMessage, message)
+
+	if set := c.stickerSet(sticker.SetName); set != nil {
+		bridgeMessage.Sticker.SetTitle = set.Title
+	}
+
+	if data := c.stickerImage(sticker); data != nil {
+		bridgeMessage.MediaBytes = data
+		bridgeMessage.MediaMimeType = http.DetectContentType(data)
+		bridgeMessage.MediaFileName = "sticker.webp"
+		if bridgeMessage.MediaMimeType == "image/jpeg" {
+			bridgeMessage.MediaFileName = "sticker.jpg"
+		}
+	}
+
+	log.Printf("🎨 Telegram sticker from %s: %s (set: %s)", username, sticker.Emoji, sticker.SetName)
+	if err := c.bridgeMessageHandler(bridgeMessage); err != nil {
+		log.Printf("❌ Failed to bridge Telegram sticker: %v", err)
+	}
+}
+
+// stickerSet returns a sticker set by name, from the cache while it is fresh, or nil if it cannot be fetched
+func (c *Client) stickerSet(name string) *tgbotapi.StickerSet {
+	if name == "" {
+		return nil
+	}
+
+	c.stickerSetsMu.Lock()
+	defer c.stickerSetsMu.Unlock()
+
+	if cached, ok := c.stickerSets[name]; ok && time.Since(cached.loadedAt) < stickerSetTTL {
+		return cached.set
+	}
+
+	set, err := c.bot.GetStickerSet(tgbotapi.GetStickerSetConfig{Name: name})
+	if err != nil {
+		log.Printf("⚠️ Failed to get Telegram sticker set %s: %v", name, err)
+		return nil
+	}
+
+	c.stickerSets[name] = &cachedStickerSet{set: &set, loadedAt: time.Now()}
+	return &set
+}
+
+// stickerImage downloads a still image of a sticker: the sticker itself when it is a static WebP image,
+// otherwise its thumbnail. It returns nil if neither can be downloaded.
+func (c *Client) stickerImage(sticker *tgbotapi.Sticker) []byte {
+	if !sticker.IsAnimated {
+		data, err := c.downloadFile(sticker.FileID)
+		if err != nil {
+			log.Printf("⚠️ Failed to download Telegram sticker: %v", err)
+		} else if http.DetectContentType(data) == "image/webp" {
+			return data
+		}
+	}
+
+	// Animated (TGS) and video (WebM) stickers cannot be shown on Discord, use their thumbnail instead
+	if sticker.Thumbnail == nil {
+		return nil
+	}
+	data, err := c.downloadFile(sticker.Thumbnail.FileID)
+	if err != nil {
+		log.Printf("⚠️ Failed to download Telegram sticker thumbnail: %v", err)
+		return nil
+	}
+	return data
+}
@@ -0,0 +1,73 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// ErrMediaTooLarge is returned by LimitedReader once a stream exceeds its size limit
+var ErrMediaTooLarge = errors.New("media exceeds the maximum size")
+
+// streamDownloadTimeout bounds a whole streamed download, including the upload reading from it
+const streamDownloadTimeout = 5 * time.Minute
+
+// LimitedReader reads from an underlying reader and fails with ErrMediaTooLarge after more than limit bytes
+type LimitedReader struct {
+	reader io.Reader
+	limit  int64 // Zero or negative means unlimited
+	read   int64
+}
+
+// NewLimitedReader creates a LimitedReader that allows at most limit bytes to be read from reader
+func NewLimitedReader(reader io.Reader, limit int64) *LimitedReader {
+	return &LimitedReader{reader: reader, limit: limit}
+}
+
+// Read reads from the underlying reader, returning ErrMediaTooLarge once the limit is exceeded
+func (l *LimitedReader) Read(p []byte) (int, error) {
+	if l.limit > 0 && l.read > l.limit {
+		return 0, ErrMediaTooLarge
+	}
+
+	n, err := l.reader.Read(p)
+	l.read += int64(n)
+	if l.limit > 0 && l.read > l.limit {
+		return n, ErrMediaTooLarge
+	}
+	return n, err
+}
+
+// streamFile returns a function opening a Telegram file as a stream. Each call starts a new download
+// that is piped to the reader as it arrives, so the file is never held in memory as a whole.
+func (c *Client) streamFile(fileID string) func() (io.ReadCloser, error) {
+	return func() (io.ReadCloser, error) {
+		fileURL, err := c.bot.GetFileDirectURL(fileID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get file URL: %v", err)
+		}
+
+		reader, writer := io.Pipe()
+		go func() {
+			httpClient := &http.Client{Timeout: streamDownloadTimeout}
+			resp, err := httpClient.Get(fileURL)
+			if err != nil {
+				// The URL contains the bot token, so it is not part of the error
+				writer.CloseWithError(errors.New("failed to download file"))
+				return
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				writer.CloseWithError(fmt.Errorf("file download failed with status: %d", resp.StatusCode))
+				return
+			}
+
+			_, err = io.Copy(writer, NewLimitedReader(resp.Body, c.maxMediaSizeBytes))
+			writer.CloseWithError(err)
+		}()
+		return reader, nil
+	}
+}
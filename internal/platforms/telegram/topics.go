@@ -0,0 +1,360 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"dcbot/internal/types"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// topicKey identifies a message in a chat
+type topicKey struct {
+	chatID    int64
+	messageID int
+}
+
+// messageTopic is the forum topic a message was posted in
+type messageTopic struct {
+	ID      int
+	Name    string
+	Service bool // The message only creates or renames the topic
+}
+
+// rawTopicUpdate holds the forum topic fields of an update, which tgbotapi does not decode
+type rawTopicUpdate struct {
+	Message *rawTopicMessage `json:"message"`
+}
+
+type rawTopicMessage struct {
+	MessageID         int              `json:"message_id"`
+	MessageThreadID   int              `json:"message_thread_id"`
+	IsTopicMessage    bool             `json:"is_topic_message"`
+	ForumTopicCreated *rawForumTopic   `json:"forum_topic_created"`
+	ForumTopicEdited  *rawForumTopic   `json:"forum_topic_edited"`
+	ReplyToMessage    *rawTopicMessage `json:"reply_to_message"`
+	Chat              struct {
+		ID int64 `json:"id"`
+	} `json:"chat"`
+}
+
+type rawForumTopic struct {
+	Name string `json:"name"`
+}
+
+// getUpdatesChan polls for updates like tgbotapi's GetUpdatesChan, also recording the forum topic of each message
+func (c *Client) getUpdatesChan(config tgbotapi.UpdateConfig, stop <-chan struct{}) tgbotapi.UpdatesChannel {
+	ch := make(chan tgbotapi.Update, c.bot.Buffer)
+
+	go func() {
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+
+			updates, err := c.getUpdates(config)
+			if err != nil {
+				log.Printf("❌ Failed to get Telegram updates, retrying in 3 seconds: %v", err)
+				time.Sleep(3 * time.Second)
+				continue
+			}
+
+			for _, update := range updates {
+				if update.UpdateID >= config.Offset {
+					config.Offset = update.UpdateID + 1
+					select {
+					case ch <- update:
+					case <-stop:
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return ch
+}
+
+// getUpdates fetches a batch of updates and records the forum topics of their messages
+func (c *Client) getUpdates(config tgbotapi.UpdateConfig) ([]tgbotapi.Update, error) {
+	params := make(tgbotapi.Params)
+	params.AddNonZero("offset", config.Offset)
+	params.AddNonZero("limit", config.Limit)
+	params.AddNonZero("timeout", config.Timeout)
+
+	resp, err := c.bot.MakeRequest("getUpdates", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var updates []tgbotapi.Update
+	if err := json.Unmarshal(resp.Result, &updates); err != nil {
+		return nil, fmt.Errorf("failed to decode updates: %v", err)
+	}
+
+	var topicUpdates []rawTopicUpdate
+	if err := json.Unmarshal(resp.Result, &topicUpdates); err != nil {
+		log.Printf("⚠️ Failed to decode Telegram forum topics: %v", err)
+		return updates, nil
+	}
+	for _, update := range topicUpdates {
+		if update.Message != nil {
+			c.recordTopic(update.Message)
+		}
+	}
+
+	return updates, nil
+}
+
+// recordTopic remembers the forum topic of a message and the topic's name
+func (c *Client) recordTopic(message *rawTopicMessage) {
+	if !message.IsTopicMessage || message.MessageThreadID == 0 {
+		return
+	}
+
+	c.topicsMu.Lock()
+	defer c.topicsMu.Unlock()
+
+	topic := messageTopic{ID: message.MessageThreadID}
+	switch {
+	case message.ForumTopicCreated != nil:
+		c.topicNames[topic.ID] = message.ForumTopicCreated.Name
+		topic.Service = true
+	case message.ForumTopicEdited != nil:
+		if message.ForumTopicEdited.Name != "" {
+			c.topicNames[topic.ID] = message.ForumTopicEdited.Name
+		}
+		topic.Service = true
+	case message.ReplyToMessage != nil && message.ReplyToMessage.ForumTopicCreated != nil:
+		// Messages that are not replies point at the message that created their topic
+		c.topicNames[topic.ID] = message.ReplyToMessage.ForumTopicCreated.Name
+	}
+
+	topic.Name = c.topicNames[topic.ID]
+	if topic.Name == "" {
+		topic.Name = fmt.Sprintf("Topic %d", topic.ID)
+	}
+	c.messageTopics[topicKey{message.Chat.ID, message.MessageID}] = topic
+}
+
+// messageTopic returns the forum topic of a received message, zero if it was not posted in one
+func (c *Client) messageTopic(message *tgbotapi.Message) messageTopic {
+	c.topicsMu.Lock()
+	defer c.topicsMu.Unlock()
+	return c.messageTopics[topicKey{message.Chat.ID, message.MessageID}]
+}
+
+// forgetTopic drops the recorded forum topic of a handled message
+func (c *Client) forgetTopic(message *tgbotapi.Message) {
+	c.topicsMu.Lock()
+	defer c.topicsMu.Unlock()
+	delete(c.messageTopics, topicKey{message.Chat.ID, message.MessageID})
+}
+
+// setTopic adds the forum topic of a message to the bridge message built from it
+func (c *Client) setTopic(bridgeMessage *types.BridgeMessage, message *tgbotapi.Message) {
+	topic := c.messageTopic(message)
+	bridgeMessage.TopicID = topic.ID
+	bridgeMessage.TopicName = topic.Name
+}
+
+// handleTopicText bridges a text message posted in a forum topic, keeping the topic so it reaches the matching thread.
+// Forwarded text messages outside topics are bridged the same way to keep their origin.
+func (c *Client) handleTopicText(message *tgbotapi.Message, userID, username string) {
+	bridgeMessage := &types.BridgeMessage{
+		ID:              fmt.Sprintf("telegram_%d_%d", message.Chat.ID, message.MessageID),
+		SourcePlatform:  types.PlatformTelegram,
+		SourceChannelID: strconv.FormatInt(message.Chat.ID, 10),
+		SourceUserID:    userID,
+		Username:        username,
+		Content:         message.Text,
+		MessageType:     types.MessageTypeText,
+		Timestamp:       message.Time(),
+		SourceMessageID: strconv.Itoa(message.MessageID),
+	}
+	c.setTopic(bridgeMessage, message)
+	setForwardOrigin(bridgeMessage, message)
+
+	log.Printf("🧵 Telegram message from %s in topic %q: %s", username, bridgeMessage.TopicName, message.Text)
+	if err := c.bridgeMessageHandler(bridgeMessage); err != nil {
+		log.Printf("❌ Failed to bridge Telegram topic message: %v", err)
+	}
+}
+
+// IsForum reports whether a chat is a supergroup with forum topics enabled
+func (c *Client) IsForum(chatID int64) (bool, error) {
+	params := make(tgbotapi.Params)
+	params.AddNonZero64("chat_id", chatID)
+
+	resp, err := c.bot.MakeRequest("getChat", params)
+	if err != nil {
+		return false, fmt.Errorf("failed to get chat info: %v", err)
+	}
+
+	var chat struct {
+		IsForum bool `json:"is_forum"`
+	}
+	if err := json.Unmarshal(resp.Result, &chat); err != nil {
+		return false, fmt.Errorf("failed to decode chat info: %v", err)
+	}
+	return chat.IsForum, nil
+}
+
+// CreateForumTopic creates a topic in a forum supergroup and returns its thread ID
+func (c *Client) CreateForumTopic(chatID int64, name string) (int, error) {
+	params := make(tgbotapi.Params)
+	params.AddNonZero64("chat_id", chatID)
+	params.AddNonEmpty("name", name)
+
+	resp, err := c.bot.MakeRequest("createForumTopic", params)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create forum topic: %v", err)
+	}
+
+	var topic struct {
+		MessageThreadID int `json:"message_thread_id"`
+	}
+	if err := json.Unmarshal(resp.Result, &topic); err != nil {
+		return 0, fmt.Errorf("failed to decode forum topic: %v", err)
+	}
+	return topic.MessageThreadID, nil
+}
+
+// SendTopicMessage sends a Markdown message into a forum topic and returns its Telegram message ID
+func (c *Client) SendTopicMessage(chatID string, topicID int, message string, silent bool) (int, error) {
+	id, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid chat ID: %v", err)
+	}
+
+	params := make(tgbotapi.Params)
+	params.AddNonZero64("chat_id", id)
+	params.AddNonZero("message_thread_id", topicID)
+	params["text"] = message
+	params["parse_mode"] = tgbotapi.ModeMarkdown
+	params.AddBool("disable_notification", silent)
+
+	resp, err := c.bot.MakeRequest("sendMessage", params)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send Telegram topic message: %v", err)
+	}
+	return c.recordSentResult(resp), nil
+}
+
+// SendTopicPhoto sends a photo with a Markdown caption into a forum topic
+func (c *Client) SendTopicPhoto(chatID string, topicID int, caption string, data []byte, silent bool) error {
+	id, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid chat ID: %v", err)
+	}
+
+	params := make(tgbotapi.Params)
+	params.AddNonZero64("chat_id", id)
+	params.AddNonZero("message_thread_id", topicID)
+	params.AddNonEmpty("caption", caption)
+	params["parse_mode"] = tgbotapi.ModeMarkdown
+	params.AddBool("disable_notification", silent)
+
+	resp, err := c.bot.UploadFiles("sendPhoto", params, []tgbotapi.RequestFile{
+		{Name: "photo", Data: tgbotapi.FileBytes{Name: "photo.jpg", Bytes: data}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send Telegram topic photo: %v", err)
+	}
+	c.recordSentResult(resp)
+
+	log.Printf("✅ Photo sent to Telegram chat %d topic %d", id, topicID)
+	return nil
+}
+
+// SendTopicDocument sends a file with a Markdown caption into a forum topic, or the chat itself if topicID is zero
+func (c *Client) SendTopicDocument(chatID string, topicID int, caption, fileName string, data []byte, silent bool) error {
+	id, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid chat ID: %v", err)
+	}
+	if fileName == "" {
+		fileName = "file"
+	}
+
+	params := make(tgbotapi.Params)
+	params.AddNonZero64("chat_id", id)
+	params.AddNonZero("message_thread_id", topicID)
+	params.AddNonEmpty("caption", caption)
+	params["parse_mode"] = tgbotapi.ModeMarkdown
+	params.AddBool("disable_notification", silent)
+
+	resp, err := c.bot.UploadFiles("sendDocument", params, []tgbotapi.RequestFile{
+		{Name: "document", Data: tgbotapi.FileBytes{Name: fileName, Bytes: data}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send Telegram document: %v", err)
+	}
+	c.recordSentResult(resp)
+
+	log.Printf("✅ Document sent to Telegram chat %d topic %d", id, topicID)
+	return nil
+}
+
+// SendMediaGroup sends photos as one album into a forum topic, or the chat itself if topicID is zero, with a Markdown caption on the first
+func (c *Client) SendMediaGroup(chatID string, topicID int, caption string, photos [][]byte, silent bool) error {
+	id, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid chat ID: %v", err)
+	}
+
+	media := make([]interface{}, 0, len(photos))
+	files := make([]tgbotapi.RequestFile, 0, len(photos))
+	for i, data := range photos {
+		name := fmt.Sprintf("file-%d", i)
+		// The photos are uploaded alongside the request and referenced by their attach:// name
+		photo := tgbotapi.NewInputMediaPhoto(tgbotapi.FileID("attach://" + name))
+		if i == 0 {
+			photo.Caption = caption
+			photo.ParseMode = tgbotapi.ModeMarkdown
+		}
+		media = append(media, photo)
+		files = append(files, tgbotapi.RequestFile{Name: name, Data: tgbotapi.FileBytes{Name: "photo.jpg", Bytes: data}})
+	}
+
+	params := make(tgbotapi.Params)
+	params.AddNonZero64("chat_id", id)
+	params.AddNonZero("message_thread_id", topicID)
+	params.AddBool("disable_notification", silent)
+	if err := params.AddInterface("media", media); err != nil {
+		return fmt.Errorf("failed to encode Telegram media group: %v", err)
+	}
+
+	resp, err := c.bot.UploadFiles("sendMediaGroup", params, files)
+	if err != nil {
+		return fmt.Errorf("failed to send Telegram media group: %v", err)
+	}
+
+	var sent []tgbotapi.Message
+	if err := json.Unmarshal(resp.Result, &sent); err != nil {
+		log.Printf("⚠️ Failed to decode sent Telegram media group: %v", err)
+	}
+	for _, message := range sent {
+		c.recordSent(message)
+	}
+
+	log.Printf("✅ Media group of %d photos sent to Telegram chat %d", len(photos), id)
+	return nil
+}
+
+// recordSentResult records the message returned by a raw send request and returns its ID
+func (c *Client) recordSentResult(resp *tgbotapi.APIResponse) int {
+	var sent tgbotapi.Message
+	if err := json.Unmarshal(resp.Result, &sent); err != nil {
+		log.Printf("⚠️ Failed to decode sent Telegram message: %v", err)
+		return 0
+	}
+	c.recordSent(sent)
+	return sent.MessageID
+}
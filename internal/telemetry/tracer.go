@@ -0,0 +1,62 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// shutdownTimeout bounds flushing the remaining spans on shutdown
+const shutdownTimeout = 10 * time.Second
+
+// InitTracer installs a global tracer provider that batches spans to the OTLP/HTTP collector at exporterURL and returns a shutdown function that flushes them
+func InitTracer(serviceName, exporterURL string) (func(), error) {
+	endpoint, err := tracesEndpoint(exporterURL)
+	if err != nil {
+		return nil, err
+	}
+
+	exporter, err := otlptracehttp.New(context.Background(), otlptracehttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %v", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	log.Printf("🔭 Tracing enabled, exporting spans to %s", endpoint)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+			defer cancel()
+			if err := provider.Shutdown(ctx); err != nil {
+				log.Printf("⚠️ Failed to flush trace spans: %v", err)
+			}
+		})
+	}, nil
+}
+
+// tracesEndpoint appends the OTLP traces path to a collector URL without one
+func tracesEndpoint(exporterURL string) (string, error) {
+	u, err := url.Parse(exporterURL)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", fmt.Errorf("invalid OTLP endpoint %q", exporterURL)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = "/v1/traces"
+	}
+	return u.String(), nil
+}
@@ -0,0 +1,75 @@
+package transformers
+
+import (
+	_ "embed"
+	"encoding/json"
+	"log"
+	"regexp"
+
+	"dcbot/internal/types"
+)
+
+// EmojiNormalizerName is the name of the built-in emoji normalizer transformer
+const EmojiNormalizerName = "emoji_normalizer"
+
+//go:embed emoji_map.json
+var emojiMapJSON []byte
+
+// discordEmojiPattern matches Discord custom emojis like <:name:123> and <a:name:123>
+var discordEmojiPattern = regexp.MustCompile(`<(a?):(\w+):\d+>`)
+
+// shortcodePattern matches :shortcode: style emojis
+var shortcodePattern = regexp.MustCompile(`:([\w+-]+):`)
+
+// EmojiNormalizerTransformer converts platform-specific emoji codes into text the other platform can display
+type EmojiNormalizerTransformer struct {
+	shortcodes map[string]string // shortcode -> Unicode emoji
+}
+
+// NewEmojiNormalizerTransformer creates an emoji normalizer using the bundled shortcode map
+func NewEmojiNormalizerTransformer() *EmojiNormalizerTransformer {
+	shortcodes := make(map[string]string)
+	if err := json.Unmarshal(emojiMapJSON, &shortcodes); err != nil {
+		log.Printf("⚠️ Failed to load emoji map: %v", err)
+	}
+
+	return &EmojiNormalizerTransformer{shortcodes: shortcodes}
+}
+
+// Name returns the transformer name
+func (t *EmojiNormalizerTransformer) Name() string {
+	return EmojiNormalizerName
+}
+
+// Transform normalizes emojis based on the platform the message comes from
+func (t *EmojiNormalizerTransformer) Transform(message *types.BridgeMessage) error {
+	switch message.SourcePlatform {
+	case types.PlatformDiscord:
+		message.Content = t.normalizeDiscordEmojis(message.Content)
+	case types.PlatformTelegram:
+		message.Content = t.normalizeShortcodes(message.Content)
+	}
+	return nil
+}
+
+// normalizeDiscordEmojis replaces custom emojis with :name: and animated ones with [animated: name]
+func (t *EmojiNormalizerTransformer) normalizeDiscordEmojis(content string) string {
+	return discordEmojiPattern.ReplaceAllStringFunc(content, func(match string) string {
+		parts := discordEmojiPattern.FindStringSubmatch(match)
+		if parts[1] == "a" {
+			return "[animated: " + parts[2] + "]"
+		}
+		return ":" + parts[2] + ":"
+	})
+}
+
+// normalizeShortcodes replaces known :shortcode: patterns with Unicode, leaving unknown ones verbatim
+func (t *EmojiNormalizerTransformer) normalizeShortcodes(content string) string {
+	return shortcodePattern.ReplaceAllStringFunc(content, func(match string) string {
+		name := shortcodePattern.FindStringSubmatch(match)[1]
+		if emoji, ok := t.shortcodes[name]; ok {
+			return emoji
+		}
+		return match
+	})
+}
@@ -0,0 +1,54 @@
+package transformers
+
+import (
+	"testing"
+
+	"dcbot/internal/types"
+)
+
+func TestEmojiNormalizerTransform(t *testing.T) {
+	tests := []struct {
+		name           string
+		sourcePlatform string
+		content        string
+		want           string
+	}{
+		{
+			name:           "discord custom emoji",
+			sourcePlatform: types.PlatformDiscord,
+			content:        "nice <:pepega:123456789> work",
+			want:           "nice :pepega: work",
+		},
+		{
+			name:           "discord animated emoji",
+			sourcePlatform: types.PlatformDiscord,
+			content:        "party <a:dance:987654321>",
+			want:           "party [animated: dance]",
+		},
+		{
+			name:           "telegram known shortcode",
+			sourcePlatform: types.PlatformTelegram,
+			content:        "hello :smile: :thumbsup:",
+			want:           "hello 😄 👍",
+		},
+		{
+			name:           "telegram unknown shortcode",
+			sourcePlatform: types.PlatformTelegram,
+			content:        "see :not_an_emoji: here",
+			want:           "see :not_an_emoji: here",
+		},
+	}
+
+	transformer := NewEmojiNormalizerTransformer()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := &types.BridgeMessage{SourcePlatform: tt.sourcePlatform, Content: tt.content}
+			if err := transformer.Transform(message); err != nil {
+				t.Fatalf("Transform returned error: %v", err)
+			}
+			if message.Content != tt.want {
+				t.Errorf("Transform(%q) = %q, want %q", tt.content, message.Content, tt.want)
+			}
+		})
+	}
+}
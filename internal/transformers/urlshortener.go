@@ -0,0 +1,108 @@
+package transformers
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"dcbot/internal/cache"
+	"dcbot/internal/types"
+)
+
+// Built-in URL shortener services
+const (
+	ShortenerIsGd    = "is.gd"
+	ShortenerTinyURL = "tinyurl.com"
+)
+
+// urlPattern matches http(s) URLs in message content
+var urlPattern = regexp.MustCompile(`https?://[^\s]+`)
+
+// URLShortenerTransformer replaces long URLs in bridged messages with shortened ones
+type URLShortenerTransformer struct {
+	apiURL     string // Endpoint the escaped long URL is appended to
+	minLength  int
+	httpClient *http.Client
+	cache      *cache.LRU[string, string] // original URL -> short URL
+}
+
+// NewURLShortenerTransformer creates a URL shortener transformer.
+// service is "is.gd", "tinyurl.com" or a custom endpoint URL that returns the
+// short URL as plain text when the escaped long URL is appended to it.
+func NewURLShortenerTransformer(service string, minLength int) *URLShortenerTransformer {
+	var apiURL string
+	switch service {
+	case "", ShortenerIsGd:
+		apiURL = "https://is.gd/create.php?format=simple&url="
+	case ShortenerTinyURL:
+		apiURL = "https://tinyurl.com/api-create.php?url="
+	default:
+		apiURL = service
+	}
+
+	return &URLShortenerTransformer{
+		apiURL:     apiURL,
+		minLength:  minLength,
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+		cache:      cache.NewLRU[string, string](1000, time.Hour),
+	}
+}
+
+// Name returns the transformer name
+func (t *URLShortenerTransformer) Name() string {
+	return "url_shortener"
+}
+
+// Transform replaces URLs longer than the minimum length with shortened versions
+func (t *URLShortenerTransformer) Transform(message *types.BridgeMessage) error {
+	message.Content = urlPattern.ReplaceAllStringFunc(message.Content, func(longURL string) string {
+		if len(longURL) < t.minLength {
+			return longURL
+		}
+
+		if shortURL, ok := t.cache.Get(longURL); ok {
+			return shortURL
+		}
+
+		shortURL, err := t.shorten(longURL)
+		if err != nil {
+			// Leave the original URL if the shortener is unavailable
+			log.Printf("⚠️ Failed to shorten URL: %v", err)
+			return longURL
+		}
+
+		t.cache.Add(longURL, shortURL)
+		return shortURL
+	})
+	return nil
+}
+
+// shorten calls the shortener API for a single URL
+func (t *URLShortenerTransformer) shorten(longURL string) (string, error) {
+	resp, err := t.httpClient.Get(t.apiURL + url.QueryEscape(longURL))
+	if err != nil {
+		return "", fmt.Errorf("shortener request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
+		return "", fmt.Errorf("failed to read shortener response: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("shortener returned status %d", resp.StatusCode)
+	}
+
+	shortURL := strings.TrimSpace(string(body))
+	if !strings.HasPrefix(shortURL, "http") {
+		return "", fmt.Errorf("unexpected shortener response: %s", shortURL)
+	}
+
+	return shortURL, nil
+}
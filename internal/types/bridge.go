@@ -1,49 +1,197 @@
 package types
 
-import "time"
+import (
+	"context"
+	"io"
+	"time"
+
+	"dcbot/internal/database/models"
+)
 
 // Platform constants
 const (
-	PlatformDiscord  = "discord"
-	PlatformTelegram = "telegram"
+	PlatformDiscord        = "discord"
+	PlatformTelegram       = "telegram"
+	PlatformDiscordWebhook = "discord_webhook" // Discord channel reached through an incoming webhook, without the bot
 )
 
 // MessageType constants
 const (
-	MessageTypeText  = "text"
-	MessageTypeImage = "image"
-	MessageTypeFile  = "file"
+	MessageTypeText          = "text"
+	MessageTypeImage         = "image"
+	MessageTypeVideo         = "video"
+	MessageTypeAudio         = "audio"
+	MessageTypeFile          = "file"
+	MessageTypeEvent         = "event"
+	MessageTypeVoiceEvent    = "voice_event"
+	MessageTypeVoiceActivity = "voice_activity" // A Discord voice chat started or ended
+	MessageTypePin           = "pin"            // A Telegram message was pinned
+	MessageTypeSystem        = "system"         // A Telegram group or Discord channel was renamed
+	MessageTypeSticker       = "sticker"
+	MessageTypeVoice         = "voice"
+	MessageTypePoll          = "poll"
+	MessageTypeLocation      = "location"
+)
+
+// AllowableMessageTypes are the message types a bridge can restrict itself to, in display order
+var AllowableMessageTypes = []string{
+	MessageTypeText, MessageTypeImage, MessageTypeVideo, MessageTypeAudio, MessageTypeFile, MessageTypeSticker,
+	MessageTypeVoice, MessageTypePoll, MessageTypeLocation, MessageTypeEvent, MessageTypeVoiceEvent, MessageTypeVoiceActivity,
+	MessageTypePin, MessageTypeSystem,
+}
+
+// Bridge priority bounds
+const (
+	MinBridgePriority = 0
+	MaxBridgePriority = 10
 )
 
 // BridgeMessage represents a message that needs to be bridged
 type BridgeMessage struct {
-	ID              string    `json:"id"`
-	SourcePlatform  string    `json:"source_platform"`
-	SourceChannelID string    `json:"source_channel_id"`
-	SourceUserID    string    `json:"source_user_id"`
-	Username        string    `json:"username"`
-	Content         string    `json:"content"`
-	MessageType     string    `json:"message_type"`
-	Timestamp       time.Time `json:"timestamp"`
-	Attachments     []string  `json:"attachments,omitempty"`
+	ID                     string                        `json:"id"`
+	SourcePlatform         string                        `json:"source_platform"`
+	SourceChannelID        string                        `json:"source_channel_id"`
+	SourceUserID           string                        `json:"source_user_id"`
+	Username               string                        `json:"username"`
+	Content                string                        `json:"content"`
+	MessageType            string                        `json:"message_type"`
+	Timestamp              time.Time                     `json:"timestamp"`
+	Attachments            []string                      `json:"attachments,omitempty"`
+	MediaBytes             []byte                        `json:"-"` // Raw media attached to the message (e.g. a new group photo)
+	MediaStream            func() (io.ReadCloser, error) `json:"-"` // Opens the media as a stream, used instead of MediaBytes for large files
+	MediaMimeType          string                        `json:"media_mime_type,omitempty"`
+	MediaFileName          string                        `json:"media_file_name,omitempty"`
+	MediaGroup             []MediaFile                   `json:"-"`                                   // All images of a message with several, sent together as an album
+	Location               *Location                     `json:"location,omitempty"`                  // Shared location of a location message
+	Sticker                *Sticker                      `json:"sticker,omitempty"`                   // Sticker of a sticker message, its image is in MediaBytes
+	SourceMessageID        string                        `json:"source_message_id,omitempty"`         // Message ID on the source platform
+	TopicID                int                           `json:"topic_id,omitempty"`                  // Telegram forum topic the message was posted in
+	TopicName              string                        `json:"topic_name,omitempty"`                // Name of the Telegram topic or Discord forum post
+	SourceThreadID         string                        `json:"source_thread_id,omitempty"`          // Discord forum post the message was posted in
+	PinnedMessageID        string                        `json:"pinned_message_id,omitempty"`         // Source platform ID of the message a pin notice is about
+	ForwardedFrom          string                        `json:"forwarded_from,omitempty"`            // Username of the user a Telegram message was forwarded from
+	ForwardedFromChatTitle string                        `json:"forwarded_from_chat_title,omitempty"` // Title of the channel a Telegram message was forwarded from
+	ForwardedFromURL       string                        `json:"forwarded_from_url,omitempty"`        // Link to the original message, if it can be opened
+}
+
+// Location is a point on the map shared in a message
+type Location struct {
+	Latitude   float64 `json:"latitude"`
+	Longitude  float64 `json:"longitude"`
+	LivePeriod int     `json:"live_period,omitempty"` // Seconds a live location is updated for, 0 for a static location
+}
+
+// Sticker describes a sticker and the sticker set it belongs to
+type Sticker struct {
+	Emoji    string `json:"emoji,omitempty"`
+	SetName  string `json:"set_name,omitempty"`
+	SetTitle string `json:"set_title,omitempty"` // Empty if the sticker set could not be looked up
+}
+
+// MediaFile is one file of a message carrying several
+type MediaFile struct {
+	Bytes    []byte
+	MimeType string
+	FileName string
 }
 
 // BridgeConnection represents a bridge between two platforms
 type BridgeConnection struct {
 	ID              string    `json:"id"`
+	Name            string    `json:"name,omitempty"`
 	SourcePlatform  string    `json:"source_platform"`
 	SourceChannelID string    `json:"source_channel_id"`
 	TargetPlatform  string    `json:"target_platform"`
 	TargetChannelID string    `json:"target_channel_id"`
+	GuildID         string    `json:"guild_id,omitempty"` // Discord server of the target channel
+	Priority        int       `json:"priority"`           // Delivery order under load (0-10), higher first
 	IsActive        bool      `json:"is_active"`
 	CreatedAt       time.Time `json:"created_at"`
 }
 
+// BridgeExportVersion is the version of the bridge export format written by this build
+const BridgeExportVersion = 1
+
+// BridgeExport is a portable copy of all bridges and their configuration, importable into another instance
+type BridgeExport struct {
+	Version     int              `json:"version"`
+	ExportedAt  time.Time        `json:"exported_at"`
+	Connections []ExportedBridge `json:"connections"`
+}
+
+// ExportedBridge is a bidirectional bridge in a BridgeExport
+type ExportedBridge struct {
+	SourcePlatform  string               `json:"source_platform"`
+	SourceChannelID string               `json:"source_channel_id"`
+	TargetPlatform  string               `json:"target_platform"`
+	TargetChannelID string               `json:"target_channel_id"`
+	Config          *models.BridgeConfig `json:"config,omitempty"` // Omitted when the bridge has no stored configuration
+}
+
+// LeaderboardEntry is a user's rank on a bridge's activity leaderboard
+type LeaderboardEntry struct {
+	Platform     string `json:"platform"`
+	UserID       string `json:"user_id"`
+	Username     string `json:"username"`
+	MessageCount int    `json:"message_count"`
+}
+
+// ChatInfo is the name and size of a Discord server or Telegram chat
+type ChatInfo struct {
+	Title       string `json:"title"`
+	MemberCount int    `json:"member_count"`
+	IsForum     bool   `json:"is_forum,omitempty"`    // Telegram supergroup with forum topics
+	Description string `json:"description,omitempty"` // Telegram group description
+}
+
+// SimulationResult describes what bridging a message would do, without sending it
+type SimulationResult struct {
+	WouldBeFiltered   bool                `json:"would_be_filtered"`
+	FilterReason      string              `json:"filter_reason,omitempty"`
+	WouldRoute        bool                `json:"would_route"`
+	TargetConnections []*BridgeConnection `json:"target_connections"`
+	FormattedMessages map[string]string   `json:"formatted_messages"` // Target platform -> formatted content
+}
+
+// RateLimitSimulation is the outcome of a burst of messages hitting a bridge's rate limit
+type RateLimitSimulation struct {
+	Limit     int           `json:"limit"` // Messages per minute, 0 means unlimited
+	Messages  int           `json:"messages"`
+	Delivered int           `json:"delivered"`
+	Dropped   int           `json:"dropped"`
+	RefillIn  time.Duration `json:"refill_in"` // Time until the bucket is full again
+}
+
+// Event types published on the bridge event bus
+const (
+	EventMessageBridged       = "message_bridged"
+	EventBridgeCreated        = "bridge_created"
+	EventBridgeRemoved        = "bridge_removed"
+	EventPlatformConnected    = "platform_connected"
+	EventPlatformDisconnected = "platform_disconnected"
+)
+
+// Event is a bridge activity notification; IDs increase by one per event
+type Event struct {
+	ID        uint64            `json:"id"`
+	Type      string            `json:"type"`
+	Timestamp time.Time         `json:"timestamp"`
+	Data      map[string]string `json:"data,omitempty"`
+}
+
+// Transformer rewrites a bridge message before it is delivered to target platforms
+type Transformer interface {
+	Name() string
+	Transform(message *BridgeMessage) error
+}
+
 // Platform interface defines methods that each platform must implement
 type Platform interface {
 	GetName() string
 	IsConnected() bool
-	SendMessage(channelID, content string) error
+	Ping(ctx context.Context) error
+	TestCredentials(ctx context.Context) (info string, latency time.Duration, err error)
+	SendMessage(ctx context.Context, channelID, content string) error
 	FormatMessage(message *BridgeMessage) string
 }
 
@@ -52,8 +200,55 @@ type BridgeCore interface {
 	RegisterPlatform(platform Platform)
 	AddBridge(sourcePlatform, sourceChannelID, targetPlatform, targetChannelID string) error
 	RemoveBridge(sourceChannelID, targetPlatform string) error
+	RemoveBridgeByID(connectionID string) error
+	SetBridgeName(connectionID, name string) error
+	SetBridgePriority(connectionID string, priority int) error
 	GetBridges(channelID string) []*BridgeConnection
 	GetPlatformStatus() map[string]bool
 	ProcessMessage(message *BridgeMessage) error
+	SimulateMessage(message *BridgeMessage) *SimulationResult
 	SetUserMapping(platform, userID, displayName string)
+	GetUserMappings(platform string) map[string]string
+	SendSystemMessage(platform, channelID, content string) error
+	DiscordDisconnected()
+	CreatePoll(discordChannelID, question string, options []string, anonymous bool) (*models.Poll, error)
+	GetPoll(id int) (*models.Poll, error)
+	UpdatePollResults(telegramPollID string, votes []int, totalVoters int) error
+	DiscordConnected()
+	GetFilterRules(platform, channelID string) ([]models.FilterRule, error)
+	AddFilterRule(platform, channelID string, rule models.FilterRule) error
+	GetBridgeConfig(platform, channelID string) (*models.BridgeConfig, error)
+	UpdateBridgeConfig(platform, channelID string, config *models.BridgeConfig) error
+	BulkUpdateBridgeConfig(guildID, field, value string) (int, error)
+	SetBridgeEmbedColor(platform, channelID string, color int) error
+	BridgeReaction(channelID, messageID, emoji string, added bool) error
+	PauseBridge(channelID string) error
+	ResumeBridge(channelID string) error
+	GetChannelStats(channelID string) map[string]int
+	GetBridgeStats() map[string]int
+	RecordAudit(entry *models.AuditLog) error
+	GetAuditLog(limit, offset int, platform, action string) ([]*models.AuditLog, error)
+	GetBridgeEvents(bridgeID string, limit int) ([]*models.BridgeEvent, error)
+	GetLeaderboard(connectionID string) ([]*LeaderboardEntry, error)
+	ResetBridgeStats(connectionID string) (int64, error)
+	MessagesPerMinute() int
+	HasFailingBridges() bool
+	MigratePlatformChannel(platform, oldChannelID, newChannelID string) error
+	SyncChannelTopic(platform, channelID, topic string) error
+	SyncAdminRole(guildID, userID, roleID string, granted bool)
+	GetChatInfo(platform, chatID string) (*ChatInfo, error)
+	BridgeForumPost(forumChannelID, threadID, name string)
+	UpdateForumPostTags(threadID, hashtags string) error
+	SimulateRateLimit(platform, channelID string, messages, limit int) (*RateLimitSimulation, error)
+	RedeemBridgeInvite(code string) (*models.PendingBridgeInvite, error)
+	LinkTelegramAccount(code, discordUserID, discordUsername string) (*models.PendingAccountLink, error)
+	TestCredentials(ctx context.Context) []CredentialCheck
+}
+
+// CredentialCheck is the result of verifying the API credentials of a platform
+type CredentialCheck struct {
+	Platform string
+	Info     string // Who the credentials authenticate as
+	Latency  time.Duration
+	Err      error
 }
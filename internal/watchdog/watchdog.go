@@ -0,0 +1,130 @@
+package watchdog
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"dcbot/internal/metrics"
+)
+
+const (
+	maxConsecutiveFailures = 3                // Failed health checks before a restart
+	initialRestartBackoff  = 10 * time.Second // Wait after the first restart attempt
+	maxRestartBackoff      = 5 * time.Minute  // Upper bound for the restart backoff
+)
+
+// target is a platform client supervised by the watchdog
+type target struct {
+	name      string
+	healthFn  func() bool
+	restartFn func() error
+
+	failures    int
+	backoff     time.Duration
+	nextRestart time.Time
+}
+
+// Watchdog restarts platform clients that keep failing their health checks
+type Watchdog struct {
+	mu      sync.Mutex
+	targets []*target
+}
+
+// New creates an empty watchdog
+func New() *Watchdog {
+	return &Watchdog{}
+}
+
+// Register adds a platform client to supervise
+func (w *Watchdog) Register(name string, healthFn func() bool, restartFn func() error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.targets = append(w.targets, &target{
+		name:      name,
+		healthFn:  healthFn,
+		restartFn: restartFn,
+	})
+}
+
+// Start checks every registered client at the given interval until ctx is cancelled
+func (w *Watchdog) Start(ctx context.Context, checkInterval time.Duration) {
+	if checkInterval <= 0 {
+		log.Printf("⏭️ Watchdog is disabled")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.checkAll()
+			}
+		}
+	}()
+
+	log.Printf("🐕 Watchdog started (interval %s)", checkInterval)
+}
+
+// checkAll runs one health check round
+func (w *Watchdog) checkAll() {
+	w.mu.Lock()
+	targets := make([]*target, len(w.targets))
+	copy(targets, w.targets)
+	w.mu.Unlock()
+
+	for _, t := range targets {
+		w.check(t)
+	}
+}
+
+// check runs the health check of a client and restarts it after repeated failures
+func (w *Watchdog) check(t *target) {
+	if t.healthFn() {
+		if t.failures > 0 {
+			log.Printf("✅ Watchdog: %s is healthy again", t.name)
+		}
+		t.failures = 0
+		t.backoff = 0
+		return
+	}
+
+	t.failures++
+	log.Printf("⚠️ Watchdog: %s health check failed (%d/%d)", t.name, t.failures, maxConsecutiveFailures)
+	if t.failures < maxConsecutiveFailures {
+		return
+	}
+
+	now := time.Now()
+	if now.Before(t.nextRestart) {
+		return
+	}
+
+	// Back off exponentially while restarts do not bring the client back
+	if t.backoff == 0 {
+		t.backoff = initialRestartBackoff
+	} else {
+		t.backoff *= 2
+		if t.backoff > maxRestartBackoff {
+			t.backoff = maxRestartBackoff
+		}
+	}
+	t.nextRestart = now.Add(t.backoff)
+
+	log.Printf("🔄 Watchdog: restarting %s", t.name)
+	metrics.IncWatchdogRestarts(t.name)
+	if err := t.restartFn(); err != nil {
+		log.Printf("❌ Watchdog: failed to restart %s (next attempt in %s): %v", t.name, t.backoff, err)
+		return
+	}
+
+	t.failures = 0
+	log.Printf("✅ Watchdog: %s restarted", t.name)
+}